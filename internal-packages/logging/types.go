@@ -35,13 +35,14 @@ const (
 type Service string
 
 const (
-	ServiceBuild   Service = "build_service"
-	ServiceDeploy  Service = "deploy_service"
-	ServiceApp     Service = "app_service"
-	ServiceUser    Service = "user_service"
-	ServiceGateway Service = "gateway_service"
-	ServiceLog     Service = "log_service"
-	ServiceProject Service = "project_service"
+	ServiceBuild     Service = "build_service"
+	ServiceDeploy    Service = "deploy_service"
+	ServiceApp       Service = "app_service"
+	ServiceUser      Service = "user_service"
+	ServiceGateway   Service = "gateway_service"
+	ServiceLog       Service = "log_service"
+	ServiceProject   Service = "project_service"
+	ServiceActivator Service = "activator_service"
 )
 
 type LogLabels struct {
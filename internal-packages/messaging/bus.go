@@ -0,0 +1,24 @@
+package messaging
+
+import (
+	"context"
+
+	"apps-hosting.com/messaging/proto/events_pb"
+)
+
+// Bus is what the services need from the event bus. EventBus implements it on NATS and MemoryBus in
+// memory, for the tests of the handlers.
+type Bus interface {
+	Publish(ctx context.Context, eventName events_pb.EventName, data *events_pb.EventData) error
+	PublishMessage(ctx context.Context, message *events_pb.Message) error
+	Subscribe(eventName events_pb.EventName, handler EventHandler) error
+	SubscribeWithMaxDeliver(eventName events_pb.EventName, handler EventHandler, maxDeliver uint64) error
+	Watch(eventNames []events_pb.EventName, handler EventHandler) (func(), error)
+	PublishDeadLetter(ctx context.Context, subject string, data []byte, consumer string, attempts uint64, reason error) error
+	Close() error
+}
+
+var (
+	_ Bus = (*EventBus)(nil)
+	_ Bus = (*MemoryBus)(nil)
+)
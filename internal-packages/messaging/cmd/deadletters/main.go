@@ -0,0 +1,158 @@
+// deadletters inspects and replays the events the services gave up on.
+//
+//	deadletters [-nats-url URL] list [-limit N]
+//	deadletters [-nats-url URL] show <sequence>
+//	deadletters [-nats-url URL] replay <sequence>...
+//	deadletters [-nats-url URL] delete <sequence>...
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"apps-hosting.com/messaging"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func main() {
+	natsURL := flag.String("nats-url", os.Getenv("NATS_URL"), "URL of the NATS server, NATS_URL by default")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	eventBus, err := messaging.Connect("deadletters", *natsURL)
+	if err != nil {
+		fail(err)
+	}
+
+	args := flag.Args()[1:]
+	switch flag.Arg(0) {
+	case "list":
+		err = list(eventBus, args)
+	case "show":
+		err = show(eventBus, args)
+	case "replay":
+		err = forEachSequence(args, func(sequence uint64) error {
+			err := eventBus.ReplayDeadLetter(sequence)
+			if err == nil {
+				fmt.Printf("replayed %d\n", sequence)
+			}
+			return err
+		})
+	case "delete":
+		err = forEachSequence(args, func(sequence uint64) error {
+			err := eventBus.DeleteDeadLetter(sequence)
+			if err == nil {
+				fmt.Printf("deleted %d\n", sequence)
+			}
+			return err
+		})
+	default:
+		usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fail(err)
+	}
+}
+
+func list(eventBus *messaging.EventBus, args []string) error {
+	flags := flag.NewFlagSet("list", flag.ExitOnError)
+	limit := flags.Int("limit", 50, "maximum number of dead letters")
+	flags.Parse(args)
+
+	deadLetters, err := eventBus.DeadLetters(*limit)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SEQUENCE\tDEAD AT\tSUBJECT\tCONSUMER\tDELIVERIES\tMESSAGE ID\tREASON")
+	for _, deadLetter := range deadLetters {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d\t%s\t%s\n",
+			deadLetter.Sequence,
+			deadLetter.DeadAt.Format(time.RFC3339),
+			deadLetter.Subject,
+			deadLetter.Consumer,
+			deadLetter.Deliveries,
+			deadLetter.Message.GetId(),
+			deadLetter.Reason,
+		)
+	}
+
+	return w.Flush()
+}
+
+func show(eventBus *messaging.EventBus, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("show takes one sequence")
+	}
+
+	sequence, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid sequence %q", args[0])
+	}
+
+	deadLetter, err := eventBus.GetDeadLetter(sequence)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Sequence:   %d\n", deadLetter.Sequence)
+	fmt.Printf("Dead at:    %s\n", deadLetter.DeadAt.Format(time.RFC3339))
+	fmt.Printf("Subject:    %s\n", deadLetter.Subject)
+	fmt.Printf("Consumer:   %s\n", deadLetter.Consumer)
+	fmt.Printf("Deliveries: %d\n", deadLetter.Deliveries)
+	fmt.Printf("Reason:     %s\n", deadLetter.Reason)
+
+	if deadLetter.Message == nil {
+		fmt.Println("Message:    could not be decoded")
+		return nil
+	}
+
+	message, err := protojson.MarshalOptions{Multiline: true}.Marshal(deadLetter.Message)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Message:\n%s\n", message)
+	return nil
+}
+
+func forEachSequence(args []string, f func(sequence uint64) error) error {
+	if len(args) == 0 {
+		return fmt.Errorf("no sequence given")
+	}
+
+	for _, arg := range args {
+		sequence, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid sequence %q", arg)
+		}
+
+		err = f(sequence)
+		if err != nil {
+			return fmt.Errorf("dead letter %d: %w", sequence, err)
+		}
+	}
+
+	return nil
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: deadletters [-nats-url URL] list [-limit N] | show <sequence> | replay <sequence>... | delete <sequence>...")
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
@@ -0,0 +1,248 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"apps-hosting.com/messaging/proto/events_pb"
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/protobuf/proto"
+)
+
+// a message is dead-lettered on "dead_letter.<subject of the event>"
+const deadLetterSubjectPrefix = "dead_letter."
+
+const (
+	deadLetterSubjectHeader    = "Dead-Letter-Subject"
+	deadLetterConsumerHeader   = "Dead-Letter-Consumer"
+	deadLetterDeliveriesHeader = "Dead-Letter-Deliveries"
+	deadLetterReasonHeader     = "Dead-Letter-Reason"
+)
+
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// ErrInvalidMessage is returned by the handlers for a message without the data of its event.
+var ErrInvalidMessage = Permanent(errors.New("invalid message"))
+
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent marks an error that fails every attempt, like an invalid message.
+// The message is dead-lettered right away instead of being delivered again.
+func Permanent(err error) error {
+	return permanentError{err: err}
+}
+
+func IsPermanent(err error) bool {
+	return errors.As(err, &permanentError{})
+}
+
+type DeadLetter struct {
+	Sequence uint64
+	// Subject is the subject the message was published on, e.g. "app.deleted".
+	Subject string
+	// Consumer is the durable consumer which gave up on the message.
+	Consumer   string
+	Deliveries uint64
+	Reason     string
+	DeadAt     time.Time
+	// Message is nil when the message could not be decoded.
+	Message *events_pb.Message
+}
+
+// deadLetter moves the message to the dead letter stream. When that fails the message is delivered
+// again later, so that it is not lost.
+func (e *EventBus) deadLetter(msg *nats.Msg, consumer string, deliveries uint64, reason error) {
+	log.Printf("dead-lettering %s message of %s after %d attempts: %v", msg.Subject, consumer, deliveries, reason)
+
+	m := &nats.Msg{
+		Subject: deadLetterSubjectPrefix + msg.Subject,
+		Data:    msg.Data,
+		Header:  nats.Header{},
+	}
+
+	// the tracing headers are kept for the replay, the id would have the message dropped as a duplicate
+	// of the one dead-lettered by another service
+	for k, vs := range msg.Header {
+		if k == nats.MsgIdHdr {
+			continue
+		}
+		for _, v := range vs {
+			m.Header.Add(k, v)
+		}
+	}
+
+	setDeadLetterHeaders(m, msg.Subject, consumer, deliveries, reason)
+
+	_, err := e.jetStream.PublishMsg(m)
+	if err != nil {
+		log.Printf("failed to dead-letter %s message: %v", msg.Subject, err)
+		msg.NakWithDelay(retryMaxDelay)
+		return
+	}
+
+	msg.Term()
+}
+
+// PublishDeadLetter moves a message which was never published to the dead letter stream, e.g. an
+// event of an outbox which failed every attempt. data is the encoded message, subject the subject
+// it was meant for and consumer what gave up on it.
+func (e *EventBus) PublishDeadLetter(ctx context.Context, subject string, data []byte, consumer string, attempts uint64, reason error) error {
+	log.Printf("dead-lettering %s message of %s after %d attempts: %v", subject, consumer, attempts, reason)
+
+	m := &nats.Msg{
+		Subject: deadLetterSubjectPrefix + subject,
+		Data:    data,
+		Header:  nats.Header{},
+	}
+
+	carrier := propagation.HeaderCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	for k, vs := range carrier {
+		for _, v := range vs {
+			m.Header.Add(k, v)
+		}
+	}
+
+	setDeadLetterHeaders(m, subject, consumer, attempts, reason)
+
+	_, err := e.jetStream.PublishMsg(m)
+	if err != nil {
+		return fmt.Errorf("failed to dead-letter %s message: %w", subject, err)
+	}
+
+	return nil
+}
+
+func setDeadLetterHeaders(m *nats.Msg, subject string, consumer string, deliveries uint64, reason error) {
+	m.Header.Set(deadLetterSubjectHeader, subject)
+	m.Header.Set(deadLetterConsumerHeader, consumer)
+	m.Header.Set(deadLetterDeliveriesHeader, strconv.FormatUint(deliveries, 10))
+	m.Header.Set(deadLetterReasonHeader, reason.Error())
+}
+
+// DeadLetters returns the dead-lettered messages, most recent first, at most limit of them.
+func (e *EventBus) DeadLetters(limit int) ([]DeadLetter, error) {
+	streamName := events_pb.StreamName_name[int32(events_pb.StreamName_DEAD_LETTER_STREAM)]
+
+	streamInfo, err := e.jetStream.StreamInfo(streamName)
+	if err != nil {
+		return nil, err
+	}
+
+	deadLetters := []DeadLetter{}
+	for sequence := streamInfo.State.LastSeq; sequence >= streamInfo.State.FirstSeq && sequence > 0 && len(deadLetters) < limit; sequence-- {
+		deadLetter, err := e.GetDeadLetter(sequence)
+		if err == ErrDeadLetterNotFound {
+			// replayed or deleted
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		deadLetters = append(deadLetters, *deadLetter)
+	}
+
+	return deadLetters, nil
+}
+
+func (e *EventBus) GetDeadLetter(sequence uint64) (*DeadLetter, error) {
+	streamName := events_pb.StreamName_name[int32(events_pb.StreamName_DEAD_LETTER_STREAM)]
+
+	msg, err := e.jetStream.GetMsg(streamName, sequence)
+	if errors.Is(err, nats.ErrMsgNotFound) {
+		return nil, ErrDeadLetterNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, _ := strconv.ParseUint(msg.Header.Get(deadLetterDeliveriesHeader), 10, 64)
+
+	deadLetter := DeadLetter{
+		Sequence:   msg.Sequence,
+		Subject:    msg.Header.Get(deadLetterSubjectHeader),
+		Consumer:   msg.Header.Get(deadLetterConsumerHeader),
+		Deliveries: deliveries,
+		Reason:     msg.Header.Get(deadLetterReasonHeader),
+		DeadAt:     msg.Time,
+	}
+	if len(deadLetter.Subject) == 0 {
+		deadLetter.Subject = strings.TrimPrefix(msg.Subject, deadLetterSubjectPrefix)
+	}
+
+	message := events_pb.Message{}
+	if proto.Unmarshal(msg.Data, &message) == nil {
+		deadLetter.Message = &message
+	}
+
+	return &deadLetter, nil
+}
+
+// ReplayDeadLetter publishes the message again on its subject and removes it from the dead letters.
+// Every service subscribed to the subject receives it again, not only the one which gave up on it.
+func (e *EventBus) ReplayDeadLetter(sequence uint64) error {
+	streamName := events_pb.StreamName_name[int32(events_pb.StreamName_DEAD_LETTER_STREAM)]
+
+	msg, err := e.jetStream.GetMsg(streamName, sequence)
+	if errors.Is(err, nats.ErrMsgNotFound) {
+		return ErrDeadLetterNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	m := &nats.Msg{
+		Subject: msg.Header.Get(deadLetterSubjectHeader),
+		Data:    msg.Data,
+		Header:  nats.Header{},
+	}
+	if len(m.Subject) == 0 {
+		m.Subject = strings.TrimPrefix(msg.Subject, deadLetterSubjectPrefix)
+	}
+
+	for k, vs := range msg.Header {
+		if strings.HasPrefix(k, "Dead-Letter-") || k == nats.MsgIdHdr {
+			continue
+		}
+		for _, v := range vs {
+			m.Header.Add(k, v)
+		}
+	}
+
+	_, err = e.jetStream.PublishMsg(m)
+	if err != nil {
+		return fmt.Errorf("failed to replay dead letter: %w", err)
+	}
+
+	return e.DeleteDeadLetter(sequence)
+}
+
+func (e *EventBus) DeleteDeadLetter(sequence uint64) error {
+	streamName := events_pb.StreamName_name[int32(events_pb.StreamName_DEAD_LETTER_STREAM)]
+
+	err := e.jetStream.DeleteMsg(streamName, sequence)
+	if errors.Is(err, nats.ErrMsgNotFound) {
+		return ErrDeadLetterNotFound
+	}
+
+	return err
+}
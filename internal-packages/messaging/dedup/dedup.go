@@ -0,0 +1,220 @@
+// Package dedup makes the event handlers safe under redelivery. The messages handled by a service
+// are recorded by their id in its processed_messages table, and a message already handled is
+// acknowledged without calling the handler again.
+package dedup
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"apps-hosting.com/messaging"
+	"apps-hosting.com/messaging/proto/events_pb"
+	"github.com/uptrace/bun"
+)
+
+const (
+	// DefaultProcessingTimeout is how long the claim of a delivery lasts when it is not renewed, e.g.
+	// when the service was stopped while handling the message. It is shorter than messaging.AckWait,
+	// so that the message delivered again after a crash is taken over right away.
+	DefaultProcessingTimeout = 2 * time.Minute
+	// DefaultRetention is how long the handled messages are remembered.
+	DefaultRetention = 7 * 24 * time.Hour
+)
+
+const pruneInterval = time.Hour
+
+type ProcessedMessage struct {
+	bun.BaseModel `bun:"table:processed_messages"`
+
+	// Subject is the subject of the event, a service handles every event once.
+	Subject     string    `bun:"subject,pk"`
+	MessageId   string    `bun:"message_id,pk"`
+	StartedAt   time.Time `bun:"started_at,notnull"`
+	ProcessedAt time.Time `bun:"processed_at,nullzero"`
+}
+
+type Deduplicator struct {
+	db                *bun.DB
+	processingTimeout time.Duration
+	retention         time.Duration
+}
+
+func NewDeduplicator(db *bun.DB) Deduplicator {
+	return Deduplicator{
+		db:                db,
+		processingTimeout: DefaultProcessingTimeout,
+		retention:         DefaultRetention,
+	}
+}
+
+func (d *Deduplicator) CreateProcessedMessagesTable(ctx context.Context) error {
+	_, err := d.db.NewCreateTable().Model((*ProcessedMessage)(nil)).IfNotExists().Exec(ctx)
+	return err
+}
+
+// Idempotent calls handler once for every message. A message is claimed before the handler is
+// called and marked as processed when it succeeds, a failure releases it for the next delivery.
+// The claim is renewed while the handler runs, however long it takes.
+func (d *Deduplicator) Idempotent(handler messaging.EventHandler) messaging.EventHandler {
+	return func(ctx context.Context, message *events_pb.Message) error {
+		subject := messaging.Subject(message.EventName)
+
+		claimed, err := d.claim(ctx, subject, message.Id)
+		if err != nil {
+			return fmt.Errorf("failed to claim message: %w", err)
+		}
+
+		if !claimed {
+			processed, err := d.isProcessed(ctx, subject, message.Id)
+			if err != nil {
+				return fmt.Errorf("failed to read processed message: %w", err)
+			}
+
+			if processed {
+				log.Printf("%s message %s already handled, skipping it", subject, message.Id)
+				return nil
+			}
+
+			return messaging.ErrMessageInProgress
+		}
+
+		stopRenewing := d.renewClaim(subject, message.Id)
+		err = handler(ctx, message)
+		stopRenewing()
+		if err != nil {
+			_, releaseErr := d.db.NewDelete().
+				Model((*ProcessedMessage)(nil)).
+				Where("subject = ?", subject).
+				Where("message_id = ?", message.Id).
+				Exec(ctx)
+			if releaseErr != nil {
+				log.Printf("failed to release %s message %s: %v", subject, message.Id, releaseErr)
+			}
+
+			return err
+		}
+
+		_, err = d.db.NewUpdate().
+			Model((*ProcessedMessage)(nil)).
+			Set("processed_at = ?", time.Now()).
+			Where("subject = ?", subject).
+			Where("message_id = ?", message.Id).
+			Exec(ctx)
+		if err != nil {
+			// the handler succeeded, the message is handled again once its claim times out at worst
+			log.Printf("failed to mark %s message %s as processed: %v", subject, message.Id, err)
+		}
+
+		return nil
+	}
+}
+
+// claim records the message as being handled, unless it was already handled or it is being
+// handled by a delivery which did not time out.
+func (d *Deduplicator) claim(ctx context.Context, subject, messageId string) (bool, error) {
+	now := time.Now()
+
+	processedMessage := ProcessedMessage{
+		Subject:   subject,
+		MessageId: messageId,
+		StartedAt: now,
+	}
+
+	result, err := d.db.NewInsert().
+		Model(&processedMessage).
+		On("CONFLICT (subject, message_id) DO UPDATE").
+		Set("started_at = EXCLUDED.started_at").
+		Where("processed_message.processed_at IS NULL").
+		Where("processed_message.started_at < ?", now.Add(-d.processingTimeout)).
+		Returning("NULL").
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected == 1, nil
+}
+
+// renewClaim keeps the message claimed until the returned function is called.
+func (d *Deduplicator) renewClaim(subject, messageId string) func() {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(d.processingTimeout / 4)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				_, err := d.db.NewUpdate().
+					Model((*ProcessedMessage)(nil)).
+					Set("started_at = ?", time.Now()).
+					Where("subject = ?", subject).
+					Where("message_id = ?", messageId).
+					Where("processed_at IS NULL").
+					Exec(context.Background())
+				if err != nil {
+					log.Printf("failed to renew the claim of %s message %s: %v", subject, messageId, err)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+func (d *Deduplicator) isProcessed(ctx context.Context, subject, messageId string) (bool, error) {
+	processedMessage := ProcessedMessage{}
+	err := d.db.NewSelect().
+		Model(&processedMessage).
+		Where("subject = ?", subject).
+		Where("message_id = ?", messageId).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		// released by a failed delivery meanwhile
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return !processedMessage.ProcessedAt.IsZero(), nil
+}
+
+// Run forgets the messages handled before the retention period.
+func (d *Deduplicator) Run(ctx context.Context) {
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err := d.db.NewDelete().
+				Model((*ProcessedMessage)(nil)).
+				Where("processed_at < ?", time.Now().Add(-d.retention)).
+				Exec(ctx)
+			if err != nil {
+				log.Printf("failed to delete processed messages: %v", err)
+			}
+		}
+	}
+}
@@ -2,9 +2,11 @@ package messaging
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
+	"sync"
 	"time"
 
 	"apps-hosting.com/messaging/proto/events_pb"
@@ -15,15 +17,43 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-type EventHandler func(ctx context.Context, message *events_pb.Message)
+// EventHandler handles a message delivered by Subscribe. The message is acknowledged when it returns nil,
+// otherwise it is delivered again later, unless the error is Permanent.
+type EventHandler func(ctx context.Context, message *events_pb.Message) error
+
+// DefaultMaxDeliver is how many times Subscribe delivers a message before it is dead-lettered.
+const DefaultMaxDeliver = 5
+
+// AckWait is how long NATS waits for the acknowledgement of a message before delivering it again. A handler
+// still running reports its progress every progressInterval, so that a long one, like a build, is not
+// delivered again meanwhile.
+const AckWait = 5 * time.Minute
+
+const (
+	retryBaseDelay   = 5 * time.Second
+	retryMaxDelay    = 5 * time.Minute
+	progressInterval = time.Minute
+	// a message handled by another delivery is delivered again after inProgressDelay
+	inProgressDelay = 30 * time.Second
+)
+
+// ErrMessageInProgress is returned for a message another delivery is handling. The delivery is not
+// counted as an attempt, the message is delivered again later.
+var ErrMessageInProgress = errors.New("message is already being handled")
 
 type EventBus struct {
 	serviceName string
 	conn        *nats.Conn
 	jetStream   nats.JetStreamContext
+
+	// deferredDeliveries counts the deliveries of a message which were not attempts, by consumer and
+	// stream sequence, they are subtracted from the deliveries counted by NATS
+	deferredDeliveriesMutex sync.Mutex
+	deferredDeliveries      map[string]uint64
 }
 
-func NewEventBus(serviceName string, natsURL string, streamName events_pb.StreamName, subjects []events_pb.EventName) (*EventBus, error) {
+// Connect connects to NATS without adding the stream of a service, e.g. to inspect the dead letters.
+func Connect(serviceName string, natsURL string) (*EventBus, error) {
 	natsConnection, err := nats.Connect(natsURL)
 	if err != nil {
 		return nil, err
@@ -34,29 +64,63 @@ func NewEventBus(serviceName string, natsURL string, streamName events_pb.Stream
 		return nil, err
 	}
 
-	_subjects := []string{}
-	for _, subject := range subjects {
-		_subjects = append(_subjects, getEventName(subject))
+	eventBus := &EventBus{
+		serviceName:        serviceName,
+		conn:               natsConnection,
+		jetStream:          jetStream,
+		deferredDeliveries: map[string]uint64{},
+	}
+
+	err = eventBus.ensureStream(DeadLetterStream)
+	if err != nil {
+		return nil, err
+	}
+
+	return eventBus, nil
+}
+
+// NewEventBus connects to NATS and creates or updates the stream the service publishes on, e.g. AppStream.
+func NewEventBus(serviceName string, natsURL string, stream StreamConfig) (*EventBus, error) {
+	eventBus, err := Connect(serviceName, natsURL)
+	if err != nil {
+		return nil, err
 	}
 
-	_, err = jetStream.AddStream(&nats.StreamConfig{
-		Name:     events_pb.StreamName_name[int32(streamName)],
-		Subjects: _subjects,
-	})
+	err = eventBus.ensureStream(stream)
 	if err != nil {
 		return nil, err
 	}
 
-	return &EventBus{serviceName: serviceName, conn: natsConnection, jetStream: jetStream}, nil
+	return eventBus, nil
 }
 
 func (e *EventBus) Subscribe(eventName events_pb.EventName, handler EventHandler) error {
+	return e.SubscribeWithMaxDeliver(eventName, handler, DefaultMaxDeliver)
+}
+
+// SubscribeWithMaxDeliver acknowledges a message once the handler succeeds. When it fails the message
+// is delivered again after a delay that doubles with every attempt, up to maxDeliver attempts, then it
+// is moved to the dead letter stream.
+//
+// A message another delivery is handling, or of a schema version newer than SchemaVersion, is delivered
+// again later without counting an attempt, it is never dead-lettered for that.
+func (e *EventBus) SubscribeWithMaxDeliver(eventName events_pb.EventName, handler EventHandler, maxDeliver uint64) error {
+	consumer := consumerName(e.serviceName, eventName)
+
 	_, err := e.jetStream.Subscribe(getEventName(eventName), func(msg *nats.Msg) {
+		attempt := uint64(1)
+		deliveryKey := ""
+		if metadata, err := msg.Metadata(); err == nil {
+			deliveryKey = fmt.Sprintf("%s/%d", consumer, metadata.Sequence.Stream)
+			attempt = metadata.NumDelivered - e.deferredDeliveryCount(deliveryKey)
+		}
+
 		//	1. validate the message
 		message := events_pb.Message{}
 		err := proto.Unmarshal(msg.Data, &message)
 		if err != nil {
-			fmt.Println(err.Error())
+			e.forgetDeferredDeliveries(deliveryKey)
+			e.deadLetter(msg, consumer, attempt, fmt.Errorf("%w: %w", ErrInvalidMessage, err))
 			return
 		}
 
@@ -74,35 +138,178 @@ func (e *EventBus) Subscribe(eventName events_pb.EventName, handler EventHandler
 		defer span.End()
 
 		//	3. call the handler
-		handler(ctx, &message)
+		err = CheckSchemaVersion(&message)
+		if err == nil {
+			stopReportingProgress := reportProgress(msg)
+			err = handler(ctx, &message)
+			stopReportingProgress()
+		}
+		if err == nil {
+			e.forgetDeferredDeliveries(deliveryKey)
+			msg.Ack()
+			return
+		}
+
+		if delay, ok := deferDelay(err); ok {
+			e.deferDelivery(deliveryKey)
+			log.Printf("%s message %s deferred for %s: %v", getEventName(eventName), message.Id, delay, err)
+			msg.NakWithDelay(delay)
+			return
+		}
+
+		span.RecordError(err)
+
+		if IsPermanent(err) || attempt >= maxDeliver {
+			e.forgetDeferredDeliveries(deliveryKey)
+			e.deadLetter(msg, consumer, attempt, err)
+			return
+		}
+
+		log.Printf("%s message %s failed on attempt %d, retrying in %s: %v", getEventName(eventName), message.Id, attempt, retryDelay(attempt), err)
+		msg.NakWithDelay(retryDelay(attempt))
 	},
-		nats.Durable(fmt.Sprintf("%s-%s", e.serviceName, strings.ReplaceAll(getEventName(eventName), ".", "-"))),
-		nats.AckWait(5*time.Minute), // FIXME: build-service may take longer.
+		// the retries are counted here rather than with a MaxDeliver of the consumer, so that the existing
+		// durable consumers are reused as is and the last failure is kept with the dead letter
+		nats.Durable(consumer),
+		nats.ManualAck(),
+		nats.AckWait(AckWait),
 		nats.DeliverAll())
 
 	return err
 }
 
+// reportProgress tells NATS the message is still being handled until the returned function is called.
+func reportProgress(msg *nats.Msg) func() {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(progressInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				msg.InProgress()
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+// deferDelay tells whether the failure is not an attempt and when the message is delivered again.
+func deferDelay(err error) (time.Duration, bool) {
+	if errors.Is(err, ErrMessageInProgress) {
+		return inProgressDelay, true
+	}
+
+	// handled once the replicas are upgraded
+	if errors.Is(err, ErrUnsupportedSchemaVersion) && !IsPermanent(err) {
+		return retryMaxDelay, true
+	}
+
+	return 0, false
+}
+
+// deferDelivery records a delivery which was not an attempt. The count is kept by the replica which
+// deferred the message, it is lost when another one handles it, which only counts more attempts.
+func (e *EventBus) deferDelivery(deliveryKey string) {
+	if len(deliveryKey) == 0 {
+		return
+	}
+
+	e.deferredDeliveriesMutex.Lock()
+	defer e.deferredDeliveriesMutex.Unlock()
+
+	e.deferredDeliveries[deliveryKey]++
+}
+
+func (e *EventBus) deferredDeliveryCount(deliveryKey string) uint64 {
+	e.deferredDeliveriesMutex.Lock()
+	defer e.deferredDeliveriesMutex.Unlock()
+
+	return e.deferredDeliveries[deliveryKey]
+}
+
+func (e *EventBus) forgetDeferredDeliveries(deliveryKey string) {
+	e.deferredDeliveriesMutex.Lock()
+	defer e.deferredDeliveriesMutex.Unlock()
+
+	delete(e.deferredDeliveries, deliveryKey)
+}
+
+// Watch calls handler with the events published from now on. Unlike Subscribe every watcher receives
+// every event and nothing is acknowledged or delivered again, the errors of handler are ignored.
+// It suits live views.
+// The returned function stops watching.
+func (e *EventBus) Watch(eventNames []events_pb.EventName, handler EventHandler) (func(), error) {
+	subscriptions := []*nats.Subscription{}
+	unsubscribe := func() {
+		for _, subscription := range subscriptions {
+			subscription.Unsubscribe()
+		}
+	}
+
+	for _, eventName := range eventNames {
+		subscription, err := e.conn.Subscribe(getEventName(eventName), func(msg *nats.Msg) {
+			message := events_pb.Message{}
+			err := proto.Unmarshal(msg.Data, &message)
+			if err != nil {
+				log.Println(err.Error())
+				return
+			}
+
+			handler(context.Background(), &message)
+		})
+		if err != nil {
+			unsubscribe()
+			return nil, err
+		}
+
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return unsubscribe, nil
+}
+
 func (e *EventBus) Publish(ctx context.Context, eventName events_pb.EventName, data *events_pb.EventData) error {
+	return e.PublishMessage(ctx, NewMessage(eventName, data))
+}
+
+// NewMessage wraps the data of an event with a new id, the id is the idempotency key of the event.
+func NewMessage(eventName events_pb.EventName, data *events_pb.EventData) *events_pb.Message {
+	return &events_pb.Message{
+		Id:            uuid.New().String(),
+		EventName:     eventName,
+		Data:          data,
+		Timestamp:     time.Now().Unix(),
+		SchemaVersion: SchemaVersion,
+	}
+}
+
+// PublishMessage publishes a message built by NewMessage. The id of the message is its JetStream message id,
+// so that a message published again within the duplicate window of the stream, 2 minutes by default, is dropped.
+func (e *EventBus) PublishMessage(ctx context.Context, message *events_pb.Message) error {
 	tracer := otel.Tracer("apps-hosting.com/messaging")
 
-	ctx, span := tracer.Start(ctx, fmt.Sprintf("publish %s", getEventName(eventName)))
+	ctx, span := tracer.Start(ctx, fmt.Sprintf("publish %s", getEventName(message.EventName)))
 	defer span.End()
 
-	message := events_pb.Message{
-		Id:        uuid.New().String(),
-		EventName: eventName,
-		Data:      data,
-		Timestamp: time.Now().Unix(),
-	}
-
-	b_message, err := proto.Marshal(&message)
+	b_message, err := proto.Marshal(message)
 	if err != nil {
 		log.Fatal("failed to encode json to bytes:", err)
 	}
 
 	m := &nats.Msg{
-		Subject: getEventName(eventName),
+		Subject: getEventName(message.EventName),
 		Data:    b_message,
 		Header:  nats.Header{},
 	}
@@ -116,35 +323,73 @@ func (e *EventBus) Publish(ctx context.Context, eventName events_pb.EventName, d
 		}
 	}
 
+	m.Header.Set(nats.MsgIdHdr, message.Id)
+
 	_, err = e.jetStream.PublishMsg(m)
 	return err
 }
 
-func getEventName(eventName events_pb.EventName) string {
-	switch eventName {
-	// App Events
-	case events_pb.EventName_APP_CREATED:
-		return "app.created"
-	case events_pb.EventName_APP_DELETED:
-		return "app.deleted"
-
-	// Build Events
-	case events_pb.EventName_BUILD_COMPLETED:
-		return "build.completed"
-	case events_pb.EventName_BUILD_FAILED:
-		return "build.failed"
-
-	// Deploy Events
-	case events_pb.EventName_DEPLOY_COMPLETED:
-		return "deploy.completed"
-	case events_pb.EventName_DEPLOY_FAILED:
-		return "deploy.failed"
-
-	// Project Events
-	case events_pb.EventName_PROJECT_DELETED:
-		return "project.deleted"
+// Close stops the subscriptions once the messages being handled are, then closes the connection.
+func (e *EventBus) Close() error {
+	return e.conn.Drain()
+}
+
+// consumerName is the durable consumer of the service for the event, e.g. "deploy-service-build-completed".
+func consumerName(serviceName string, eventName events_pb.EventName) string {
+	return fmt.Sprintf("%s-%s", serviceName, strings.ReplaceAll(getEventName(eventName), ".", "-"))
+}
 
+func retryDelay(attempt uint64) time.Duration {
+	delay := retryBaseDelay
+	for i := uint64(1); i < attempt && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, retryMaxDelay)
+}
+
+// Subject is the NATS subject of the event, e.g. "deploy.completed".
+func Subject(eventName events_pb.EventName) string {
+	return getEventName(eventName)
+}
+
+// AppId returns the app the event is about, empty for the events which are not about an app.
+func AppId(message *events_pb.Message) string {
+	switch data := message.Data.GetValue().(type) {
+	case *events_pb.EventData_AppCreatedData:
+		return data.AppCreatedData.GetApp().GetId()
+	case *events_pb.EventData_AppBuildRequestedData:
+		return data.AppBuildRequestedData.GetApp().GetId()
+	case *events_pb.EventData_AppDeletedData:
+		return data.AppDeletedData.GetAppId()
+	case *events_pb.EventData_AppEnvUpdatedData:
+		return data.AppEnvUpdatedData.GetAppId()
+	case *events_pb.EventData_AppSuspendedData:
+		return data.AppSuspendedData.GetAppId()
+	case *events_pb.EventData_AppResumedData:
+		return data.AppResumedData.GetAppId()
+	case *events_pb.EventData_BuildCompletedData:
+		return data.BuildCompletedData.GetAppId()
+	case *events_pb.EventData_BuildFailedData:
+		return data.BuildFailedData.GetAppId()
+	case *events_pb.EventData_DeployCompletedData:
+		return data.DeployCompletedData.GetAppId()
+	case *events_pb.EventData_DeployFailedData:
+		return data.DeployFailedData.GetAppId()
+	case *events_pb.EventData_DeployDriftDetectedData:
+		return data.DeployDriftDetectedData.GetAppId()
 	default:
+		return ""
+	}
+}
+
+// getEventName derives the subject of the event from its name, the first word of the name is the
+// entity the event is about, e.g. APP_ENV_UPDATED is published on "app.env_updated".
+func getEventName(eventName events_pb.EventName) string {
+	name, ok := events_pb.EventName_name[int32(eventName)]
+	if !ok {
 		return "unknown"
 	}
+
+	return strings.Replace(strings.ToLower(name), "_", ".", 1)
 }
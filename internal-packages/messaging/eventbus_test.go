@@ -0,0 +1,241 @@
+package messaging_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"apps-hosting.com/messaging"
+	"apps-hosting.com/messaging/natstest"
+	"apps-hosting.com/messaging/proto/events_pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// the first retry of a failed message is 5 seconds later
+const deliveryTimeout = 15 * time.Second
+
+// deliveries counts the deliveries of every event name to a handler.
+type deliveries struct {
+	mutex  sync.Mutex
+	counts map[events_pb.EventName]int
+}
+
+func (d *deliveries) add(eventName events_pb.EventName) int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.counts[eventName]++
+	return d.counts[eventName]
+}
+
+func (d *deliveries) count(eventName events_pb.EventName) int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return d.counts[eventName]
+}
+
+func waitFor(t *testing.T, description string, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(deliveryTimeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", description)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func deadLetterOf(t *testing.T, eventBus *messaging.EventBus, consumer string) *messaging.DeadLetter {
+	t.Helper()
+
+	deadLetters, err := eventBus.DeadLetters(10)
+	if err != nil {
+		t.Fatalf("DeadLetters: %v", err)
+	}
+
+	for _, deadLetter := range deadLetters {
+		if deadLetter.Consumer == consumer {
+			return &deadLetter
+		}
+	}
+
+	return nil
+}
+
+func TestEventBusAcknowledgements(t *testing.T) {
+	if testing.Short() {
+		t.Skip("waits for the retries of NATS")
+	}
+
+	server, err := natstest.Start()
+	if err != nil {
+		t.Fatalf("failed to start NATS: %v", err)
+	}
+	defer server.Shutdown()
+
+	eventBus, err := server.NewEventBus("test-service", messaging.AppStream)
+	if err != nil {
+		t.Fatalf("NewEventBus: %v", err)
+	}
+	defer eventBus.Close()
+
+	delivered := &deliveries{counts: map[events_pb.EventName]int{}}
+	transientFailure := errors.New("connection reset by peer")
+
+	// acknowledged on the first delivery
+	err = eventBus.Subscribe(events_pb.EventName_APP_RESUMED, func(ctx context.Context, message *events_pb.Message) error {
+		delivered.add(message.EventName)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	// negatively acknowledged once, then acknowledged
+	err = eventBus.Subscribe(events_pb.EventName_APP_CREATED, func(ctx context.Context, message *events_pb.Message) error {
+		if delivered.add(message.EventName) == 1 {
+			return transientFailure
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	// dead-lettered without another delivery
+	err = eventBus.Subscribe(events_pb.EventName_APP_DELETED, func(ctx context.Context, message *events_pb.Message) error {
+		delivered.add(message.EventName)
+		return messaging.ErrInvalidMessage
+	})
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	// dead-lettered once every delivery failed
+	err = eventBus.SubscribeWithMaxDeliver(events_pb.EventName_APP_SUSPENDED, func(ctx context.Context, message *events_pb.Message) error {
+		delivered.add(message.EventName)
+		return transientFailure
+	}, 2)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	ctx := context.Background()
+	for _, message := range []struct {
+		eventName events_pb.EventName
+		data      *events_pb.EventData
+	}{
+		{events_pb.EventName_APP_RESUMED, &events_pb.EventData{Value: &events_pb.EventData_AppResumedData{AppResumedData: &events_pb.AppResumedEventData{AppId: "app-1"}}}},
+		{events_pb.EventName_APP_CREATED, &events_pb.EventData{Value: &events_pb.EventData_AppCreatedData{AppCreatedData: &events_pb.AppCreatedEventData{UserId: "user-1"}}}},
+		{events_pb.EventName_APP_DELETED, &events_pb.EventData{Value: &events_pb.EventData_AppDeletedData{AppDeletedData: &events_pb.AppDeletedEventData{AppId: "app-1"}}}},
+		{events_pb.EventName_APP_SUSPENDED, &events_pb.EventData{Value: &events_pb.EventData_AppSuspendedData{AppSuspendedData: &events_pb.AppSuspendedEventData{AppId: "app-1"}}}},
+	} {
+		err = eventBus.Publish(ctx, message.eventName, message.data)
+		if err != nil {
+			t.Fatalf("Publish: %v", err)
+		}
+	}
+
+	waitFor(t, "app.created to be delivered again", func() bool {
+		return delivered.count(events_pb.EventName_APP_CREATED) == 2
+	})
+	waitFor(t, "app.suspended to be dead-lettered", func() bool {
+		return deadLetterOf(t, eventBus, "test-service-app-suspended") != nil
+	})
+
+	deadLetter := deadLetterOf(t, eventBus, "test-service-app-suspended")
+	if deadLetter.Deliveries != 2 || deadLetter.Reason != transientFailure.Error() {
+		t.Errorf("app.suspended dead-lettered after %d deliveries for %q, expected 2 for %q", deadLetter.Deliveries, deadLetter.Reason, transientFailure)
+	}
+
+	deadLetter = deadLetterOf(t, eventBus, "test-service-app-deleted")
+	if deadLetter == nil {
+		t.Fatal("app.deleted was not dead-lettered")
+	}
+	if deadLetter.Deliveries != 1 || deadLetter.Message.GetData().GetAppDeletedData().GetAppId() != "app-1" {
+		t.Errorf("app.deleted dead-lettered after %d deliveries with %v, expected the message after 1", deadLetter.Deliveries, deadLetter.Message)
+	}
+
+	if deadLetter := deadLetterOf(t, eventBus, "test-service-app-created"); deadLetter != nil {
+		t.Errorf("app.created dead-lettered: %+v", deadLetter)
+	}
+
+	// the acknowledged messages are not delivered again
+	for eventName, expected := range map[events_pb.EventName]int{
+		events_pb.EventName_APP_RESUMED:   1,
+		events_pb.EventName_APP_CREATED:   2,
+		events_pb.EventName_APP_DELETED:   1,
+		events_pb.EventName_APP_SUSPENDED: 2,
+	} {
+		if count := delivered.count(eventName); count != expected {
+			t.Errorf("%s delivered %d times, expected %d", eventName, count, expected)
+		}
+	}
+}
+
+func TestPublishDeadLetter(t *testing.T) {
+	server, err := natstest.Start()
+	if err != nil {
+		t.Fatalf("failed to start NATS: %v", err)
+	}
+	defer server.Shutdown()
+
+	eventBus, err := server.NewEventBus("test-service", messaging.AppStream)
+	if err != nil {
+		t.Fatalf("NewEventBus: %v", err)
+	}
+	defer eventBus.Close()
+
+	message := messaging.NewMessage(events_pb.EventName_APP_DELETED, &events_pb.EventData{
+		Value: &events_pb.EventData_AppDeletedData{AppDeletedData: &events_pb.AppDeletedEventData{AppId: "app-1"}},
+	})
+	data, err := proto.Marshal(message)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	reason := errors.New("maximum payload exceeded")
+	err = eventBus.PublishDeadLetter(context.Background(), "app.deleted", data, "outbox", 10, reason)
+	if err != nil {
+		t.Fatalf("PublishDeadLetter: %v", err)
+	}
+
+	deadLetter := deadLetterOf(t, eventBus, "outbox")
+	if deadLetter == nil {
+		t.Fatal("the message was not dead-lettered")
+	}
+	if deadLetter.Subject != "app.deleted" || deadLetter.Deliveries != 10 || deadLetter.Reason != reason.Error() {
+		t.Errorf("dead letter %+v, expected app.deleted after 10 attempts for %q", deadLetter, reason)
+	}
+	if deadLetter.Message.GetId() != message.Id {
+		t.Errorf("dead letter of message %v, expected %s", deadLetter.Message, message.Id)
+	}
+
+	// replayed, the message is published on its subject
+	delivered := make(chan *events_pb.Message, 1)
+	err = eventBus.Subscribe(events_pb.EventName_APP_DELETED, func(ctx context.Context, message *events_pb.Message) error {
+		delivered <- message
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	err = eventBus.ReplayDeadLetter(deadLetter.Sequence)
+	if err != nil {
+		t.Fatalf("ReplayDeadLetter: %v", err)
+	}
+
+	select {
+	case replayed := <-delivered:
+		if replayed.Id != message.Id {
+			t.Errorf("replayed message %s, expected %s", replayed.Id, message.Id)
+		}
+	case <-time.After(deliveryTimeout):
+		t.Fatal("timed out waiting for the replayed message")
+	}
+}
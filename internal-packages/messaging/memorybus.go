@@ -0,0 +1,282 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"apps-hosting.com/messaging/proto/events_pb"
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/protobuf/proto"
+)
+
+var ErrMessageNotFound = errors.New("message not found")
+
+// MemoryBus is an event bus in memory, for the tests of the handlers. The messages are delivered
+// synchronously: Publish returns once every subscriber handled the message, including the messages
+// the handlers published meanwhile. A failed delivery is retried right away, without the delays of
+// EventBus, and the message is dead-lettered after maxDeliver attempts. A deferred message, e.g. one
+// another delivery is handling, is not delivered again until Redeliver is called.
+//
+// The published messages and the dead letters are recorded, e.g. to check that a handler published
+// a build.failed event.
+type MemoryBus struct {
+	serviceName string
+
+	mutex         sync.Mutex
+	closed        bool
+	subscriptions []*memorySubscription
+	watchers      map[int]EventHandler
+	nextWatcher   int
+	published     []*events_pb.Message
+	publishedIds  map[string]bool
+	deadLetters   []DeadLetter
+}
+
+type memorySubscription struct {
+	eventName  events_pb.EventName
+	consumer   string
+	handler    EventHandler
+	maxDeliver uint64
+}
+
+func NewMemoryBus(serviceName string) *MemoryBus {
+	return &MemoryBus{
+		serviceName:  serviceName,
+		watchers:     map[int]EventHandler{},
+		publishedIds: map[string]bool{},
+	}
+}
+
+func (b *MemoryBus) Subscribe(eventName events_pb.EventName, handler EventHandler) error {
+	return b.SubscribeWithMaxDeliver(eventName, handler, DefaultMaxDeliver)
+}
+
+// SubscribeWithMaxDeliver delivers the messages published from now on, unlike the durable consumers
+// of EventBus it does not receive the messages published before.
+func (b *MemoryBus) SubscribeWithMaxDeliver(eventName events_pb.EventName, handler EventHandler, maxDeliver uint64) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.closed {
+		return nats.ErrConnectionClosed
+	}
+
+	b.subscriptions = append(b.subscriptions, &memorySubscription{
+		eventName:  eventName,
+		consumer:   consumerName(b.serviceName, eventName),
+		handler:    handler,
+		maxDeliver: maxDeliver,
+	})
+
+	return nil
+}
+
+func (b *MemoryBus) Watch(eventNames []events_pb.EventName, handler EventHandler) (func(), error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.closed {
+		return nil, nats.ErrConnectionClosed
+	}
+
+	id := b.nextWatcher
+	b.nextWatcher++
+	b.watchers[id] = func(ctx context.Context, message *events_pb.Message) error {
+		if slices.Contains(eventNames, message.EventName) {
+			handler(ctx, message)
+		}
+		return nil
+	}
+
+	return func() {
+		b.mutex.Lock()
+		defer b.mutex.Unlock()
+
+		delete(b.watchers, id)
+	}, nil
+}
+
+func (b *MemoryBus) Publish(ctx context.Context, eventName events_pb.EventName, data *events_pb.EventData) error {
+	return b.PublishMessage(ctx, NewMessage(eventName, data))
+}
+
+// PublishMessage records the message and delivers it. Like the duplicate window of the streams, a
+// message published again with the same id is dropped.
+func (b *MemoryBus) PublishMessage(ctx context.Context, message *events_pb.Message) error {
+	b.mutex.Lock()
+	if b.closed {
+		b.mutex.Unlock()
+		return nats.ErrConnectionClosed
+	}
+	if b.publishedIds[message.Id] {
+		b.mutex.Unlock()
+		return nil
+	}
+
+	message = proto.Clone(message).(*events_pb.Message)
+	b.published = append(b.published, message)
+	b.publishedIds[message.Id] = true
+	b.mutex.Unlock()
+
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	b.deliver(message, carrier)
+	return nil
+}
+
+// PublishDeadLetter records a message which was never published with the dead letters.
+func (b *MemoryBus) PublishDeadLetter(ctx context.Context, subject string, data []byte, consumer string, attempts uint64, reason error) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.closed {
+		return nats.ErrConnectionClosed
+	}
+
+	deadLetter := DeadLetter{
+		Sequence:   uint64(len(b.deadLetters) + 1),
+		Subject:    subject,
+		Consumer:   consumer,
+		Deliveries: attempts,
+		Reason:     reason.Error(),
+		DeadAt:     time.Now(),
+	}
+
+	message := events_pb.Message{}
+	if proto.Unmarshal(data, &message) == nil {
+		deadLetter.Message = &message
+	}
+
+	b.deadLetters = append(b.deadLetters, deadLetter)
+	return nil
+}
+
+// Redeliver delivers a published message again to every subscriber, as NATS does when the
+// acknowledgement of a message is lost, e.g. to check that a handler is idempotent.
+func (b *MemoryBus) Redeliver(messageId string) error {
+	b.mutex.Lock()
+	index := slices.IndexFunc(b.published, func(message *events_pb.Message) bool {
+		return message.Id == messageId
+	})
+	if index < 0 {
+		b.mutex.Unlock()
+		return ErrMessageNotFound
+	}
+	message := b.published[index]
+	b.mutex.Unlock()
+
+	b.deliver(message, propagation.MapCarrier{})
+	return nil
+}
+
+// Published returns the published messages in order, only the ones of eventNames when given.
+func (b *MemoryBus) Published(eventNames ...events_pb.EventName) []*events_pb.Message {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	messages := []*events_pb.Message{}
+	for _, message := range b.published {
+		if len(eventNames) == 0 || slices.Contains(eventNames, message.EventName) {
+			messages = append(messages, proto.Clone(message).(*events_pb.Message))
+		}
+	}
+
+	return messages
+}
+
+// DeadLetters returns the messages the subscribers gave up on, in order.
+func (b *MemoryBus) DeadLetters() []DeadLetter {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return slices.Clone(b.deadLetters)
+}
+
+// Reset forgets the published messages and the dead letters, the subscriptions are kept.
+func (b *MemoryBus) Reset() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.published = nil
+	b.publishedIds = map[string]bool{}
+	b.deadLetters = nil
+}
+
+func (b *MemoryBus) Close() error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.closed = true
+	return nil
+}
+
+func (b *MemoryBus) deliver(message *events_pb.Message, carrier propagation.MapCarrier) {
+	b.mutex.Lock()
+	subscriptions := []*memorySubscription{}
+	for _, subscription := range b.subscriptions {
+		if subscription.eventName == message.EventName {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+	watchers := []EventHandler{}
+	for _, watcher := range b.watchers {
+		watchers = append(watchers, watcher)
+	}
+	b.mutex.Unlock()
+
+	for _, subscription := range subscriptions {
+		b.deliverTo(subscription, message, carrier)
+	}
+
+	for _, watcher := range watchers {
+		watcher(context.Background(), proto.Clone(message).(*events_pb.Message))
+	}
+}
+
+func (b *MemoryBus) deliverTo(subscription *memorySubscription, message *events_pb.Message, carrier propagation.MapCarrier) {
+	for attempt := uint64(1); ; attempt++ {
+		// every attempt gets its own copy, as a message decoded again
+		delivered := proto.Clone(message).(*events_pb.Message)
+		ctx := otel.GetTextMapPropagator().Extract(context.Background(), carrier)
+
+		err := CheckSchemaVersion(delivered)
+		if err == nil {
+			err = subscription.handler(ctx, delivered)
+		}
+		if err == nil {
+			return
+		}
+
+		// EventBus delivers it again later, here it is left to Redeliver
+		if _, ok := deferDelay(err); ok {
+			log.Printf("%s message %s deferred: %v", getEventName(message.EventName), message.Id, err)
+			return
+		}
+
+		if IsPermanent(err) || attempt >= subscription.maxDeliver {
+			log.Printf("dead-lettering %s message of %s after %d attempts: %v", getEventName(message.EventName), subscription.consumer, attempt, err)
+
+			b.mutex.Lock()
+			b.deadLetters = append(b.deadLetters, DeadLetter{
+				Sequence:   uint64(len(b.deadLetters) + 1),
+				Subject:    getEventName(message.EventName),
+				Consumer:   subscription.consumer,
+				Deliveries: attempt,
+				Reason:     err.Error(),
+				DeadAt:     time.Now(),
+				Message:    proto.Clone(message).(*events_pb.Message),
+			})
+			b.mutex.Unlock()
+			return
+		}
+
+		log.Printf("%s message %s failed on attempt %d, retrying: %v", getEventName(message.EventName), message.Id, attempt, err)
+	}
+}
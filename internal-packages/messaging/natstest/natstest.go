@@ -0,0 +1,64 @@
+// Package natstest runs a NATS server with JetStream in the process, for the integration tests of the
+// services against the EventBus itself rather than the MemoryBus.
+package natstest
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"apps-hosting.com/messaging"
+	"github.com/nats-io/nats-server/v2/server"
+)
+
+const startTimeout = 10 * time.Second
+
+type Server struct {
+	server   *server.Server
+	storeDir string
+}
+
+// Start runs a server on a free port, its streams are stored in a temporary directory removed by Shutdown.
+func Start() (*Server, error) {
+	storeDir, err := os.MkdirTemp("", "natstest-")
+	if err != nil {
+		return nil, err
+	}
+
+	natsServer, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      server.RANDOM_PORT,
+		JetStream: true,
+		StoreDir:  storeDir,
+		NoSigs:    true,
+	})
+	if err != nil {
+		os.RemoveAll(storeDir)
+		return nil, err
+	}
+
+	go natsServer.Start()
+
+	if !natsServer.ReadyForConnections(startTimeout) {
+		natsServer.Shutdown()
+		os.RemoveAll(storeDir)
+		return nil, errors.New("nats server did not start")
+	}
+
+	return &Server{server: natsServer, storeDir: storeDir}, nil
+}
+
+func (s *Server) URL() string {
+	return s.server.ClientURL()
+}
+
+// NewEventBus connects the service to the server and creates the stream it publishes on.
+func (s *Server) NewEventBus(serviceName string, stream messaging.StreamConfig) (*messaging.EventBus, error) {
+	return messaging.NewEventBus(serviceName, s.URL(), stream)
+}
+
+func (s *Server) Shutdown() {
+	s.server.Shutdown()
+	s.server.WaitForShutdown()
+	os.RemoveAll(s.storeDir)
+}
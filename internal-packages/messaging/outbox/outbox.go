@@ -0,0 +1,225 @@
+// Package outbox publishes events in the same transaction as the changes they are about. The events
+// are saved in an outbox table with the changes, and a relay publishes them once committed, so that
+// an event is published at least once when its changes are committed and never when they are not.
+//
+// An event may be published more than once, e.g. when the relay stops between publishing it and
+// deleting it from the table, the handlers recognize it by the id of its message.
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"apps-hosting.com/messaging"
+	"apps-hosting.com/messaging/proto/events_pb"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/protobuf/proto"
+)
+
+const DefaultRelayInterval = 5 * time.Second
+
+// the events are published in batches, oldest first
+const relayBatchSize = 100
+
+// MaxPublishAttempts is the number of times the relay tries to publish an event before it moves the
+// event to the dead letter stream, so that it stops holding back the events saved after it.
+const MaxPublishAttempts = 10
+
+// deadLetterConsumer is the consumer of the events the relay gave up on, in the dead letters.
+const deadLetterConsumer = "outbox"
+
+type Event struct {
+	bun.BaseModel `bun:"table:outbox_events"`
+
+	// Id is the id of the message, the idempotency key of the event.
+	Id      string `bun:"id,pk"`
+	Message []byte `bun:"message,notnull"`
+	// TraceContext links the publication to the request which saved the event.
+	TraceContext map[string]string `bun:"trace_context,type:jsonb"`
+	Attempts     int               `bun:"attempts,notnull,default:0"`
+	LastError    string            `bun:"last_error"`
+	CreatedAt    time.Time         `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
+
+func CreateOutboxTable(ctx context.Context, db bun.IDB) error {
+	_, err := db.NewCreateTable().Model((*Event)(nil)).IfNotExists().Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.NewCreateIndex().
+		Model((*Event)(nil)).
+		Index("outbox_events_created_at_idx").
+		Column("created_at").
+		IfNotExists().
+		Exec(ctx)
+	return err
+}
+
+// Enqueue saves the event in the outbox, db is the transaction of the changes the event is about.
+// The event is published by the relay after the transaction is committed.
+func Enqueue(ctx context.Context, db bun.IDB, eventName events_pb.EventName, data *events_pb.EventData) error {
+	message := messaging.NewMessage(eventName, data)
+	encodedMessage, err := proto.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %w", err)
+	}
+
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	event := Event{
+		Id:           message.Id,
+		Message:      encodedMessage,
+		TraceContext: carrier,
+	}
+
+	_, err = db.NewInsert().Model(&event).Exec(ctx)
+	return err
+}
+
+// Relay publishes the events of the outbox and deletes them once published. Several replicas of a
+// service may run it, an event is only picked by one of them at a time.
+type Relay struct {
+	db       *bun.DB
+	eventBus messaging.Bus
+	interval time.Duration
+	notify   chan struct{}
+}
+
+func NewRelay(db *bun.DB, eventBus messaging.Bus, interval time.Duration) *Relay {
+	return &Relay{
+		db:       db,
+		eventBus: eventBus,
+		interval: interval,
+		notify:   make(chan struct{}, 1),
+	}
+}
+
+// Notify wakes the relay up after events were committed, so that they do not wait for the next interval.
+func (r *Relay) Notify() {
+	select {
+	case r.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.notify:
+		}
+
+		for {
+			removed, err := r.relay(ctx)
+			if err != nil {
+				log.Printf("failed to relay outbox events: %v", err)
+				break
+			}
+			if removed < relayBatchSize {
+				break
+			}
+		}
+	}
+}
+
+// relay publishes a batch of events and returns how many were removed from the outbox. It stops at
+// the first event that fails, so that the events are published in order, unless the event failed
+// MaxPublishAttempts times or cannot be decoded: that one is moved to the dead letter stream instead.
+func (r *Relay) relay(ctx context.Context) (int, error) {
+	removed := 0
+	var publishErr error
+
+	err := r.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		events := []Event{}
+		err := tx.NewSelect().
+			Model(&events).
+			Order("created_at ASC").
+			Limit(relayBatchSize).
+			For("UPDATE SKIP LOCKED").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+
+		removedIds := []string{}
+		for _, event := range events {
+			publishErr = r.publish(ctx, event)
+			if publishErr == nil {
+				removedIds = append(removedIds, event.Id)
+				continue
+			}
+
+			attempts := event.Attempts + 1
+			if messaging.IsPermanent(publishErr) || attempts >= MaxPublishAttempts {
+				publishErr = r.deadLetter(ctx, event, attempts, publishErr)
+				if publishErr == nil {
+					removedIds = append(removedIds, event.Id)
+					continue
+				}
+			}
+
+			_, err = tx.NewUpdate().
+				Model((*Event)(nil)).
+				Set("attempts = ?", attempts).
+				Set("last_error = ?", publishErr.Error()).
+				Where("id = ?", event.Id).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+			break
+		}
+
+		if len(removedIds) > 0 {
+			_, err = tx.NewDelete().Model((*Event)(nil)).Where("id IN (?)", bun.In(removedIds)).Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		removed = len(removedIds)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return removed, publishErr
+}
+
+// publish fails with a permanent error for an event which cannot be decoded.
+func (r *Relay) publish(ctx context.Context, event Event) error {
+	message := events_pb.Message{}
+	err := proto.Unmarshal(event.Message, &message)
+	if err != nil {
+		return messaging.Permanent(fmt.Errorf("failed to decode message %s: %w", event.Id, err))
+	}
+
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(event.TraceContext))
+
+	return r.eventBus.PublishMessage(ctx, &message)
+}
+
+// deadLetter moves the event to the dead letter stream, on the subject of its event when it can be decoded.
+func (r *Relay) deadLetter(ctx context.Context, event Event, attempts int, reason error) error {
+	subject := deadLetterConsumer
+	message := events_pb.Message{}
+	if proto.Unmarshal(event.Message, &message) == nil {
+		subject = messaging.Subject(message.EventName)
+	}
+
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(event.TraceContext))
+
+	return r.eventBus.PublishDeadLetter(ctx, subject, event.Message, deadLetterConsumer, uint64(attempts), reason)
+}
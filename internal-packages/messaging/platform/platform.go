@@ -0,0 +1,103 @@
+// Package platform holds the configuration of the platform shared by the services, like the domain
+// the apps are served under and how requests reach them. It is read from the environment, which the
+// chart fills from the same values for every service.
+package platform
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+)
+
+type RoutingBackend string
+
+const (
+	RoutingBackendIngress RoutingBackend = "ingress"
+	// RoutingBackendGatewayAPI routes the requests with HTTPRoutes attached to an existing Gateway.
+	RoutingBackendGatewayAPI RoutingBackend = "gateway-api"
+)
+
+type TLSMode string
+
+const (
+	// TLSModeWildcard serves every app with the certificate of a wildcard secret.
+	TLSModeWildcard TLSMode = "wildcard"
+	// TLSModeCertManager has cert-manager issue a certificate for every app.
+	TLSModeCertManager TLSMode = "cert-manager"
+	// TLSModeNone leaves TLS to a proxy in front of the cluster.
+	TLSModeNone TLSMode = "none"
+)
+
+const (
+	DefaultAppsDomain       = "apps-hosting.com"
+	DefaultIngressClass     = "nginx"
+	DefaultGatewayNamespace = "default"
+)
+
+type Config struct {
+	// AppsDomain is the domain the apps are served under, as <app>.<AppsDomain>.
+	AppsDomain     string
+	RoutingBackend RoutingBackend
+	IngressClass   string
+	// IngressAnnotations are added to the Ingresses of the apps, e.g. the entrypoints of Traefik.
+	IngressAnnotations map[string]string
+	TLSMode            TLSMode
+	// TLSSecretName is the wildcard certificate of TLSModeWildcard.
+	TLSSecretName string
+	// CertManagerClusterIssuer issues the certificates of TLSModeCertManager.
+	CertManagerClusterIssuer string
+	// GatewayName and GatewayNamespace is the Gateway the HTTPRoutes of RoutingBackendGatewayAPI attach to,
+	// TLS is configured on its listeners.
+	GatewayName      string
+	GatewayNamespace string
+}
+
+// FromEnv reads the configuration, a value that is missing or invalid takes its default.
+func FromEnv() Config {
+	config := Config{
+		AppsDomain:               strings.Trim(strings.ToLower(os.Getenv("APPS_DOMAIN")), "."),
+		RoutingBackend:           RoutingBackend(os.Getenv("ROUTING_BACKEND")),
+		IngressClass:             os.Getenv("INGRESS_CLASS"),
+		IngressAnnotations:       map[string]string{},
+		TLSMode:                  TLSMode(os.Getenv("TLS_MODE")),
+		TLSSecretName:            os.Getenv("TLS_WILDCARD_SECRET_NAME"),
+		CertManagerClusterIssuer: os.Getenv("CERT_MANAGER_CLUSTER_ISSUER"),
+		GatewayName:              os.Getenv("GATEWAY_NAME"),
+		GatewayNamespace:         os.Getenv("GATEWAY_NAMESPACE"),
+	}
+
+	if len(config.AppsDomain) == 0 {
+		config.AppsDomain = DefaultAppsDomain
+	}
+
+	if config.RoutingBackend != RoutingBackendGatewayAPI {
+		config.RoutingBackend = RoutingBackendIngress
+	}
+
+	if len(config.IngressClass) == 0 {
+		config.IngressClass = DefaultIngressClass
+	}
+
+	// a JSON object, so that the chart can pass a map of its values with toJson
+	annotations := os.Getenv("INGRESS_ANNOTATIONS")
+	if len(annotations) != 0 && json.Unmarshal([]byte(annotations), &config.IngressAnnotations) != nil {
+		config.IngressAnnotations = map[string]string{}
+	}
+
+	switch config.TLSMode {
+	case TLSModeCertManager, TLSModeNone:
+	default:
+		config.TLSMode = TLSModeWildcard
+	}
+
+	if len(config.GatewayNamespace) == 0 {
+		config.GatewayNamespace = DefaultGatewayNamespace
+	}
+
+	return config
+}
+
+// AppDomainName is the domain an app is served at.
+func (c Config) AppDomainName(appName string) string {
+	return strings.ToLower(strings.ReplaceAll(appName, " ", "-")) + "." + c.AppsDomain
+}
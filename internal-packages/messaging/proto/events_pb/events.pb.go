@@ -25,10 +25,11 @@ const (
 type StreamName int32
 
 const (
-	StreamName_APP_STREAM     StreamName = 0
-	StreamName_BUILD_STREAM   StreamName = 1
-	StreamName_DEPLOY_STREAM  StreamName = 2
-	StreamName_PROJECT_STREAM StreamName = 3
+	StreamName_APP_STREAM         StreamName = 0
+	StreamName_BUILD_STREAM       StreamName = 1
+	StreamName_DEPLOY_STREAM      StreamName = 2
+	StreamName_PROJECT_STREAM     StreamName = 3
+	StreamName_DEAD_LETTER_STREAM StreamName = 4
 )
 
 // Enum value maps for StreamName.
@@ -38,12 +39,14 @@ var (
 		1: "BUILD_STREAM",
 		2: "DEPLOY_STREAM",
 		3: "PROJECT_STREAM",
+		4: "DEAD_LETTER_STREAM",
 	}
 	StreamName_value = map[string]int32{
-		"APP_STREAM":     0,
-		"BUILD_STREAM":   1,
-		"DEPLOY_STREAM":  2,
-		"PROJECT_STREAM": 3,
+		"APP_STREAM":         0,
+		"BUILD_STREAM":       1,
+		"DEPLOY_STREAM":      2,
+		"PROJECT_STREAM":     3,
+		"DEAD_LETTER_STREAM": 4,
 	}
 )
 
@@ -74,37 +77,66 @@ func (StreamName) EnumDescriptor() ([]byte, []int) {
 	return file_events_proto_rawDescGZIP(), []int{0}
 }
 
+// The subject of an event is derived from its name, the first word is the entity the event
+// is about: APP_ENV_UPDATED is published on "app.env_updated".
 type EventName int32
 
 const (
-	EventName_APP_CREATED      EventName = 0
-	EventName_APP_DELETED      EventName = 1
-	EventName_BUILD_COMPLETED  EventName = 2
-	EventName_BUILD_FAILED     EventName = 3
-	EventName_DEPLOY_COMPLETED EventName = 4
-	EventName_DEPLOY_FAILED    EventName = 5
-	EventName_PROJECT_DELETED  EventName = 6
+	EventName_APP_CREATED            EventName = 0
+	EventName_APP_DELETED            EventName = 1
+	EventName_BUILD_COMPLETED        EventName = 2
+	EventName_BUILD_FAILED           EventName = 3
+	EventName_DEPLOY_COMPLETED       EventName = 4
+	EventName_DEPLOY_FAILED          EventName = 5
+	EventName_PROJECT_DELETED        EventName = 6
+	EventName_APP_ENV_UPDATED        EventName = 7
+	EventName_ADDON_CREATED          EventName = 8
+	EventName_ADDON_DELETED          EventName = 9
+	EventName_ADDON_PROVISIONED      EventName = 10
+	EventName_ADDON_PROVISION_FAILED EventName = 11
+	EventName_APP_BUILD_REQUESTED    EventName = 12
+	EventName_APP_SUSPENDED          EventName = 13
+	EventName_APP_RESUMED            EventName = 14
+	EventName_DEPLOY_DRIFT_DETECTED  EventName = 15
 )
 
 // Enum value maps for EventName.
 var (
 	EventName_name = map[int32]string{
-		0: "APP_CREATED",
-		1: "APP_DELETED",
-		2: "BUILD_COMPLETED",
-		3: "BUILD_FAILED",
-		4: "DEPLOY_COMPLETED",
-		5: "DEPLOY_FAILED",
-		6: "PROJECT_DELETED",
+		0:  "APP_CREATED",
+		1:  "APP_DELETED",
+		2:  "BUILD_COMPLETED",
+		3:  "BUILD_FAILED",
+		4:  "DEPLOY_COMPLETED",
+		5:  "DEPLOY_FAILED",
+		6:  "PROJECT_DELETED",
+		7:  "APP_ENV_UPDATED",
+		8:  "ADDON_CREATED",
+		9:  "ADDON_DELETED",
+		10: "ADDON_PROVISIONED",
+		11: "ADDON_PROVISION_FAILED",
+		12: "APP_BUILD_REQUESTED",
+		13: "APP_SUSPENDED",
+		14: "APP_RESUMED",
+		15: "DEPLOY_DRIFT_DETECTED",
 	}
 	EventName_value = map[string]int32{
-		"APP_CREATED":      0,
-		"APP_DELETED":      1,
-		"BUILD_COMPLETED":  2,
-		"BUILD_FAILED":     3,
-		"DEPLOY_COMPLETED": 4,
-		"DEPLOY_FAILED":    5,
-		"PROJECT_DELETED":  6,
+		"APP_CREATED":            0,
+		"APP_DELETED":            1,
+		"BUILD_COMPLETED":        2,
+		"BUILD_FAILED":           3,
+		"DEPLOY_COMPLETED":       4,
+		"DEPLOY_FAILED":          5,
+		"PROJECT_DELETED":        6,
+		"APP_ENV_UPDATED":        7,
+		"ADDON_CREATED":          8,
+		"ADDON_DELETED":          9,
+		"ADDON_PROVISIONED":      10,
+		"ADDON_PROVISION_FAILED": 11,
+		"APP_BUILD_REQUESTED":    12,
+		"APP_SUSPENDED":          13,
+		"APP_RESUMED":            14,
+		"DEPLOY_DRIFT_DETECTED":  15,
 	}
 )
 
@@ -141,8 +173,10 @@ type AppCreatedEventData struct {
 	App                 *models_pb.App                 `protobuf:"bytes,2,opt,name=app,proto3" json:"app,omitempty"`
 	EnvironmentVariable *models_pb.EnvironmentVariable `protobuf:"bytes,3,opt,name=environment_variable,json=environmentVariable,proto3,oneof" json:"environment_variable,omitempty"`
 	GitRepository       *models_pb.GitRepository       `protobuf:"bytes,4,opt,name=git_repository,json=gitRepository,proto3" json:"git_repository,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// git_ref is the reference to build instead of the default branch.
+	GitRef        string `protobuf:"bytes,5,opt,name=git_ref,json=gitRef,proto3" json:"git_ref,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AppCreatedEventData) Reset() {
@@ -203,6 +237,169 @@ func (x *AppCreatedEventData) GetGitRepository() *models_pb.GitRepository {
 	return nil
 }
 
+func (x *AppCreatedEventData) GetGitRef() string {
+	if x != nil {
+		return x.GitRef
+	}
+	return ""
+}
+
+type AppBuildRequestedEventData struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	UserId        string                   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	App           *models_pb.App           `protobuf:"bytes,2,opt,name=app,proto3" json:"app,omitempty"`
+	GitRepository *models_pb.GitRepository `protobuf:"bytes,3,opt,name=git_repository,json=gitRepository,proto3" json:"git_repository,omitempty"`
+	GitRef        string                   `protobuf:"bytes,4,opt,name=git_ref,json=gitRef,proto3" json:"git_ref,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppBuildRequestedEventData) Reset() {
+	*x = AppBuildRequestedEventData{}
+	mi := &file_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppBuildRequestedEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppBuildRequestedEventData) ProtoMessage() {}
+
+func (x *AppBuildRequestedEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppBuildRequestedEventData.ProtoReflect.Descriptor instead.
+func (*AppBuildRequestedEventData) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AppBuildRequestedEventData) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AppBuildRequestedEventData) GetApp() *models_pb.App {
+	if x != nil {
+		return x.App
+	}
+	return nil
+}
+
+func (x *AppBuildRequestedEventData) GetGitRepository() *models_pb.GitRepository {
+	if x != nil {
+		return x.GitRepository
+	}
+	return nil
+}
+
+func (x *AppBuildRequestedEventData) GetGitRef() string {
+	if x != nil {
+		return x.GitRef
+	}
+	return ""
+}
+
+type AppSuspendedEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppSuspendedEventData) Reset() {
+	*x = AppSuspendedEventData{}
+	mi := &file_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppSuspendedEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppSuspendedEventData) ProtoMessage() {}
+
+func (x *AppSuspendedEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppSuspendedEventData.ProtoReflect.Descriptor instead.
+func (*AppSuspendedEventData) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AppSuspendedEventData) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type AppResumedEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppResumedEventData) Reset() {
+	*x = AppResumedEventData{}
+	mi := &file_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppResumedEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppResumedEventData) ProtoMessage() {}
+
+func (x *AppResumedEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppResumedEventData.ProtoReflect.Descriptor instead.
+func (*AppResumedEventData) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AppResumedEventData) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 type AppDeletedEventData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
@@ -213,7 +410,7 @@ type AppDeletedEventData struct {
 
 func (x *AppDeletedEventData) Reset() {
 	*x = AppDeletedEventData{}
-	mi := &file_events_proto_msgTypes[1]
+	mi := &file_events_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -225,7 +422,7 @@ func (x *AppDeletedEventData) String() string {
 func (*AppDeletedEventData) ProtoMessage() {}
 
 func (x *AppDeletedEventData) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[1]
+	mi := &file_events_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -238,7 +435,7 @@ func (x *AppDeletedEventData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppDeletedEventData.ProtoReflect.Descriptor instead.
 func (*AppDeletedEventData) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{1}
+	return file_events_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AppDeletedEventData) GetAppId() string {
@@ -255,6 +452,50 @@ func (x *AppDeletedEventData) GetAppName() string {
 	return ""
 }
 
+type AppEnvUpdatedEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppEnvUpdatedEventData) Reset() {
+	*x = AppEnvUpdatedEventData{}
+	mi := &file_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppEnvUpdatedEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppEnvUpdatedEventData) ProtoMessage() {}
+
+func (x *AppEnvUpdatedEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppEnvUpdatedEventData.ProtoReflect.Descriptor instead.
+func (*AppEnvUpdatedEventData) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AppEnvUpdatedEventData) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
 type BuildCompletedData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
@@ -262,25 +503,324 @@ type BuildCompletedData struct {
 	AppName       string                 `protobuf:"bytes,3,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
 	ImageUrl      string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
 	DomainName    string                 `protobuf:"bytes,5,opt,name=domain_name,json=domainName,proto3" json:"domain_name,omitempty"`
+	CommitHash    string                 `protobuf:"bytes,6,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildCompletedData) Reset() {
+	*x = BuildCompletedData{}
+	mi := &file_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildCompletedData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildCompletedData) ProtoMessage() {}
+
+func (x *BuildCompletedData) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildCompletedData.ProtoReflect.Descriptor instead.
+func (*BuildCompletedData) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BuildCompletedData) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *BuildCompletedData) GetBuildId() string {
+	if x != nil {
+		return x.BuildId
+	}
+	return ""
+}
+
+func (x *BuildCompletedData) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *BuildCompletedData) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *BuildCompletedData) GetDomainName() string {
+	if x != nil {
+		return x.DomainName
+	}
+	return ""
+}
+
+func (x *BuildCompletedData) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+type BuildFailedData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	BuildId       string                 `protobuf:"bytes,2,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	AppName       string                 `protobuf:"bytes,3,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildFailedData) Reset() {
+	*x = BuildFailedData{}
+	mi := &file_events_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildFailedData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildFailedData) ProtoMessage() {}
+
+func (x *BuildFailedData) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildFailedData.ProtoReflect.Descriptor instead.
+func (*BuildFailedData) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BuildFailedData) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *BuildFailedData) GetBuildId() string {
+	if x != nil {
+		return x.BuildId
+	}
+	return ""
+}
+
+func (x *BuildFailedData) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *BuildFailedData) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DeployCompletedData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeployId      string                 `protobuf:"bytes,1,opt,name=deploy_id,json=deployId,proto3" json:"deploy_id,omitempty"`
+	AppName       string                 `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	BuildId       string                 `protobuf:"bytes,4,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeployCompletedData) Reset() {
+	*x = DeployCompletedData{}
+	mi := &file_events_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeployCompletedData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployCompletedData) ProtoMessage() {}
+
+func (x *DeployCompletedData) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployCompletedData.ProtoReflect.Descriptor instead.
+func (*DeployCompletedData) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeployCompletedData) GetDeployId() string {
+	if x != nil {
+		return x.DeployId
+	}
+	return ""
+}
+
+func (x *DeployCompletedData) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *DeployCompletedData) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *DeployCompletedData) GetBuildId() string {
+	if x != nil {
+		return x.BuildId
+	}
+	return ""
+}
+
+type DeployFailedData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	BuildId       string                 `protobuf:"bytes,2,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	DeploymentId  string                 `protobuf:"bytes,3,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	AppName       string                 `protobuf:"bytes,4,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeployFailedData) Reset() {
+	*x = DeployFailedData{}
+	mi := &file_events_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeployFailedData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeployFailedData) ProtoMessage() {}
+
+func (x *DeployFailedData) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeployFailedData.ProtoReflect.Descriptor instead.
+func (*DeployFailedData) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeployFailedData) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *DeployFailedData) GetBuildId() string {
+	if x != nil {
+		return x.BuildId
+	}
+	return ""
+}
+
+func (x *DeployFailedData) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *DeployFailedData) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *DeployFailedData) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// DeployDriftDetectedData reports the resources of an app that no longer match its
+// last successful deployment, and whether deploying it again repaired them.
+type DeployDriftDetectedData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	AppName       string                 `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	DeploymentId  string                 `protobuf:"bytes,3,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	Discrepancies []string               `protobuf:"bytes,4,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+	Repaired      bool                   `protobuf:"varint,5,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuildCompletedData) Reset() {
-	*x = BuildCompletedData{}
-	mi := &file_events_proto_msgTypes[2]
+func (x *DeployDriftDetectedData) Reset() {
+	*x = DeployDriftDetectedData{}
+	mi := &file_events_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildCompletedData) String() string {
+func (x *DeployDriftDetectedData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildCompletedData) ProtoMessage() {}
+func (*DeployDriftDetectedData) ProtoMessage() {}
 
-func (x *BuildCompletedData) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[2]
+func (x *DeployDriftDetectedData) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,71 +831,75 @@ func (x *BuildCompletedData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildCompletedData.ProtoReflect.Descriptor instead.
-func (*BuildCompletedData) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use DeployDriftDetectedData.ProtoReflect.Descriptor instead.
+func (*DeployDriftDetectedData) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *BuildCompletedData) GetAppId() string {
+func (x *DeployDriftDetectedData) GetAppId() string {
 	if x != nil {
 		return x.AppId
 	}
 	return ""
 }
 
-func (x *BuildCompletedData) GetBuildId() string {
+func (x *DeployDriftDetectedData) GetAppName() string {
 	if x != nil {
-		return x.BuildId
+		return x.AppName
 	}
 	return ""
 }
 
-func (x *BuildCompletedData) GetAppName() string {
+func (x *DeployDriftDetectedData) GetDeploymentId() string {
 	if x != nil {
-		return x.AppName
+		return x.DeploymentId
 	}
 	return ""
 }
 
-func (x *BuildCompletedData) GetImageUrl() string {
+func (x *DeployDriftDetectedData) GetDiscrepancies() []string {
 	if x != nil {
-		return x.ImageUrl
+		return x.Discrepancies
 	}
-	return ""
+	return nil
 }
 
-func (x *BuildCompletedData) GetDomainName() string {
+func (x *DeployDriftDetectedData) GetRepaired() bool {
 	if x != nil {
-		return x.DomainName
+		return x.Repaired
+	}
+	return false
+}
+
+func (x *DeployDriftDetectedData) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-type BuildFailedData struct {
+type ProjectDeletedEventData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	BuildId       string                 `protobuf:"bytes,2,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
-	AppName       string                 `protobuf:"bytes,3,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
-	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuildFailedData) Reset() {
-	*x = BuildFailedData{}
-	mi := &file_events_proto_msgTypes[3]
+func (x *ProjectDeletedEventData) Reset() {
+	*x = ProjectDeletedEventData{}
+	mi := &file_events_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildFailedData) String() string {
+func (x *ProjectDeletedEventData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildFailedData) ProtoMessage() {}
+func (*ProjectDeletedEventData) ProtoMessage() {}
 
-func (x *BuildFailedData) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[3]
+func (x *ProjectDeletedEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -366,62 +910,101 @@ func (x *BuildFailedData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildFailedData.ProtoReflect.Descriptor instead.
-func (*BuildFailedData) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ProjectDeletedEventData.ProtoReflect.Descriptor instead.
+func (*ProjectDeletedEventData) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *BuildFailedData) GetAppId() string {
+func (x *ProjectDeletedEventData) GetProjectId() string {
 	if x != nil {
-		return x.AppId
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *BuildFailedData) GetBuildId() string {
+type AddOnCreatedEventData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AddOnId       string                 `protobuf:"bytes,1,opt,name=add_on_id,json=addOnId,proto3" json:"add_on_id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddOnCreatedEventData) Reset() {
+	*x = AddOnCreatedEventData{}
+	mi := &file_events_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOnCreatedEventData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOnCreatedEventData) ProtoMessage() {}
+
+func (x *AddOnCreatedEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[12]
 	if x != nil {
-		return x.BuildId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOnCreatedEventData.ProtoReflect.Descriptor instead.
+func (*AddOnCreatedEventData) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddOnCreatedEventData) GetAddOnId() string {
+	if x != nil {
+		return x.AddOnId
 	}
 	return ""
 }
 
-func (x *BuildFailedData) GetAppName() string {
+func (x *AddOnCreatedEventData) GetProjectId() string {
 	if x != nil {
-		return x.AppName
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *BuildFailedData) GetReason() string {
+func (x *AddOnCreatedEventData) GetType() string {
 	if x != nil {
-		return x.Reason
+		return x.Type
 	}
 	return ""
 }
 
-type DeployCompletedData struct {
+type AddOnDeletedEventData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DeployId      string                 `protobuf:"bytes,1,opt,name=deploy_id,json=deployId,proto3" json:"deploy_id,omitempty"`
-	AppName       string                 `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	AddOnId       string                 `protobuf:"bytes,1,opt,name=add_on_id,json=addOnId,proto3" json:"add_on_id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeployCompletedData) Reset() {
-	*x = DeployCompletedData{}
-	mi := &file_events_proto_msgTypes[4]
+func (x *AddOnDeletedEventData) Reset() {
+	*x = AddOnDeletedEventData{}
+	mi := &file_events_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeployCompletedData) String() string {
+func (x *AddOnDeletedEventData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeployCompletedData) ProtoMessage() {}
+func (*AddOnDeletedEventData) ProtoMessage() {}
 
-func (x *DeployCompletedData) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[4]
+func (x *AddOnDeletedEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -432,51 +1015,53 @@ func (x *DeployCompletedData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployCompletedData.ProtoReflect.Descriptor instead.
-func (*DeployCompletedData) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use AddOnDeletedEventData.ProtoReflect.Descriptor instead.
+func (*AddOnDeletedEventData) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DeployCompletedData) GetDeployId() string {
+func (x *AddOnDeletedEventData) GetAddOnId() string {
 	if x != nil {
-		return x.DeployId
+		return x.AddOnId
 	}
 	return ""
 }
 
-func (x *DeployCompletedData) GetAppName() string {
+func (x *AddOnDeletedEventData) GetProjectId() string {
 	if x != nil {
-		return x.AppName
+		return x.ProjectId
 	}
 	return ""
 }
 
-type DeployFailedData struct {
+// AddOnProvisionedEventData only carries the public connection info, the
+// password stays in the add-on secret inside the cluster.
+type AddOnProvisionedEventData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	BuildId       string                 `protobuf:"bytes,2,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
-	DeploymentId  string                 `protobuf:"bytes,3,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	AppName       string                 `protobuf:"bytes,4,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
-	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	AddOnId       string                 `protobuf:"bytes,1,opt,name=add_on_id,json=addOnId,proto3" json:"add_on_id,omitempty"`
+	Host          string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Port          int32                  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	Database      string                 `protobuf:"bytes,4,opt,name=database,proto3" json:"database,omitempty"`
+	Username      string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeployFailedData) Reset() {
-	*x = DeployFailedData{}
-	mi := &file_events_proto_msgTypes[5]
+func (x *AddOnProvisionedEventData) Reset() {
+	*x = AddOnProvisionedEventData{}
+	mi := &file_events_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeployFailedData) String() string {
+func (x *AddOnProvisionedEventData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeployFailedData) ProtoMessage() {}
+func (*AddOnProvisionedEventData) ProtoMessage() {}
 
-func (x *DeployFailedData) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[5]
+func (x *AddOnProvisionedEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -487,68 +1072,69 @@ func (x *DeployFailedData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployFailedData.ProtoReflect.Descriptor instead.
-func (*DeployFailedData) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use AddOnProvisionedEventData.ProtoReflect.Descriptor instead.
+func (*AddOnProvisionedEventData) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *DeployFailedData) GetAppId() string {
+func (x *AddOnProvisionedEventData) GetAddOnId() string {
 	if x != nil {
-		return x.AppId
+		return x.AddOnId
 	}
 	return ""
 }
 
-func (x *DeployFailedData) GetBuildId() string {
+func (x *AddOnProvisionedEventData) GetHost() string {
 	if x != nil {
-		return x.BuildId
+		return x.Host
 	}
 	return ""
 }
 
-func (x *DeployFailedData) GetDeploymentId() string {
+func (x *AddOnProvisionedEventData) GetPort() int32 {
 	if x != nil {
-		return x.DeploymentId
+		return x.Port
 	}
-	return ""
+	return 0
 }
 
-func (x *DeployFailedData) GetAppName() string {
+func (x *AddOnProvisionedEventData) GetDatabase() string {
 	if x != nil {
-		return x.AppName
+		return x.Database
 	}
 	return ""
 }
 
-func (x *DeployFailedData) GetReason() string {
+func (x *AddOnProvisionedEventData) GetUsername() string {
 	if x != nil {
-		return x.Reason
+		return x.Username
 	}
 	return ""
 }
 
-type ProjectDeletedEventData struct {
+type AddOnProvisionFailedEventData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AddOnId       string                 `protobuf:"bytes,1,opt,name=add_on_id,json=addOnId,proto3" json:"add_on_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProjectDeletedEventData) Reset() {
-	*x = ProjectDeletedEventData{}
-	mi := &file_events_proto_msgTypes[6]
+func (x *AddOnProvisionFailedEventData) Reset() {
+	*x = AddOnProvisionFailedEventData{}
+	mi := &file_events_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProjectDeletedEventData) String() string {
+func (x *AddOnProvisionFailedEventData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProjectDeletedEventData) ProtoMessage() {}
+func (*AddOnProvisionFailedEventData) ProtoMessage() {}
 
-func (x *ProjectDeletedEventData) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[6]
+func (x *AddOnProvisionFailedEventData) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,14 +1145,21 @@ func (x *ProjectDeletedEventData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProjectDeletedEventData.ProtoReflect.Descriptor instead.
-func (*ProjectDeletedEventData) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use AddOnProvisionFailedEventData.ProtoReflect.Descriptor instead.
+func (*AddOnProvisionFailedEventData) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ProjectDeletedEventData) GetProjectId() string {
+func (x *AddOnProvisionFailedEventData) GetAddOnId() string {
 	if x != nil {
-		return x.ProjectId
+		return x.AddOnId
+	}
+	return ""
+}
+
+func (x *AddOnProvisionFailedEventData) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
@@ -582,6 +1175,15 @@ type EventData struct {
 	//	*EventData_DeployCompletedData
 	//	*EventData_DeployFailedData
 	//	*EventData_ProjectDeletedData
+	//	*EventData_AppEnvUpdatedData
+	//	*EventData_AddOnCreatedData
+	//	*EventData_AddOnDeletedData
+	//	*EventData_AddOnProvisionedData
+	//	*EventData_AddOnProvisionFailedData
+	//	*EventData_AppBuildRequestedData
+	//	*EventData_AppSuspendedData
+	//	*EventData_AppResumedData
+	//	*EventData_DeployDriftDetectedData
 	Value         isEventData_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -589,7 +1191,7 @@ type EventData struct {
 
 func (x *EventData) Reset() {
 	*x = EventData{}
-	mi := &file_events_proto_msgTypes[7]
+	mi := &file_events_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -601,7 +1203,7 @@ func (x *EventData) String() string {
 func (*EventData) ProtoMessage() {}
 
 func (x *EventData) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[7]
+	mi := &file_events_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -614,7 +1216,7 @@ func (x *EventData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EventData.ProtoReflect.Descriptor instead.
 func (*EventData) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{7}
+	return file_events_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *EventData) GetValue() isEventData_Value {
@@ -687,6 +1289,87 @@ func (x *EventData) GetProjectDeletedData() *ProjectDeletedEventData {
 	return nil
 }
 
+func (x *EventData) GetAppEnvUpdatedData() *AppEnvUpdatedEventData {
+	if x != nil {
+		if x, ok := x.Value.(*EventData_AppEnvUpdatedData); ok {
+			return x.AppEnvUpdatedData
+		}
+	}
+	return nil
+}
+
+func (x *EventData) GetAddOnCreatedData() *AddOnCreatedEventData {
+	if x != nil {
+		if x, ok := x.Value.(*EventData_AddOnCreatedData); ok {
+			return x.AddOnCreatedData
+		}
+	}
+	return nil
+}
+
+func (x *EventData) GetAddOnDeletedData() *AddOnDeletedEventData {
+	if x != nil {
+		if x, ok := x.Value.(*EventData_AddOnDeletedData); ok {
+			return x.AddOnDeletedData
+		}
+	}
+	return nil
+}
+
+func (x *EventData) GetAddOnProvisionedData() *AddOnProvisionedEventData {
+	if x != nil {
+		if x, ok := x.Value.(*EventData_AddOnProvisionedData); ok {
+			return x.AddOnProvisionedData
+		}
+	}
+	return nil
+}
+
+func (x *EventData) GetAddOnProvisionFailedData() *AddOnProvisionFailedEventData {
+	if x != nil {
+		if x, ok := x.Value.(*EventData_AddOnProvisionFailedData); ok {
+			return x.AddOnProvisionFailedData
+		}
+	}
+	return nil
+}
+
+func (x *EventData) GetAppBuildRequestedData() *AppBuildRequestedEventData {
+	if x != nil {
+		if x, ok := x.Value.(*EventData_AppBuildRequestedData); ok {
+			return x.AppBuildRequestedData
+		}
+	}
+	return nil
+}
+
+func (x *EventData) GetAppSuspendedData() *AppSuspendedEventData {
+	if x != nil {
+		if x, ok := x.Value.(*EventData_AppSuspendedData); ok {
+			return x.AppSuspendedData
+		}
+	}
+	return nil
+}
+
+func (x *EventData) GetAppResumedData() *AppResumedEventData {
+	if x != nil {
+		if x, ok := x.Value.(*EventData_AppResumedData); ok {
+			return x.AppResumedData
+		}
+	}
+	return nil
+}
+
+func (x *EventData) GetDeployDriftDetectedData() *DeployDriftDetectedData {
+	if x != nil {
+		if x, ok := x.Value.(*EventData_DeployDriftDetectedData); ok {
+			return x.DeployDriftDetectedData
+		}
+	}
+	return nil
+}
+
 type isEventData_Value interface {
 	isEventData_Value()
 }
@@ -719,6 +1402,42 @@ type EventData_ProjectDeletedData struct {
 	ProjectDeletedData *ProjectDeletedEventData `protobuf:"bytes,7,opt,name=project_deleted_data,json=projectDeletedData,proto3,oneof"`
 }
 
+type EventData_AppEnvUpdatedData struct {
+	AppEnvUpdatedData *AppEnvUpdatedEventData `protobuf:"bytes,8,opt,name=app_env_updated_data,json=appEnvUpdatedData,proto3,oneof"`
+}
+
+type EventData_AddOnCreatedData struct {
+	AddOnCreatedData *AddOnCreatedEventData `protobuf:"bytes,9,opt,name=add_on_created_data,json=addOnCreatedData,proto3,oneof"`
+}
+
+type EventData_AddOnDeletedData struct {
+	AddOnDeletedData *AddOnDeletedEventData `protobuf:"bytes,10,opt,name=add_on_deleted_data,json=addOnDeletedData,proto3,oneof"`
+}
+
+type EventData_AddOnProvisionedData struct {
+	AddOnProvisionedData *AddOnProvisionedEventData `protobuf:"bytes,11,opt,name=add_on_provisioned_data,json=addOnProvisionedData,proto3,oneof"`
+}
+
+type EventData_AddOnProvisionFailedData struct {
+	AddOnProvisionFailedData *AddOnProvisionFailedEventData `protobuf:"bytes,12,opt,name=add_on_provision_failed_data,json=addOnProvisionFailedData,proto3,oneof"`
+}
+
+type EventData_AppBuildRequestedData struct {
+	AppBuildRequestedData *AppBuildRequestedEventData `protobuf:"bytes,13,opt,name=app_build_requested_data,json=appBuildRequestedData,proto3,oneof"`
+}
+
+type EventData_AppSuspendedData struct {
+	AppSuspendedData *AppSuspendedEventData `protobuf:"bytes,14,opt,name=app_suspended_data,json=appSuspendedData,proto3,oneof"`
+}
+
+type EventData_AppResumedData struct {
+	AppResumedData *AppResumedEventData `protobuf:"bytes,15,opt,name=app_resumed_data,json=appResumedData,proto3,oneof"`
+}
+
+type EventData_DeployDriftDetectedData struct {
+	DeployDriftDetectedData *DeployDriftDetectedData `protobuf:"bytes,16,opt,name=deploy_drift_detected_data,json=deployDriftDetectedData,proto3,oneof"`
+}
+
 func (*EventData_AppCreatedData) isEventData_Value() {}
 
 func (*EventData_AppDeletedData) isEventData_Value() {}
@@ -733,19 +1452,40 @@ func (*EventData_DeployFailedData) isEventData_Value() {}
 
 func (*EventData_ProjectDeletedData) isEventData_Value() {}
 
+func (*EventData_AppEnvUpdatedData) isEventData_Value() {}
+
+func (*EventData_AddOnCreatedData) isEventData_Value() {}
+
+func (*EventData_AddOnDeletedData) isEventData_Value() {}
+
+func (*EventData_AddOnProvisionedData) isEventData_Value() {}
+
+func (*EventData_AddOnProvisionFailedData) isEventData_Value() {}
+
+func (*EventData_AppBuildRequestedData) isEventData_Value() {}
+
+func (*EventData_AppSuspendedData) isEventData_Value() {}
+
+func (*EventData_AppResumedData) isEventData_Value() {}
+
+func (*EventData_DeployDriftDetectedData) isEventData_Value() {}
+
 type Message struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	EventName     EventName              `protobuf:"varint,2,opt,name=event_name,json=eventName,proto3,enum=events.EventName" json:"event_name,omitempty"`
-	Data          *EventData             `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	Timestamp     int64                  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventName EventName              `protobuf:"varint,2,opt,name=event_name,json=eventName,proto3,enum=events.EventName" json:"event_name,omitempty"`
+	Data      *EventData             `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp int64                  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// schema_version is increased by the changes the previous handlers can not read,
+	// the messages published before it was added have none and are version 1.
+	SchemaVersion uint32 `protobuf:"varint,5,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Message) Reset() {
 	*x = Message{}
-	mi := &file_events_proto_msgTypes[8]
+	mi := &file_events_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -757,7 +1497,7 @@ func (x *Message) String() string {
 func (*Message) ProtoMessage() {}
 
 func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[8]
+	mi := &file_events_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -770,7 +1510,7 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Message.ProtoReflect.Descriptor instead.
 func (*Message) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{8}
+	return file_events_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Message) GetId() string {
@@ -801,44 +1541,93 @@ func (x *Message) GetTimestamp() int64 {
 	return 0
 }
 
+func (x *Message) GetSchemaVersion() uint32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
 var File_events_proto protoreflect.FileDescriptor
 
 const file_events_proto_rawDesc = "" +
 	"\n" +
-	"\fevents.proto\x12\x06events\x1a\fmodels.proto\"\xf9\x01\n" +
+	"\fevents.proto\x12\x06events\x1a\fmodels.proto\"\x92\x02\n" +
 	"\x13AppCreatedEventData\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\x03app\x18\x02 \x01(\v2\v.models.AppR\x03app\x12S\n" +
 	"\x14environment_variable\x18\x03 \x01(\v2\x1b.models.EnvironmentVariableH\x00R\x13environmentVariable\x88\x01\x01\x12<\n" +
-	"\x0egit_repository\x18\x04 \x01(\v2\x15.models.GitRepositoryR\rgitRepositoryB\x17\n" +
-	"\x15_environment_variable\"G\n" +
+	"\x0egit_repository\x18\x04 \x01(\v2\x15.models.GitRepositoryR\rgitRepository\x12\x17\n" +
+	"\agit_ref\x18\x05 \x01(\tR\x06gitRefB\x17\n" +
+	"\x15_environment_variable\"\xab\x01\n" +
+	"\x1aAppBuildRequestedEventData\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\x03app\x18\x02 \x01(\v2\v.models.AppR\x03app\x12<\n" +
+	"\x0egit_repository\x18\x03 \x01(\v2\x15.models.GitRepositoryR\rgitRepository\x12\x17\n" +
+	"\agit_ref\x18\x04 \x01(\tR\x06gitRef\".\n" +
+	"\x15AppSuspendedEventData\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\",\n" +
+	"\x13AppResumedEventData\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\"G\n" +
 	"\x13AppDeletedEventData\x12\x15\n" +
 	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x19\n" +
-	"\bapp_name\x18\x02 \x01(\tR\aappName\"\x9f\x01\n" +
+	"\bapp_name\x18\x02 \x01(\tR\aappName\"/\n" +
+	"\x16AppEnvUpdatedEventData\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\"\xc0\x01\n" +
 	"\x12BuildCompletedData\x12\x15\n" +
 	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x19\n" +
 	"\bbuild_id\x18\x02 \x01(\tR\abuildId\x12\x19\n" +
 	"\bapp_name\x18\x03 \x01(\tR\aappName\x12\x1b\n" +
 	"\timage_url\x18\x04 \x01(\tR\bimageUrl\x12\x1f\n" +
 	"\vdomain_name\x18\x05 \x01(\tR\n" +
-	"domainName\"v\n" +
+	"domainName\x12\x1f\n" +
+	"\vcommit_hash\x18\x06 \x01(\tR\n" +
+	"commitHash\"v\n" +
 	"\x0fBuildFailedData\x12\x15\n" +
 	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x19\n" +
 	"\bbuild_id\x18\x02 \x01(\tR\abuildId\x12\x19\n" +
 	"\bapp_name\x18\x03 \x01(\tR\aappName\x12\x16\n" +
-	"\x06reason\x18\x04 \x01(\tR\x06reason\"M\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x7f\n" +
 	"\x13DeployCompletedData\x12\x1b\n" +
 	"\tdeploy_id\x18\x01 \x01(\tR\bdeployId\x12\x19\n" +
-	"\bapp_name\x18\x02 \x01(\tR\aappName\"\x9c\x01\n" +
+	"\bapp_name\x18\x02 \x01(\tR\aappName\x12\x15\n" +
+	"\x06app_id\x18\x03 \x01(\tR\x05appId\x12\x19\n" +
+	"\bbuild_id\x18\x04 \x01(\tR\abuildId\"\x9c\x01\n" +
 	"\x10DeployFailedData\x12\x15\n" +
 	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x19\n" +
 	"\bbuild_id\x18\x02 \x01(\tR\abuildId\x12#\n" +
 	"\rdeployment_id\x18\x03 \x01(\tR\fdeploymentId\x12\x19\n" +
 	"\bapp_name\x18\x04 \x01(\tR\aappName\x12\x16\n" +
-	"\x06reason\x18\x05 \x01(\tR\x06reason\"8\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xca\x01\n" +
+	"\x17DeployDriftDetectedData\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x19\n" +
+	"\bapp_name\x18\x02 \x01(\tR\aappName\x12#\n" +
+	"\rdeployment_id\x18\x03 \x01(\tR\fdeploymentId\x12$\n" +
+	"\rdiscrepancies\x18\x04 \x03(\tR\rdiscrepancies\x12\x1a\n" +
+	"\brepaired\x18\x05 \x01(\bR\brepaired\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"8\n" +
 	"\x17ProjectDeletedEventData\x12\x1d\n" +
 	"\n" +
-	"project_id\x18\x01 \x01(\tR\tprojectId\"\xaf\x04\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"f\n" +
+	"\x15AddOnCreatedEventData\x12\x1a\n" +
+	"\tadd_on_id\x18\x01 \x01(\tR\aaddOnId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\"R\n" +
+	"\x15AddOnDeletedEventData\x12\x1a\n" +
+	"\tadd_on_id\x18\x01 \x01(\tR\aaddOnId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\"\x97\x01\n" +
+	"\x19AddOnProvisionedEventData\x12\x1a\n" +
+	"\tadd_on_id\x18\x01 \x01(\tR\aaddOnId\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\x05R\x04port\x12\x1a\n" +
+	"\bdatabase\x18\x04 \x01(\tR\bdatabase\x12\x1a\n" +
+	"\busername\x18\x05 \x01(\tR\busername\"S\n" +
+	"\x1dAddOnProvisionFailedEventData\x12\x1a\n" +
+	"\tadd_on_id\x18\x01 \x01(\tR\aaddOnId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xbe\n" +
+	"\n" +
 	"\tEventData\x12G\n" +
 	"\x10app_created_data\x18\x01 \x01(\v2\x1b.events.AppCreatedEventDataH\x00R\x0eappCreatedData\x12G\n" +
 	"\x10app_deleted_data\x18\x02 \x01(\v2\x1b.events.AppDeletedEventDataH\x00R\x0eappDeletedData\x12N\n" +
@@ -846,21 +1635,33 @@ const file_events_proto_rawDesc = "" +
 	"\x11build_failed_data\x18\x04 \x01(\v2\x17.events.BuildFailedDataH\x00R\x0fbuildFailedData\x12Q\n" +
 	"\x15deploy_completed_data\x18\x05 \x01(\v2\x1b.events.DeployCompletedDataH\x00R\x13deployCompletedData\x12H\n" +
 	"\x12deploy_failed_data\x18\x06 \x01(\v2\x18.events.DeployFailedDataH\x00R\x10deployFailedData\x12S\n" +
-	"\x14project_deleted_data\x18\a \x01(\v2\x1f.events.ProjectDeletedEventDataH\x00R\x12projectDeletedDataB\a\n" +
-	"\x05value\"\x90\x01\n" +
+	"\x14project_deleted_data\x18\a \x01(\v2\x1f.events.ProjectDeletedEventDataH\x00R\x12projectDeletedData\x12Q\n" +
+	"\x14app_env_updated_data\x18\b \x01(\v2\x1e.events.AppEnvUpdatedEventDataH\x00R\x11appEnvUpdatedData\x12N\n" +
+	"\x13add_on_created_data\x18\t \x01(\v2\x1d.events.AddOnCreatedEventDataH\x00R\x10addOnCreatedData\x12N\n" +
+	"\x13add_on_deleted_data\x18\n" +
+	" \x01(\v2\x1d.events.AddOnDeletedEventDataH\x00R\x10addOnDeletedData\x12Z\n" +
+	"\x17add_on_provisioned_data\x18\v \x01(\v2!.events.AddOnProvisionedEventDataH\x00R\x14addOnProvisionedData\x12g\n" +
+	"\x1cadd_on_provision_failed_data\x18\f \x01(\v2%.events.AddOnProvisionFailedEventDataH\x00R\x18addOnProvisionFailedData\x12]\n" +
+	"\x18app_build_requested_data\x18\r \x01(\v2\".events.AppBuildRequestedEventDataH\x00R\x15appBuildRequestedData\x12M\n" +
+	"\x12app_suspended_data\x18\x0e \x01(\v2\x1d.events.AppSuspendedEventDataH\x00R\x10appSuspendedData\x12G\n" +
+	"\x10app_resumed_data\x18\x0f \x01(\v2\x1b.events.AppResumedEventDataH\x00R\x0eappResumedData\x12^\n" +
+	"\x1adeploy_drift_detected_data\x18\x10 \x01(\v2\x1f.events.DeployDriftDetectedDataH\x00R\x17deployDriftDetectedDataB\a\n" +
+	"\x05value\"\xb7\x01\n" +
 	"\aMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
 	"\n" +
 	"event_name\x18\x02 \x01(\x0e2\x11.events.EventNameR\teventName\x12%\n" +
 	"\x04data\x18\x03 \x01(\v2\x11.events.EventDataR\x04data\x12\x1c\n" +
-	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp*U\n" +
+	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp\x12%\n" +
+	"\x0eschema_version\x18\x05 \x01(\rR\rschemaVersion*m\n" +
 	"\n" +
 	"StreamName\x12\x0e\n" +
 	"\n" +
 	"APP_STREAM\x10\x00\x12\x10\n" +
 	"\fBUILD_STREAM\x10\x01\x12\x11\n" +
 	"\rDEPLOY_STREAM\x10\x02\x12\x12\n" +
-	"\x0ePROJECT_STREAM\x10\x03*\x92\x01\n" +
+	"\x0ePROJECT_STREAM\x10\x03\x12\x16\n" +
+	"\x12DEAD_LETTER_STREAM\x10\x04*\xd8\x02\n" +
 	"\tEventName\x12\x0f\n" +
 	"\vAPP_CREATED\x10\x00\x12\x0f\n" +
 	"\vAPP_DELETED\x10\x01\x12\x13\n" +
@@ -868,7 +1669,17 @@ const file_events_proto_rawDesc = "" +
 	"\fBUILD_FAILED\x10\x03\x12\x14\n" +
 	"\x10DEPLOY_COMPLETED\x10\x04\x12\x11\n" +
 	"\rDEPLOY_FAILED\x10\x05\x12\x13\n" +
-	"\x0fPROJECT_DELETED\x10\x06B\x1bZ\x19proto/events_pb;events_pbb\x06proto3"
+	"\x0fPROJECT_DELETED\x10\x06\x12\x13\n" +
+	"\x0fAPP_ENV_UPDATED\x10\a\x12\x11\n" +
+	"\rADDON_CREATED\x10\b\x12\x11\n" +
+	"\rADDON_DELETED\x10\t\x12\x15\n" +
+	"\x11ADDON_PROVISIONED\x10\n" +
+	"\x12\x1a\n" +
+	"\x16ADDON_PROVISION_FAILED\x10\v\x12\x17\n" +
+	"\x13APP_BUILD_REQUESTED\x10\f\x12\x11\n" +
+	"\rAPP_SUSPENDED\x10\r\x12\x0f\n" +
+	"\vAPP_RESUMED\x10\x0e\x12\x19\n" +
+	"\x15DEPLOY_DRIFT_DETECTED\x10\x0fB\x1bZ\x19proto/events_pb;events_pbb\x06proto3"
 
 var (
 	file_events_proto_rawDescOnce sync.Once
@@ -883,41 +1694,61 @@ func file_events_proto_rawDescGZIP() []byte {
 }
 
 var file_events_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_events_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_events_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_events_proto_goTypes = []any{
 	(StreamName)(0),                       // 0: events.StreamName
 	(EventName)(0),                        // 1: events.EventName
 	(*AppCreatedEventData)(nil),           // 2: events.AppCreatedEventData
-	(*AppDeletedEventData)(nil),           // 3: events.AppDeletedEventData
-	(*BuildCompletedData)(nil),            // 4: events.BuildCompletedData
-	(*BuildFailedData)(nil),               // 5: events.BuildFailedData
-	(*DeployCompletedData)(nil),           // 6: events.DeployCompletedData
-	(*DeployFailedData)(nil),              // 7: events.DeployFailedData
-	(*ProjectDeletedEventData)(nil),       // 8: events.ProjectDeletedEventData
-	(*EventData)(nil),                     // 9: events.EventData
-	(*Message)(nil),                       // 10: events.Message
-	(*models_pb.App)(nil),                 // 11: models.App
-	(*models_pb.EnvironmentVariable)(nil), // 12: models.EnvironmentVariable
-	(*models_pb.GitRepository)(nil),       // 13: models.GitRepository
+	(*AppBuildRequestedEventData)(nil),    // 3: events.AppBuildRequestedEventData
+	(*AppSuspendedEventData)(nil),         // 4: events.AppSuspendedEventData
+	(*AppResumedEventData)(nil),           // 5: events.AppResumedEventData
+	(*AppDeletedEventData)(nil),           // 6: events.AppDeletedEventData
+	(*AppEnvUpdatedEventData)(nil),        // 7: events.AppEnvUpdatedEventData
+	(*BuildCompletedData)(nil),            // 8: events.BuildCompletedData
+	(*BuildFailedData)(nil),               // 9: events.BuildFailedData
+	(*DeployCompletedData)(nil),           // 10: events.DeployCompletedData
+	(*DeployFailedData)(nil),              // 11: events.DeployFailedData
+	(*DeployDriftDetectedData)(nil),       // 12: events.DeployDriftDetectedData
+	(*ProjectDeletedEventData)(nil),       // 13: events.ProjectDeletedEventData
+	(*AddOnCreatedEventData)(nil),         // 14: events.AddOnCreatedEventData
+	(*AddOnDeletedEventData)(nil),         // 15: events.AddOnDeletedEventData
+	(*AddOnProvisionedEventData)(nil),     // 16: events.AddOnProvisionedEventData
+	(*AddOnProvisionFailedEventData)(nil), // 17: events.AddOnProvisionFailedEventData
+	(*EventData)(nil),                     // 18: events.EventData
+	(*Message)(nil),                       // 19: events.Message
+	(*models_pb.App)(nil),                 // 20: models.App
+	(*models_pb.EnvironmentVariable)(nil), // 21: models.EnvironmentVariable
+	(*models_pb.GitRepository)(nil),       // 22: models.GitRepository
 }
 var file_events_proto_depIdxs = []int32{
-	11, // 0: events.AppCreatedEventData.app:type_name -> models.App
-	12, // 1: events.AppCreatedEventData.environment_variable:type_name -> models.EnvironmentVariable
-	13, // 2: events.AppCreatedEventData.git_repository:type_name -> models.GitRepository
-	2,  // 3: events.EventData.app_created_data:type_name -> events.AppCreatedEventData
-	3,  // 4: events.EventData.app_deleted_data:type_name -> events.AppDeletedEventData
-	4,  // 5: events.EventData.build_completed_data:type_name -> events.BuildCompletedData
-	5,  // 6: events.EventData.build_failed_data:type_name -> events.BuildFailedData
-	6,  // 7: events.EventData.deploy_completed_data:type_name -> events.DeployCompletedData
-	7,  // 8: events.EventData.deploy_failed_data:type_name -> events.DeployFailedData
-	8,  // 9: events.EventData.project_deleted_data:type_name -> events.ProjectDeletedEventData
-	1,  // 10: events.Message.event_name:type_name -> events.EventName
-	9,  // 11: events.Message.data:type_name -> events.EventData
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	20, // 0: events.AppCreatedEventData.app:type_name -> models.App
+	21, // 1: events.AppCreatedEventData.environment_variable:type_name -> models.EnvironmentVariable
+	22, // 2: events.AppCreatedEventData.git_repository:type_name -> models.GitRepository
+	20, // 3: events.AppBuildRequestedEventData.app:type_name -> models.App
+	22, // 4: events.AppBuildRequestedEventData.git_repository:type_name -> models.GitRepository
+	2,  // 5: events.EventData.app_created_data:type_name -> events.AppCreatedEventData
+	6,  // 6: events.EventData.app_deleted_data:type_name -> events.AppDeletedEventData
+	8,  // 7: events.EventData.build_completed_data:type_name -> events.BuildCompletedData
+	9,  // 8: events.EventData.build_failed_data:type_name -> events.BuildFailedData
+	10, // 9: events.EventData.deploy_completed_data:type_name -> events.DeployCompletedData
+	11, // 10: events.EventData.deploy_failed_data:type_name -> events.DeployFailedData
+	13, // 11: events.EventData.project_deleted_data:type_name -> events.ProjectDeletedEventData
+	7,  // 12: events.EventData.app_env_updated_data:type_name -> events.AppEnvUpdatedEventData
+	14, // 13: events.EventData.add_on_created_data:type_name -> events.AddOnCreatedEventData
+	15, // 14: events.EventData.add_on_deleted_data:type_name -> events.AddOnDeletedEventData
+	16, // 15: events.EventData.add_on_provisioned_data:type_name -> events.AddOnProvisionedEventData
+	17, // 16: events.EventData.add_on_provision_failed_data:type_name -> events.AddOnProvisionFailedEventData
+	3,  // 17: events.EventData.app_build_requested_data:type_name -> events.AppBuildRequestedEventData
+	4,  // 18: events.EventData.app_suspended_data:type_name -> events.AppSuspendedEventData
+	5,  // 19: events.EventData.app_resumed_data:type_name -> events.AppResumedEventData
+	12, // 20: events.EventData.deploy_drift_detected_data:type_name -> events.DeployDriftDetectedData
+	1,  // 21: events.Message.event_name:type_name -> events.EventName
+	18, // 22: events.Message.data:type_name -> events.EventData
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_events_proto_init() }
@@ -926,7 +1757,7 @@ func file_events_proto_init() {
 		return
 	}
 	file_events_proto_msgTypes[0].OneofWrappers = []any{}
-	file_events_proto_msgTypes[7].OneofWrappers = []any{
+	file_events_proto_msgTypes[16].OneofWrappers = []any{
 		(*EventData_AppCreatedData)(nil),
 		(*EventData_AppDeletedData)(nil),
 		(*EventData_BuildCompletedData)(nil),
@@ -934,6 +1765,15 @@ func file_events_proto_init() {
 		(*EventData_DeployCompletedData)(nil),
 		(*EventData_DeployFailedData)(nil),
 		(*EventData_ProjectDeletedData)(nil),
+		(*EventData_AppEnvUpdatedData)(nil),
+		(*EventData_AddOnCreatedData)(nil),
+		(*EventData_AddOnDeletedData)(nil),
+		(*EventData_AddOnProvisionedData)(nil),
+		(*EventData_AddOnProvisionFailedData)(nil),
+		(*EventData_AppBuildRequestedData)(nil),
+		(*EventData_AppSuspendedData)(nil),
+		(*EventData_AppResumedData)(nil),
+		(*EventData_DeployDriftDetectedData)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -941,7 +1781,7 @@ func file_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_proto_rawDesc), len(file_events_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   9,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -385,6 +385,8 @@ type App struct {
 	BuildCmd      string                 `protobuf:"bytes,7,opt,name=build_cmd,json=buildCmd,proto3" json:"build_cmd,omitempty"`
 	StartCmd      string                 `protobuf:"bytes,8,opt,name=start_cmd,json=startCmd,proto3" json:"start_cmd,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Type          string                 `protobuf:"bytes,10,opt,name=type,proto3" json:"type,omitempty"`
+	PublishDir    string                 `protobuf:"bytes,11,opt,name=publish_dir,json=publishDir,proto3" json:"publish_dir,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -482,6 +484,20 @@ func (x *App) GetCreatedAt() string {
 	return ""
 }
 
+func (x *App) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *App) GetPublishDir() string {
+	if x != nil {
+		return x.PublishDir
+	}
+	return ""
+}
+
 type EnvironmentVariable struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -818,7 +834,7 @@ const file_src_protos_models_proto_rawDesc = "" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\tR\tcreatedAt\"\xf7\x01\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"\xac\x02\n" +
 	"\x03App\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -831,7 +847,11 @@ const file_src_protos_models_proto_rawDesc = "" +
 	"\tbuild_cmd\x18\a \x01(\tR\bbuildCmd\x12\x1b\n" +
 	"\tstart_cmd\x18\b \x01(\tR\bstartCmd\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\t \x01(\tR\tcreatedAt\"q\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x12\n" +
+	"\x04type\x18\n" +
+	" \x01(\tR\x04type\x12\x1f\n" +
+	"\vpublish_dir\x18\v \x01(\tR\n" +
+	"publishDir\"q\n" +
 	"\x13EnvironmentVariable\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
 	"\x06app_id\x18\x02 \x01(\tR\x05appId\x12\x14\n" +
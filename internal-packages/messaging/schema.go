@@ -0,0 +1,38 @@
+package messaging
+
+import (
+	"errors"
+	"fmt"
+
+	"apps-hosting.com/messaging/proto/events_pb"
+)
+
+const (
+	// SchemaVersion is the version of the events published by this version of the package. It is bumped
+	// when the data of an event changes in a way the handlers of the previous version cannot handle.
+	SchemaVersion = 1
+	// MinSchemaVersion is the oldest version the handlers still handle, the messages of older versions
+	// are dead-lettered.
+	MinSchemaVersion = 1
+)
+
+var ErrUnsupportedSchemaVersion = errors.New("unsupported schema version")
+
+// CheckSchemaVersion tells whether the message can be handled. A message published before the versions
+// were introduced has no version, it is version 1.
+//
+// A message of a newer version is delivered again every 5 minutes without counting an attempt, rather
+// than dead-lettered, so that a replica already upgraded handles it during a rolling update.
+func CheckSchemaVersion(message *events_pb.Message) error {
+	version := max(message.SchemaVersion, 1)
+
+	if version > SchemaVersion {
+		return fmt.Errorf("%w: %d is newer than %d", ErrUnsupportedSchemaVersion, version, SchemaVersion)
+	}
+
+	if version < MinSchemaVersion {
+		return Permanent(fmt.Errorf("%w: %d is older than %d", ErrUnsupportedSchemaVersion, version, MinSchemaVersion))
+	}
+
+	return nil
+}
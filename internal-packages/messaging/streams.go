@@ -0,0 +1,151 @@
+package messaging
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"apps-hosting.com/messaging/proto/events_pb"
+	"github.com/nats-io/nats.go"
+)
+
+// StreamConfig declares a JetStream stream. Every service publishes its events on its own stream,
+// which it creates on start or updates to match its declaration.
+type StreamConfig struct {
+	Name      events_pb.StreamName
+	Subjects  []events_pb.EventName
+	Retention nats.RetentionPolicy
+	// MaxAge is how long the events are kept, the durable consumers created later receive the
+	// events of this period.
+	MaxAge time.Duration
+	// Replicas is the number of copies of the stream in a NATS cluster, NATS_STREAM_REPLICAS
+	// overrides it for every stream.
+	Replicas int
+	// Duplicates is the window in which a message published again with the same id is dropped.
+	Duplicates time.Duration
+
+	// subjectPatterns replace the subjects of the events, for the streams of the package
+	subjectPatterns []string
+}
+
+var (
+	AppStream = StreamConfig{
+		Name: events_pb.StreamName_APP_STREAM,
+		Subjects: []events_pb.EventName{
+			events_pb.EventName_APP_CREATED,
+			events_pb.EventName_APP_DELETED,
+			events_pb.EventName_APP_ENV_UPDATED,
+			events_pb.EventName_APP_BUILD_REQUESTED,
+			events_pb.EventName_APP_SUSPENDED,
+			events_pb.EventName_APP_RESUMED,
+		},
+		Retention:  nats.LimitsPolicy,
+		MaxAge:     30 * 24 * time.Hour,
+		Replicas:   1,
+		Duplicates: 2 * time.Minute,
+	}
+
+	BuildStream = StreamConfig{
+		Name: events_pb.StreamName_BUILD_STREAM,
+		Subjects: []events_pb.EventName{
+			events_pb.EventName_BUILD_COMPLETED,
+			events_pb.EventName_BUILD_FAILED,
+		},
+		Retention:  nats.LimitsPolicy,
+		MaxAge:     30 * 24 * time.Hour,
+		Replicas:   1,
+		Duplicates: 2 * time.Minute,
+	}
+
+	DeployStream = StreamConfig{
+		Name: events_pb.StreamName_DEPLOY_STREAM,
+		Subjects: []events_pb.EventName{
+			events_pb.EventName_DEPLOY_COMPLETED,
+			events_pb.EventName_DEPLOY_FAILED,
+			events_pb.EventName_DEPLOY_DRIFT_DETECTED,
+			events_pb.EventName_ADDON_PROVISIONED,
+			events_pb.EventName_ADDON_PROVISION_FAILED,
+		},
+		Retention:  nats.LimitsPolicy,
+		MaxAge:     30 * 24 * time.Hour,
+		Replicas:   1,
+		Duplicates: 2 * time.Minute,
+	}
+
+	ProjectStream = StreamConfig{
+		Name: events_pb.StreamName_PROJECT_STREAM,
+		Subjects: []events_pb.EventName{
+			events_pb.EventName_PROJECT_DELETED,
+			events_pb.EventName_ADDON_CREATED,
+			events_pb.EventName_ADDON_DELETED,
+		},
+		Retention:  nats.LimitsPolicy,
+		MaxAge:     30 * 24 * time.Hour,
+		Replicas:   1,
+		Duplicates: 2 * time.Minute,
+	}
+
+	// DeadLetterStream keeps the messages the services gave up on until they are replayed or deleted,
+	// its subjects are "dead_letter.<subject of the event>".
+	DeadLetterStream = StreamConfig{
+		Name:       events_pb.StreamName_DEAD_LETTER_STREAM,
+		Retention:  nats.LimitsPolicy,
+		MaxAge:     90 * 24 * time.Hour,
+		Replicas:   1,
+		Duplicates: 2 * time.Minute,
+
+		subjectPatterns: []string{deadLetterSubjectPrefix + ">"},
+	}
+)
+
+func (s StreamConfig) natsConfig() *nats.StreamConfig {
+	subjects := []string{}
+	for _, subject := range s.Subjects {
+		subjects = append(subjects, getEventName(subject))
+	}
+	if len(s.subjectPatterns) != 0 {
+		subjects = s.subjectPatterns
+	}
+
+	replicas := s.Replicas
+	if value, err := strconv.Atoi(os.Getenv("NATS_STREAM_REPLICAS")); err == nil && value > 0 {
+		replicas = value
+	}
+
+	return &nats.StreamConfig{
+		Name:       events_pb.StreamName_name[int32(s.Name)],
+		Subjects:   subjects,
+		Retention:  s.Retention,
+		MaxAge:     s.MaxAge,
+		Replicas:   max(replicas, 1),
+		Duplicates: s.Duplicates,
+	}
+}
+
+// ensureStream creates the stream, or updates it when it exists, e.g. with the subjects of an older
+// version. NATS refuses some changes of an existing stream, like its retention policy.
+func (e *EventBus) ensureStream(stream StreamConfig) error {
+	config := stream.natsConfig()
+
+	_, err := e.jetStream.StreamInfo(config.Name)
+	if errors.Is(err, nats.ErrStreamNotFound) {
+		_, err = e.jetStream.AddStream(config)
+		if err != nil {
+			return fmt.Errorf("failed to create stream %s: %w", config.Name, err)
+		}
+
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = e.jetStream.UpdateStream(config)
+	if err != nil {
+		return fmt.Errorf("failed to update stream %s: %w", config.Name, err)
+	}
+
+	return nil
+}
@@ -14,6 +14,7 @@ services=(
   "golang:deploy_service"
   "golang:log_service"
   "golang:project_service"
+  "golang:activator_service"
   "react:frontend_service"
 )
 registry_url="$(minikube ip):5000/"
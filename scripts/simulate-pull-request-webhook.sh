@@ -0,0 +1,36 @@
+#!/bin/bash
+
+# Sends a signed GitHub pull_request webhook to the gateway, for testing previews locally.
+# usage: simulate-pull-request-webhook.sh <opened|reopened|synchronize|closed> <clone_url> <pull_request_number>
+
+# Exit immediately if any command fails
+set -e
+
+action="$1"
+clone_url="$2"
+number="$3"
+gateway_url="${GATEWAY_URL:-http://localhost:8080}"
+
+if [[ -z "$action" || -z "$clone_url" || -z "$number" ]]; then
+  echo "usage: $0 <opened|reopened|synchronize|closed> <clone_url> <pull_request_number>"
+  exit 1
+fi
+
+if [[ -z "$GITHUB_WEBHOOK_SECRET" ]]; then
+  echo "GITHUB_WEBHOOK_SECRET must be set to the secret of the gateway"
+  exit 1
+fi
+
+# the pull request comes from a branch of the repository itself, previews are not deployed for forks
+full_name=$(printf '%s' "$clone_url" | sed -e 's|^https://github.com/||' -e 's|\.git$||')
+repo=$(printf '{"full_name":"%s"}' "$full_name")
+payload=$(printf '{"action":"%s","number":%d,"pull_request":{"head":{"repo":%s},"base":{"repo":%s}},"repository":{"clone_url":"%s"}}' "$action" "$number" "$repo" "$repo" "$clone_url")
+signature=$(printf '%s' "$payload" | openssl dgst -sha256 -hmac "$GITHUB_WEBHOOK_SECRET" | sed 's/^.* //')
+
+curl -sS -X POST "$gateway_url/webhooks/github" \
+  -H "Content-Type: application/json" \
+  -H "X-GitHub-Event: pull_request" \
+  -H "X-GitHub-Delivery: $(uuidgen 2>/dev/null || date +%s)" \
+  -H "X-Hub-Signature-256: sha256=$signature" \
+  -d "$payload"
+echo ""
@@ -0,0 +1,348 @@
+package activator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"apps-hosting.com/logging"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// The annotations of deploy-service, set on the Deployment of the apps scaled to zero when idle.
+const (
+	IdleTimeoutAnnotation   = "apps-hosting.com/idle-timeout"
+	DomainNameAnnotation    = "apps-hosting.com/domain-name"
+	LastRequestAtAnnotation = "apps-hosting.com/last-request-at"
+	RequestCountAnnotation  = "apps-hosting.com/request-count"
+)
+
+const (
+	// a request for an unknown domain lists the deployments again at most this often
+	refreshInterval = 5 * time.Second
+	// a started app is not checked again before this long
+	readyCacheDuration = 10 * time.Second
+	pollInterval       = 500 * time.Millisecond
+)
+
+var ErrAppNotFound = errors.New("no app is served on this domain")
+
+type app struct {
+	deploymentName string
+	proxy          *httputil.ReverseProxy
+
+	readyUntil time.Time
+	waking     *wakeCall
+
+	lastRequestAt   time.Time
+	pendingRequests int64
+}
+
+// wakeCall lets the requests arriving while an app starts wait for the same scale up.
+type wakeCall struct {
+	done chan struct{}
+	err  error
+}
+
+// Activator answers on the domain of the apps scaled to zero when idle. A request starts the app
+// when it has no running instance, waits until it is ready and is then forwarded to its service.
+// The requests are counted on the Deployment, deploy-service scales it to zero once they stop.
+type Activator struct {
+	kubernetesClient *kubernetes.Clientset
+	namespace        string
+	wakeTimeout      time.Duration
+	logger           logging.ServiceLogger
+
+	mu          sync.Mutex
+	apps        map[string]*app
+	refreshedAt time.Time
+}
+
+func NewActivator(kubernetesClient *kubernetes.Clientset, namespace string, wakeTimeout time.Duration, logger logging.ServiceLogger) *Activator {
+	return &Activator{
+		kubernetesClient: kubernetesClient,
+		namespace:        namespace,
+		wakeTimeout:      wakeTimeout,
+		logger:           logger,
+		apps:             map[string]*app{},
+	}
+}
+
+func (a *Activator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	host := r.Host
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		host = hostname
+	}
+
+	app, err := a.findApp(r.Context(), host)
+	if errors.Is(err, ErrAppNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		a.logger.LogError(err.Error())
+		http.Error(w, "failed to find the app", http.StatusBadGateway)
+		return
+	}
+
+	a.recordRequest(app)
+
+	err = a.waitUntilReady(r.Context(), app)
+	if err != nil {
+		a.logger.LogErrorF("failed to start deployment %q: %v", app.deploymentName, err)
+		http.Error(w, "the app did not start in time", http.StatusServiceUnavailable)
+		return
+	}
+
+	app.proxy.ServeHTTP(w, r)
+}
+
+// Run writes the request counts to the Deployments every interval, until ctx is done.
+func (a *Activator) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.flushRequestCounts(ctx)
+		}
+	}
+}
+
+func (a *Activator) findApp(ctx context.Context, host string) (*app, error) {
+	a.mu.Lock()
+	app, ok := a.apps[host]
+	refresh := !ok && time.Since(a.refreshedAt) >= refreshInterval
+	if refresh {
+		a.refreshedAt = time.Now()
+	}
+	a.mu.Unlock()
+
+	if ok {
+		return app, nil
+	}
+	if !refresh {
+		return nil, ErrAppNotFound
+	}
+
+	err := a.refreshApps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	app, ok = a.apps[host]
+	if !ok {
+		return nil, ErrAppNotFound
+	}
+
+	return app, nil
+}
+
+// refreshApps maps the domain names to the deployments again, the known apps keep their state.
+func (a *Activator) refreshApps(ctx context.Context) error {
+	deployments, err := a.kubernetesClient.AppsV1().Deployments(a.namespace).List(ctx, metav1.ListOptions{LabelSelector: "app_id"})
+	if err != nil {
+		return fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	apps := map[string]*app{}
+	for _, deployment := range deployments.Items {
+		domainName, ok := deployment.Annotations[DomainNameAnnotation]
+		if !ok {
+			continue
+		}
+
+		if existingApp, ok := a.apps[domainName]; ok && existingApp.deploymentName == deployment.Name {
+			apps[domainName] = existingApp
+			continue
+		}
+
+		// the service of an app is named by deploy-service after its app_name label
+		target := &url.URL{
+			Scheme: "http",
+			Host:   fmt.Sprintf("%s-service.%s.svc.cluster.local", deployment.Labels["app_name"], a.namespace),
+		}
+		apps[domainName] = a.newApp(deployment.Name, target)
+	}
+
+	a.apps = apps
+	return nil
+}
+
+func (a *Activator) newApp(deploymentName string, target *url.URL) *app {
+	app := &app{deploymentName: deploymentName}
+
+	app.proxy = httputil.NewSingleHostReverseProxy(target)
+	app.proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+		a.logger.LogErrorF("failed to proxy request to deployment %q: %v", deploymentName, err)
+
+		// the app may have been scaled down meanwhile, the next request checks it again
+		a.mu.Lock()
+		app.readyUntil = time.Time{}
+		a.mu.Unlock()
+
+		w.WriteHeader(http.StatusBadGateway)
+	}
+
+	return app
+}
+
+func (a *Activator) recordRequest(app *app) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	app.lastRequestAt = time.Now()
+	app.pendingRequests++
+}
+
+func (a *Activator) waitUntilReady(ctx context.Context, app *app) error {
+	a.mu.Lock()
+	if time.Now().Before(app.readyUntil) {
+		a.mu.Unlock()
+		return nil
+	}
+
+	call := app.waking
+	if call == nil {
+		call = &wakeCall{done: make(chan struct{})}
+		app.waking = call
+		go a.wake(app, call)
+	}
+	a.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (a *Activator) wake(app *app, call *wakeCall) {
+	err := a.scaleUp(app.deploymentName)
+
+	a.mu.Lock()
+	app.waking = nil
+	if err == nil {
+		app.readyUntil = time.Now().Add(readyCacheDuration)
+	}
+	if apierrors.IsNotFound(err) {
+		// blue/green deployments replace the Deployment, the next request finds the new one
+		for domainName, knownApp := range a.apps {
+			if knownApp == app {
+				delete(a.apps, domainName)
+			}
+		}
+	}
+	a.mu.Unlock()
+
+	call.err = err
+	close(call.done)
+}
+
+// scaleUp starts the deployment if it has no instance and waits until one is available.
+func (a *Activator) scaleUp(deploymentName string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), a.wakeTimeout)
+	defer cancel()
+
+	deploymentsClient := a.kubernetesClient.AppsV1().Deployments(a.namespace)
+
+	deployment, err := deploymentsClient.Get(ctx, deploymentName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get deployment: %w", err)
+	}
+
+	if deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == 0 {
+		// the last request is moved forward as well, otherwise the app would be stopped again right away
+		patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}},"spec":{"replicas":1}}`, LastRequestAtAnnotation, time.Now().UTC().Format(time.RFC3339))
+		_, err = deploymentsClient.Patch(ctx, deploymentName, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to scale deployment: %w", err)
+		}
+
+		a.logger.LogInfoF("Deployment %q scaled up to serve a request", deploymentName)
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if deployment.Status.AvailableReplicas >= 1 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		deployment, err = deploymentsClient.Get(ctx, deploymentName, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get deployment: %w", err)
+		}
+	}
+}
+
+func (a *Activator) flushRequestCounts(ctx context.Context) {
+	type requestCount struct {
+		deploymentName  string
+		lastRequestAt   time.Time
+		pendingRequests int64
+	}
+
+	a.mu.Lock()
+	requestCounts := []requestCount{}
+	for _, app := range a.apps {
+		if app.pendingRequests == 0 {
+			continue
+		}
+
+		requestCounts = append(requestCounts, requestCount{app.deploymentName, app.lastRequestAt, app.pendingRequests})
+		app.pendingRequests = 0
+	}
+	a.mu.Unlock()
+
+	deploymentsClient := a.kubernetesClient.AppsV1().Deployments(a.namespace)
+	for _, count := range requestCounts {
+		deployment, err := deploymentsClient.Get(ctx, count.deploymentName, metav1.GetOptions{})
+		if err != nil {
+			a.logger.LogErrorF("failed to get deployment %q: %v", count.deploymentName, err)
+			continue
+		}
+
+		// deploy-service resets the count on every deployment
+		total, _ := strconv.ParseInt(deployment.Annotations[RequestCountAnnotation], 10, 64)
+		total += count.pendingRequests
+
+		patch := fmt.Sprintf(
+			`{"metadata":{"annotations":{%q:%q,%q:%q}}}`,
+			LastRequestAtAnnotation, count.lastRequestAt.UTC().Format(time.RFC3339),
+			RequestCountAnnotation, strconv.FormatInt(total, 10),
+		)
+		_, err = deploymentsClient.Patch(ctx, count.deploymentName, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+		if err != nil {
+			a.logger.LogErrorF("failed to record requests of deployment %q: %v", count.deploymentName, err)
+		}
+	}
+}
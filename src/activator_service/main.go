@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"time"
+
+	"apps-hosting.com/activator/internal/activator"
+	"apps-hosting.com/logging"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// FIXME: must be a dynamic value, like the namespace of deploy-service
+const NAMESPACE = "default"
+
+const DefaultWakeTimeout = 2 * time.Minute
+
+func main() {
+	ctx := context.Background()
+
+	logger := logging.NewServiceLogger(logging.ServiceActivator)
+
+	logger.LogInfo("Activator running")
+
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		panic(err)
+	}
+
+	kubernetesClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		panic(err)
+	}
+
+	wakeTimeout, err := time.ParseDuration(os.Getenv("WAKE_TIMEOUT"))
+	if err != nil || wakeTimeout <= 0 {
+		wakeTimeout = DefaultWakeTimeout
+	}
+
+	appActivator := activator.NewActivator(kubernetesClient, NAMESPACE, wakeTimeout, logger)
+	go appActivator.Run(ctx, 30*time.Second)
+
+	PORT := os.Getenv("PORT")
+	if PORT == "" {
+		PORT = "8080"
+	}
+
+	logger.LogInfoF("HTTP server listening at :%s", PORT)
+	if err := http.ListenAndServe(":"+PORT, appActivator); err != nil {
+		logger.LogErrorF("failed to serve: %v", err)
+		return
+	}
+}
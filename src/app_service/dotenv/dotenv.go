@@ -0,0 +1,155 @@
+package dotenv
+
+import (
+	"bufio"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// keyPattern follows the POSIX rules for portable environment variable names.
+var keyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+type Variable struct {
+	Key   string
+	Value string
+}
+
+func IsValidKey(key string) bool {
+	return keyPattern.MatchString(key)
+}
+
+// Parse reads a .env file. Blank lines and comments are ignored, an optional
+// "export " prefix is accepted, single quoted values are taken literally and
+// double quoted values support the \n, \r, \t, \" and \\ escapes.
+// When a key is repeated the last value wins.
+func Parse(content string) ([]Variable, error) {
+	variables := []Variable{}
+	indexes := map[string]int{}
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		line = strings.TrimPrefix(line, "export ")
+
+		key, rawValue, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected KEY=VALUE", lineNumber)
+		}
+
+		key = strings.TrimSpace(key)
+		if !IsValidKey(key) {
+			return nil, fmt.Errorf("line %d: invalid variable name '%s'", lineNumber, key)
+		}
+
+		value, err := parseValue(strings.TrimSpace(rawValue))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+
+		if index, ok := indexes[key]; ok {
+			variables[index].Value = value
+			continue
+		}
+
+		indexes[key] = len(variables)
+		variables = append(variables, Variable{Key: key, Value: value})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return variables, nil
+}
+
+// Format writes the variables sorted by key, quoting the values that need it.
+func Format(values map[string]string) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	builder := strings.Builder{}
+	for _, key := range keys {
+		builder.WriteString(key)
+		builder.WriteString("=")
+		builder.WriteString(formatValue(values[key]))
+		builder.WriteString("\n")
+	}
+
+	return builder.String()
+}
+
+func parseValue(rawValue string) (string, error) {
+	if len(rawValue) == 0 {
+		return "", nil
+	}
+
+	switch rawValue[0] {
+	case '\'':
+		end := strings.IndexByte(rawValue[1:], '\'')
+		if end == -1 {
+			return "", fmt.Errorf("unterminated single quoted value")
+		}
+		return rawValue[1 : end+1], nil
+	case '"':
+		builder := strings.Builder{}
+		for i := 1; i < len(rawValue); i++ {
+			c := rawValue[i]
+			if c == '"' {
+				return builder.String(), nil
+			}
+
+			if c == '\\' && i+1 < len(rawValue) {
+				i++
+				switch rawValue[i] {
+				case 'n':
+					builder.WriteByte('\n')
+				case 'r':
+					builder.WriteByte('\r')
+				case 't':
+					builder.WriteByte('\t')
+				default:
+					builder.WriteByte(rawValue[i])
+				}
+				continue
+			}
+
+			builder.WriteByte(c)
+		}
+		return "", fmt.Errorf("unterminated double quoted value")
+	}
+
+	// Unquoted values end at an inline comment.
+	if index := strings.Index(rawValue, " #"); index != -1 {
+		rawValue = rawValue[:index]
+	}
+
+	return strings.TrimSpace(rawValue), nil
+}
+
+func formatValue(value string) string {
+	if len(value) > 0 && !strings.ContainsAny(value, " \t\r\n\"'#\\=$`") {
+		return value
+	}
+
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+	)
+
+	return `"` + replacer.Replace(value) + `"`
+}
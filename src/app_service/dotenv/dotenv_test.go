@@ -0,0 +1,137 @@
+package dotenv
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		expected      []Variable
+		expectedError bool
+	}{
+		{
+			name:     "unquoted values",
+			content:  "PORT=8080\nHOST = localhost \nEMPTY=\n",
+			expected: []Variable{{"PORT", "8080"}, {"HOST", "localhost"}, {"EMPTY", ""}},
+		},
+		{
+			name:     "single quoted values are taken literally",
+			content:  `GREETING='hello \n "world" # not a comment'`,
+			expected: []Variable{{"GREETING", `hello \n "world" # not a comment`}},
+		},
+		{
+			name:     "double quoted values with escapes",
+			content:  `MESSAGE="line 1\nline 2\ttab \"quoted\" back\\slash\r"`,
+			expected: []Variable{{"MESSAGE", "line 1\nline 2\ttab \"quoted\" back\\slash\r"}},
+		},
+		{
+			name:     "export prefix",
+			content:  "export DATABASE_URL=postgres://db:5432/app\n",
+			expected: []Variable{{"DATABASE_URL", "postgres://db:5432/app"}},
+		},
+		{
+			name:     "comments and blank lines",
+			content:  "# the port\n\n  # indented comment\nPORT=8080 # inline comment\nCOLOR=#fff\n",
+			expected: []Variable{{"PORT", "8080"}, {"COLOR", "#fff"}},
+		},
+		{
+			name:     "the last value of a repeated key wins",
+			content:  "PORT=8080\nHOST=localhost\nPORT=9090\n",
+			expected: []Variable{{"PORT", "9090"}, {"HOST", "localhost"}},
+		},
+		{
+			name:          "line without equal sign",
+			content:       "PORT\n",
+			expectedError: true,
+		},
+		{
+			name:          "invalid key",
+			content:       "1PORT=8080\n",
+			expectedError: true,
+		},
+		{
+			name:          "unterminated single quoted value",
+			content:       "NAME='web\n",
+			expectedError: true,
+		},
+		{
+			name:          "unterminated double quoted value",
+			content:       `NAME="web`,
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			variables, err := Parse(test.content)
+			if test.expectedError {
+				if err == nil {
+					t.Errorf("Parse returned %v, expected an error", variables)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if !slices.Equal(variables, test.expected) {
+				t.Errorf("Parse returned %v, expected %v", variables, test.expected)
+			}
+		})
+	}
+}
+
+func TestIsValidKey(t *testing.T) {
+	tests := []struct {
+		key      string
+		expected bool
+	}{
+		{"PORT", true},
+		{"_PRIVATE", true},
+		{"database_url_2", true},
+		{"", false},
+		{"2FA_SECRET", false},
+		{"API-KEY", false},
+		{"API KEY", false},
+		{"API.KEY", false},
+	}
+
+	for _, test := range tests {
+		if valid := IsValidKey(test.key); valid != test.expected {
+			t.Errorf("IsValidKey(%q) is %t, expected %t", test.key, valid, test.expected)
+		}
+	}
+}
+
+func TestFormatRoundTrip(t *testing.T) {
+	values := map[string]string{
+		"PORT":      "8080",
+		"EMPTY":     "",
+		"SPACES":    "  hello world  ",
+		"MULTILINE": "line 1\nline 2\r\n\tindented",
+		"QUOTES":    `it's "quoted"`,
+		"SYMBOLS":   `#not-a-comment $HOME a=b \back\slash` + "`cmd`",
+	}
+
+	variables, err := Parse(Format(values))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	parsed := map[string]string{}
+	for _, variable := range variables {
+		parsed[variable.Key] = variable.Value
+	}
+
+	if len(parsed) != len(values) {
+		t.Errorf("%d variables parsed, expected %d", len(parsed), len(values))
+	}
+	for key, value := range values {
+		if parsed[key] != value {
+			t.Errorf("%s is %q after the round trip, expected %q", key, parsed[key], value)
+		}
+	}
+}
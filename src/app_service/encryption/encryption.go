@@ -0,0 +1,113 @@
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+)
+
+const dataKeySize = 32
+
+var ErrInvalidCiphertext = errors.New("invalid ciphertext")
+
+// KeyProvider wraps and unwraps data keys with a key encryption key that
+// never leaves the provider (local master key, KMS, Vault, ...).
+type KeyProvider interface {
+	KeyId() string
+	WrapKey(ctx context.Context, dataKey []byte) ([]byte, error)
+	UnwrapKey(ctx context.Context, keyId string, wrappedKey []byte) ([]byte, error)
+}
+
+type EncryptedValue struct {
+	Ciphertext []byte
+	WrappedKey []byte
+	KeyId      string
+}
+
+// EnvelopeEncryptor encrypts every value with its own random data key and
+// stores that data key wrapped by the KeyProvider next to the ciphertext.
+type EnvelopeEncryptor struct {
+	keyProvider KeyProvider
+}
+
+func NewEnvelopeEncryptor(keyProvider KeyProvider) EnvelopeEncryptor {
+	return EnvelopeEncryptor{keyProvider: keyProvider}
+}
+
+func (e *EnvelopeEncryptor) Encrypt(ctx context.Context, plaintext []byte) (*EncryptedValue, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	ciphertext, err := seal(dataKey, plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedKey, err := e.keyProvider.WrapKey(ctx, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap data key: %w", err)
+	}
+
+	return &EncryptedValue{
+		Ciphertext: ciphertext,
+		WrappedKey: wrappedKey,
+		KeyId:      e.keyProvider.KeyId(),
+	}, nil
+}
+
+func (e *EnvelopeEncryptor) Decrypt(ctx context.Context, encryptedValue *EncryptedValue) ([]byte, error) {
+	dataKey, err := e.keyProvider.UnwrapKey(ctx, encryptedValue.KeyId, encryptedValue.WrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+
+	return open(dataKey, encryptedValue.Ciphertext)
+}
+
+// seal encrypts plaintext with AES-256-GCM and prepends the random nonce.
+func seal(key, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(key, ciphertext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, ErrInvalidCiphertext
+	}
+
+	nonce, sealed := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, ErrInvalidCiphertext
+	}
+
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,46 @@
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+var ErrUnknownKeyId = errors.New("unknown key id")
+
+// LocalKeyProvider wraps data keys with a master key loaded from the
+// service configuration.
+type LocalKeyProvider struct {
+	keyId     string
+	masterKey []byte
+}
+
+func NewLocalKeyProvider(keyId, base64MasterKey string) (*LocalKeyProvider, error) {
+	masterKey, err := base64.StdEncoding.DecodeString(base64MasterKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode master key: %w", err)
+	}
+
+	if len(masterKey) != dataKeySize {
+		return nil, fmt.Errorf("master key must be %d bytes, got %d", dataKeySize, len(masterKey))
+	}
+
+	return &LocalKeyProvider{keyId: keyId, masterKey: masterKey}, nil
+}
+
+func (p *LocalKeyProvider) KeyId() string {
+	return p.keyId
+}
+
+func (p *LocalKeyProvider) WrapKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+	return seal(p.masterKey, dataKey)
+}
+
+func (p *LocalKeyProvider) UnwrapKey(ctx context.Context, keyId string, wrappedKey []byte) ([]byte, error) {
+	if keyId != p.keyId {
+		return nil, ErrUnknownKeyId
+	}
+
+	return open(p.masterKey, wrappedKey)
+}
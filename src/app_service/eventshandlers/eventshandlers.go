@@ -5,7 +5,9 @@ import (
 	"context"
 
 	"apps-hosting.com/messaging"
+	"apps-hosting.com/messaging/outbox"
 	"apps-hosting.com/messaging/proto/events_pb"
+	"github.com/uptrace/bun"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
@@ -13,30 +15,42 @@ import (
 )
 
 type EventsHandlers struct {
-	eventBus                       messaging.EventBus
+	database                       *bun.DB
+	outboxRelay                    *outbox.Relay
+	eventBus                       messaging.Bus
 	appRepository                  repositories.AppRepository
 	environmentVariablesRepository repositories.EnvironmentVariablesRepository
+	environmentGroupsRepository    repositories.EnvironmentGroupsRepository
+	diskRepository                 repositories.DiskRepository
 	gitRepositoryRepository        repositories.GitRepositoryRepository
 	logger                         logging.ServiceLogger
 }
 
 func NewEventsHandlers(
-	eventBus messaging.EventBus,
+	database *bun.DB,
+	outboxRelay *outbox.Relay,
+	eventBus messaging.Bus,
 	appRepository repositories.AppRepository,
 	environmentVariablesRepository repositories.EnvironmentVariablesRepository,
+	environmentGroupsRepository repositories.EnvironmentGroupsRepository,
+	diskRepository repositories.DiskRepository,
 	gitRepositoryRepository repositories.GitRepositoryRepository,
 	logger logging.ServiceLogger,
 ) EventsHandlers {
 	return EventsHandlers{
+		database:                       database,
+		outboxRelay:                    outboxRelay,
 		eventBus:                       eventBus,
 		appRepository:                  appRepository,
 		environmentVariablesRepository: environmentVariablesRepository,
+		environmentGroupsRepository:    environmentGroupsRepository,
+		diskRepository:                 diskRepository,
 		gitRepositoryRepository:        gitRepositoryRepository,
 		logger:                         logger,
 	}
 }
 
-func (h *EventsHandlers) HandleProjectDeletedEvent(ctx context.Context, message *events_pb.Message) {
+func (h *EventsHandlers) HandleProjectDeletedEvent(ctx context.Context, message *events_pb.Message) error {
 	h.logger.LogInfo("Handle 'project.deleted' event")
 	span := trace.SpanFromContext(ctx)
 
@@ -44,7 +58,7 @@ func (h *EventsHandlers) HandleProjectDeletedEvent(ctx context.Context, message
 	if data == nil {
 		h.logger.LogError("Invalid project deleted message")
 		span.SetAttributes(attribute.String("error", "Invalid project deleted message"))
-		return
+		return messaging.ErrInvalidMessage
 	}
 
 	span.SetAttributes(attribute.String("project.id", data.ProjectId))
@@ -53,7 +67,7 @@ func (h *EventsHandlers) HandleProjectDeletedEvent(ctx context.Context, message
 	if err != nil {
 		h.logger.LogError(err.Error())
 		span.SetAttributes(attribute.String("error", err.Error()))
-		return
+		return err
 	}
 
 	appIds := make([]string, len(apps))
@@ -61,32 +75,149 @@ func (h *EventsHandlers) HandleProjectDeletedEvent(ctx context.Context, message
 		appIds[i] = app.Id
 	}
 
-	if err := h.environmentVariablesRepository.DeleteEnvironmentVariablesByAppIds(ctx, appIds); err != nil {
+	// the apps are only deleted with their 'app.deleted' events, so that their resources are released
+	err = h.database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		environmentVariablesRepository := h.environmentVariablesRepository.WithTx(tx)
+		if err := environmentVariablesRepository.DeleteEnvironmentVariablesByAppIds(ctx, appIds); err != nil {
+			return err
+		}
+
+		environmentGroupsRepository := h.environmentGroupsRepository.WithTx(tx)
+		if err := environmentGroupsRepository.DeleteAppLinksByAppIds(ctx, appIds); err != nil {
+			return err
+		}
+
+		if err := environmentGroupsRepository.DeleteEnvironmentGroupsByProjectId(ctx, data.ProjectId); err != nil {
+			return err
+		}
+
+		diskRepository := h.diskRepository.WithTx(tx)
+		if err := diskRepository.DeleteDisksByAppIds(ctx, appIds); err != nil {
+			return err
+		}
+
+		gitRepositoryRepository := h.gitRepositoryRepository.WithTx(tx)
+		if err := gitRepositoryRepository.DeleteGitRepositoriessByAppIds(ctx, appIds); err != nil {
+			return err
+		}
+
+		appRepository := h.appRepository.WithTx(tx)
+		if err := appRepository.DeleteAppsByProjectId(ctx, data.ProjectId); err != nil {
+			return err
+		}
+
+		for _, app := range apps {
+			err := outbox.Enqueue(ctx, tx, events_pb.EventName_APP_DELETED, &events_pb.EventData{
+				Value: &events_pb.EventData_AppDeletedData{
+					AppDeletedData: &events_pb.AppDeletedEventData{
+						AppId:   app.Id,
+						AppName: app.Name,
+					},
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
 		h.logger.LogError(err.Error())
 		span.SetAttributes(attribute.String("error", err.Error()))
-		return
+		return err
 	}
 
-	if err := h.gitRepositoryRepository.DeleteGitRepositoriessByAppIds(ctx, appIds); err != nil {
-		h.logger.LogError(err.Error())
-		span.SetAttributes(attribute.String("error", err.Error()))
-		return
+	h.outboxRelay.Notify()
+	return nil
+}
+
+func (h *EventsHandlers) HandleBuildCompletedEvent(ctx context.Context, message *events_pb.Message) error {
+	h.logger.LogInfo("Handle 'build.completed' event")
+	span := trace.SpanFromContext(ctx)
+
+	data := message.Data.GetBuildCompletedData()
+	if data == nil {
+		h.logger.LogError("Invalid build completed message")
+		span.SetAttributes(attribute.String("error", "Invalid build completed message"))
+		return messaging.ErrInvalidMessage
+	}
+
+	return h.updateAppStatus(ctx, data.AppId, repositories.AppStatusDeploying, repositories.UpdateAppStatusParams{
+		BuildId:    data.BuildId,
+		CommitHash: data.CommitHash,
+	})
+}
+
+func (h *EventsHandlers) HandleBuildFailedEvent(ctx context.Context, message *events_pb.Message) error {
+	h.logger.LogInfo("Handle 'build.failed' event")
+	span := trace.SpanFromContext(ctx)
+
+	data := message.Data.GetBuildFailedData()
+	if data == nil {
+		h.logger.LogError("Invalid build failed message")
+		span.SetAttributes(attribute.String("error", "Invalid build failed message"))
+		return messaging.ErrInvalidMessage
 	}
 
-	if err := h.appRepository.DeleteAppsByProjectId(ctx, data.ProjectId); err != nil {
+	return h.updateAppStatus(ctx, data.AppId, repositories.AppStatusBuildFailed, repositories.UpdateAppStatusParams{
+		BuildId: data.BuildId,
+	})
+}
+
+func (h *EventsHandlers) HandleDeployCompletedEvent(ctx context.Context, message *events_pb.Message) error {
+	h.logger.LogInfo("Handle 'deploy.completed' event")
+	span := trace.SpanFromContext(ctx)
+
+	data := message.Data.GetDeployCompletedData()
+	if data == nil {
+		h.logger.LogError("Invalid deploy completed message")
+		span.SetAttributes(attribute.String("error", "Invalid deploy completed message"))
+		return messaging.ErrInvalidMessage
+	}
+
+	return h.updateAppStatus(ctx, data.AppId, repositories.AppStatusDeployed, repositories.UpdateAppStatusParams{
+		BuildId: data.BuildId,
+	})
+}
+
+func (h *EventsHandlers) HandleDeployFailedEvent(ctx context.Context, message *events_pb.Message) error {
+	h.logger.LogInfo("Handle 'deploy.failed' event")
+	span := trace.SpanFromContext(ctx)
+
+	data := message.Data.GetDeployFailedData()
+	if data == nil {
+		h.logger.LogError("Invalid deploy failed message")
+		span.SetAttributes(attribute.String("error", "Invalid deploy failed message"))
+		return messaging.ErrInvalidMessage
+	}
+
+	return h.updateAppStatus(ctx, data.AppId, repositories.AppStatusDeployFailed, repositories.UpdateAppStatusParams{
+		BuildId: data.BuildId,
+	})
+}
+
+func (h *EventsHandlers) updateAppStatus(ctx context.Context, appId string, status repositories.AppStatus, updateAppStatusParams repositories.UpdateAppStatusParams) error {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("app.id", appId),
+		attribute.String("app.status", string(status)),
+		attribute.String("build.id", updateAppStatusParams.BuildId),
+	)
+
+	_, err := h.appRepository.UpdateAppStatus(ctx, appId, status, updateAppStatusParams)
+	if err == repositories.ErrInvalidAppStatusTransition || err == repositories.ErrAppNotFound {
+		// an event of an earlier build, or of an app deleted since
+		h.logger.LogInfoF("App '%s' not moved to status '%s': %s", appId, status, err.Error())
+		return nil
+	}
+
+	if err != nil {
 		h.logger.LogError(err.Error())
 		span.SetAttributes(attribute.String("error", err.Error()))
-		return
+		return err
 	}
 
-	for _, app := range apps {
-		h.eventBus.Publish(ctx, events_pb.EventName_APP_DELETED, &events_pb.EventData{
-			Value: &events_pb.EventData_AppDeletedData{
-				AppDeletedData: &events_pb.AppDeletedEventData{
-					AppId:   app.Id,
-					AppName: app.Name,
-				},
-			},
-		})
-	}
+	return nil
 }
@@ -0,0 +1,222 @@
+package eventshandlers
+
+import (
+	"app/repositories"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"apps-hosting.com/logging"
+	"apps-hosting.com/messaging"
+	"apps-hosting.com/messaging/proto/events_pb"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+var appIdPattern = regexp.MustCompile(`\(id = '([^']*)'\)`)
+
+// fakeDatabase answers the queries updating the status of the apps, it fails the first queries with
+// failure. Its connections only serve queries, which is all updateAppStatus needs.
+type fakeDatabase struct {
+	mutex    sync.Mutex
+	failures int
+	failure  error
+	apps     map[string]bool
+	updates  int
+}
+
+func (d *fakeDatabase) Connect(ctx context.Context) (driver.Conn, error) {
+	return fakeConn{database: d}, nil
+}
+
+func (d *fakeDatabase) Driver() driver.Driver {
+	return nil
+}
+
+func (d *fakeDatabase) query(query string) (driver.Rows, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.failures > 0 {
+		d.failures--
+		return nil, d.failure
+	}
+
+	match := appIdPattern.FindStringSubmatch(query)
+	if match == nil {
+		return nil, errors.New("unexpected query: " + query)
+	}
+	exists := d.apps[match[1]]
+
+	switch {
+	case strings.HasPrefix(query, "UPDATE"):
+		d.updates++
+		rows := &fakeRows{columns: []string{"id", "status"}}
+		if exists {
+			rows.values = [][]driver.Value{{match[1], "updated"}}
+		}
+		return rows, nil
+	case strings.HasPrefix(query, "SELECT EXISTS"):
+		return &fakeRows{columns: []string{"exists"}, values: [][]driver.Value{{exists}}}, nil
+	}
+
+	return nil, errors.New("unexpected query: " + query)
+}
+
+type fakeConn struct {
+	database *fakeDatabase
+}
+
+func (c fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported")
+}
+
+func (c fakeConn) Close() error {
+	return nil
+}
+
+func (c fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+func (c fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return c.database.query(query)
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func TestHandleBuildFailedEvent(t *testing.T) {
+	buildFailedData := &events_pb.EventData{
+		Value: &events_pb.EventData_BuildFailedData{
+			BuildFailedData: &events_pb.BuildFailedData{AppId: "app-1", BuildId: "build-1", Reason: "exit status 1"},
+		},
+	}
+	transientFailure := errors.New("connection reset by peer")
+
+	tests := []struct {
+		name               string
+		data               *events_pb.EventData
+		apps               map[string]bool
+		failures           int
+		expectedUpdates    int
+		expectedDeliveries uint64
+	}{
+		{
+			name:            "app status updated on the first delivery",
+			data:            buildFailedData,
+			apps:            map[string]bool{"app-1": true},
+			expectedUpdates: 1,
+		},
+		{
+			name:            "delivered again while the database fails",
+			data:            buildFailedData,
+			apps:            map[string]bool{"app-1": true},
+			failures:        2,
+			expectedUpdates: 1,
+		},
+		{
+			name:               "dead-lettered once every delivery failed",
+			data:               buildFailedData,
+			apps:               map[string]bool{"app-1": true},
+			failures:           messaging.DefaultMaxDeliver,
+			expectedDeliveries: messaging.DefaultMaxDeliver,
+		},
+		{
+			name:            "app deleted since",
+			data:            buildFailedData,
+			apps:            map[string]bool{},
+			expectedUpdates: 1,
+		},
+		{
+			name: "invalid message dead-lettered right away",
+			data: &events_pb.EventData{
+				Value: &events_pb.EventData_BuildCompletedData{
+					BuildCompletedData: &events_pb.BuildCompletedData{AppId: "app-1", BuildId: "build-1"},
+				},
+			},
+			apps:               map[string]bool{"app-1": true},
+			expectedDeliveries: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			database := &fakeDatabase{failures: test.failures, failure: transientFailure, apps: test.apps}
+			db := bun.NewDB(sql.OpenDB(database), pgdialect.New())
+			defer db.Close()
+
+			logger := logging.NewServiceLogger(logging.ServiceApp)
+			eventBus := messaging.NewMemoryBus("app-service")
+			eventsHandlers := NewEventsHandlers(
+				db,
+				nil,
+				eventBus,
+				repositories.NewAppRepository(db, logger),
+				repositories.EnvironmentVariablesRepository{},
+				repositories.EnvironmentGroupsRepository{},
+				repositories.DiskRepository{},
+				repositories.GitRepositoryRepository{},
+				logger,
+			)
+
+			err := eventBus.Subscribe(events_pb.EventName_BUILD_FAILED, eventsHandlers.HandleBuildFailedEvent)
+			if err != nil {
+				t.Fatalf("Subscribe: %v", err)
+			}
+
+			err = eventBus.Publish(context.Background(), events_pb.EventName_BUILD_FAILED, test.data)
+			if err != nil {
+				t.Fatalf("Publish: %v", err)
+			}
+
+			if database.updates != test.expectedUpdates {
+				t.Errorf("app status updated %d times, expected %d", database.updates, test.expectedUpdates)
+			}
+
+			deadLetters := eventBus.DeadLetters()
+			if test.expectedDeliveries == 0 {
+				if len(deadLetters) != 0 {
+					t.Errorf("%d dead letters, expected none", len(deadLetters))
+				}
+				return
+			}
+
+			if len(deadLetters) != 1 {
+				t.Fatalf("%d dead letters, expected 1", len(deadLetters))
+			}
+			if deadLetters[0].Deliveries != test.expectedDeliveries {
+				t.Errorf("dead-lettered after %d deliveries, expected %d", deadLetters[0].Deliveries, test.expectedDeliveries)
+			}
+			if deadLetters[0].Consumer != "app-service-build-failed" {
+				t.Errorf("dead-lettered by %q, expected app-service-build-failed", deadLetters[0].Consumer)
+			}
+		})
+	}
+}
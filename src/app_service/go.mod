@@ -3,8 +3,8 @@ module app
 go 1.23.5
 
 require (
-	apps-hosting.com/logging v0.0.1-20251127192047-9a4b3aa8018d
-	apps-hosting.com/messaging v0.0.1-20251127192047-9a4b3aa8018d
+	apps-hosting.com/logging v0.0.1-20261019033112-43aa3e925870
+	apps-hosting.com/messaging v0.0.1-20261019040005-85cad14baaac
 	github.com/google/uuid v1.6.0
 	github.com/uptrace/bun v1.2.15
 	github.com/uptrace/bun/extra/bunotel v1.2.15
@@ -1,6 +1,7 @@
 package grpc_server
 
 import (
+	"app/dotenv"
 	"app/proto/app_service_pb"
 	"app/repositories"
 	"app/utils"
@@ -9,14 +10,15 @@ import (
 	"slices"
 
 	"apps-hosting.com/messaging"
+	"apps-hosting.com/messaging/outbox"
 	"apps-hosting.com/messaging/proto/events_pb"
-	"apps-hosting.com/messaging/proto/models_pb"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
 	"apps-hosting.com/logging"
 
 	"github.com/google/uuid"
+	"github.com/uptrace/bun"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -24,25 +26,37 @@ import (
 type GRPCAppServiceServer struct {
 	app_service_pb.UnimplementedAppServiceServer
 
+	Database                       *bun.DB
 	AppRepository                  repositories.AppRepository
 	EnvironmentVariablesRepository repositories.EnvironmentVariablesRepository
+	EnvironmentGroupsRepository    repositories.EnvironmentGroupsRepository
+	DiskRepository                 repositories.DiskRepository
 	GitRepositoryRepository        repositories.GitRepositoryRepository
-	EventBus                       messaging.EventBus
+	EventBus                       messaging.Bus
+	OutboxRelay                    *outbox.Relay
 	Logger                         logging.ServiceLogger
 }
 
 func NewGRPCAppServiceServer(
+	database *bun.DB,
 	appRepository repositories.AppRepository,
 	environmentVariablesRepository repositories.EnvironmentVariablesRepository,
+	environmentGroupsRepository repositories.EnvironmentGroupsRepository,
+	diskRepository repositories.DiskRepository,
 	gitRepositoryRepository repositories.GitRepositoryRepository,
-	eventBus messaging.EventBus,
+	eventBus messaging.Bus,
+	outboxRelay *outbox.Relay,
 	logger logging.ServiceLogger,
 ) *GRPCAppServiceServer {
 	return &GRPCAppServiceServer{
+		Database:                       database,
 		AppRepository:                  appRepository,
 		EnvironmentVariablesRepository: environmentVariablesRepository,
+		EnvironmentGroupsRepository:    environmentGroupsRepository,
+		DiskRepository:                 diskRepository,
 		GitRepositoryRepository:        gitRepositoryRepository,
 		EventBus:                       eventBus,
+		OutboxRelay:                    outboxRelay,
 		Logger:                         logger,
 	}
 }
@@ -70,128 +84,164 @@ func (server *GRPCAppServiceServer) CreateApp(ctx context.Context, createAppRequ
 		return nil, status.Error(codes.InvalidArgument, "Unsupported runtime")
 	}
 
+	appType := repositories.AppType(createAppRequest.Type)
+	if len(appType) == 0 {
+		appType = repositories.AppTypeWebService
+	}
+
+	if !slices.Contains(repositories.AppTypes, appType) {
+		return nil, status.Error(codes.InvalidArgument, "Unsupported app type")
+	}
+
+	if err := ValidateSchedule(appType, createAppRequest.Schedule); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	publishDir, err := NormalizePublishDir(appType, createAppRequest.PublishDir)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := ValidatePreviewsEnabled(appType, createAppRequest.PreviewsEnabled); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := ValidateScaleToZero(appType, createAppRequest.ScaleToZeroIdleMinutes); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	deploymentStrategy, canaryWeight := NormalizeDeploymentStrategy(
+		repositories.DeploymentStrategy(createAppRequest.DeploymentStrategy),
+		createAppRequest.CanaryWeight,
+	)
+
+	if err := ValidateDeploymentStrategy(appType, deploymentStrategy, canaryWeight, createAppRequest.ScaleToZeroIdleMinutes, false); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := ValidateRollingUpdate(createAppRequest.RollingMaxSurge, createAppRequest.RollingMaxUnavailable); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := ValidateDeployCommands(appType, createAppRequest.PreDeployCmd, createAppRequest.PostDeployCmd); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	repoURL, err := url.Parse(createAppRequest.GitRepository.CloneUrl)
 	if err != nil || repoURL.Hostname() != "github.com" {
 		return nil, status.Error(codes.InvalidArgument, "Invalid GitHub URL")
 	}
 
-	createdApp, err := server.AppRepository.CreateApp(ctx, createAppRequest.ProjectId, repositories.CreateAppParams{
+	environmentVariables := map[string]string{}
+	if createAppRequest.EnvironmentVariables != nil {
+		environmentVariables, err = ParseEnvironmentVariablesJSON(*createAppRequest.EnvironmentVariables)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	createAppParams := repositories.CreateAppParams{
 		Name:       createAppRequest.Name,
 		Runtime:    createAppRequest.Runtime,
 		RepoURL:    createAppRequest.GitRepository.CloneUrl,
 		StartCMD:   createAppRequest.StartCmd,
 		BuildCMD:   createAppRequest.BuildCmd,
 		DomainName: utils.GetDomainName(createAppRequest.Name),
-	})
+		Type:       appType,
+		Schedule:   createAppRequest.Schedule,
+		PublishDir: publishDir,
 
-	if err == repositories.ErrDomainNameInUse {
-		appName := createAppRequest.Name + "-" + uuid.NewString()
-		createdApp, err = server.AppRepository.CreateApp(ctx, createAppRequest.ProjectId, repositories.CreateAppParams{
-			Name:       createAppRequest.Name,
-			Runtime:    createAppRequest.Runtime,
-			RepoURL:    createAppRequest.GitRepository.CloneUrl,
-			StartCMD:   createAppRequest.StartCmd,
-			BuildCMD:   createAppRequest.BuildCmd,
-			DomainName: utils.GetDomainName(appName),
-		})
-	}
+		PreviewsEnabled:        createAppRequest.PreviewsEnabled,
+		ScaleToZeroIdleMinutes: createAppRequest.ScaleToZeroIdleMinutes,
 
-	if err == repositories.ErrAppNameInUse {
-		span.SetAttributes(attribute.String("error", err.Error()))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
+		DeploymentStrategy:    deploymentStrategy,
+		RollingMaxSurge:       createAppRequest.RollingMaxSurge,
+		RollingMaxUnavailable: createAppRequest.RollingMaxUnavailable,
+		CanaryWeight:          canaryWeight,
 
-	if err != nil {
-		server.Logger.LogError(err.Error())
-		span.SetAttributes(attribute.String("error", err.Error()))
-		return nil, status.Error(codes.Internal, err.Error())
+		PreDeployCMD:  createAppRequest.PreDeployCmd,
+		PostDeployCMD: createAppRequest.PostDeployCmd,
 	}
 
-	server.Logger.LogInfo("App created successfully")
-
-	environmentVariables := models_pb.EnvironmentVariable{}
-	if createAppRequest.EnvironmentVariables != nil {
-		createdEnvironmentVariables, err := server.EnvironmentVariablesRepository.CreateEnvironmentVariables(ctx, createdApp.Id, repositories.CreateEnvironmentVariableParams{
-			Value: *createAppRequest.EnvironmentVariables,
-		})
+	// the app is only created with its 'app.created' event, so that it is always built
+	var createdApp *repositories.App
+	err = server.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// a failed insert aborts the transaction, so every attempt runs in a savepoint
+		createApp := func() error {
+			return tx.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+				appRepository := server.AppRepository.WithTx(tx)
+
+				var err error
+				createdApp, err = appRepository.CreateApp(ctx, createAppRequest.ProjectId, createAppParams)
+				return err
+			})
+		}
 
+		err := createApp()
+		if err == repositories.ErrDomainNameInUse {
+			createAppParams.DomainName = utils.GetDomainName(createAppRequest.Name + "-" + uuid.NewString())
+			err = createApp()
+		}
 		if err != nil {
-			span.SetAttributes(attribute.String("error", err.Error()))
-			return nil, status.Error(codes.Internal, err.Error())
+			return err
 		}
 
-		environmentVariables.Id = createdEnvironmentVariables.Id
-		environmentVariables.AppId = createdEnvironmentVariables.AppId
-		environmentVariables.Value = createdEnvironmentVariables.Value
-		environmentVariables.CreatedAt = createdEnvironmentVariables.CreatedAt.String()
+		server.Logger.LogInfo("App created successfully")
 
-		server.Logger.LogInfo("Environment variables created successfully")
-	}
+		if createAppRequest.EnvironmentVariables != nil {
+			setEnvironmentVariablesParams := make([]repositories.SetEnvironmentVariableParams, 0, len(environmentVariables))
+			for key, value := range environmentVariables {
+				setEnvironmentVariablesParams = append(setEnvironmentVariablesParams, repositories.SetEnvironmentVariableParams{Key: key, Value: value})
+			}
 
-	span.SetAttributes(attribute.String("app.id", createdApp.Id))
+			environmentVariablesRepository := server.EnvironmentVariablesRepository.WithTx(tx)
+			_, err := environmentVariablesRepository.SetEnvironmentVariables(ctx, createdApp.Id, setEnvironmentVariablesParams)
+			if err != nil {
+				return err
+			}
 
-	createdGitRepository, err := server.GitRepositoryRepository.CreateGitRepository(ctx, createdApp.Id, repositories.CreateGitRepository{
-		Provider:  createAppRequest.GitRepository.Provider,
-		CloneURL:  createAppRequest.GitRepository.CloneUrl,
-		IsPrivate: createAppRequest.GitRepository.IsPrivate,
-	})
+			server.Logger.LogInfo("Environment variables created successfully")
+		}
 
-	if err != nil {
-		server.Logger.LogError(err.Error())
-		span.SetAttributes(attribute.String("error", err.Error()))
-		return nil, status.Error(codes.Internal, err.Error())
-	}
+		span.SetAttributes(attribute.String("app.id", createdApp.Id))
 
-	// FIXME: Mapping should be done in the gateway-service
-	gitProvider := map[string]models_pb.GitProvider{
-		"github": models_pb.GitProvider_GITHUB,
-	}
+		gitRepositoryRepository := server.GitRepositoryRepository.WithTx(tx)
+		createdGitRepository, err := gitRepositoryRepository.CreateGitRepository(ctx, createdApp.Id, repositories.CreateGitRepository{
+			Provider:  createAppRequest.GitRepository.Provider,
+			CloneURL:  createAppRequest.GitRepository.CloneUrl,
+			IsPrivate: createAppRequest.GitRepository.IsPrivate,
+		})
+		if err != nil {
+			return err
+		}
 
-	server.Logger.LogInfo("Send AppCreated Event")
-	err = server.EventBus.Publish(ctx, events_pb.EventName_APP_CREATED, &events_pb.EventData{
-		Value: &events_pb.EventData_AppCreatedData{
-			AppCreatedData: &events_pb.AppCreatedEventData{
-				App: &models_pb.App{
-					Id:         createdApp.Id,
-					ProjectId:  createdApp.ProjectId,
-					Name:       createdApp.Name,
-					DomainName: createdApp.DomainName,
-					Runtime:    createdApp.Runtime,
-					RepoUrl:    createdApp.RepoURL,
-					BuildCmd:   createdApp.BuildCMD,
-					StartCmd:   createdApp.StartCMD,
-					CreatedAt:  createdApp.CreatedAt.String(),
-				},
-				EnvironmentVariable: &environmentVariables,
-				GitRepository: &models_pb.GitRepository{
-					Id:        createdGitRepository.Id,
-					AppId:     createdGitRepository.AppId,
-					CloneUrl:  createdGitRepository.CloneURL,
-					IsPrivate: createdGitRepository.IsPrivate,
-					Provider:  gitProvider[createdGitRepository.Provider],
-					CreatedAt: createdGitRepository.CreatedAt.String(),
+		server.Logger.LogInfo("Send AppCreated Event")
+		return outbox.Enqueue(ctx, tx, events_pb.EventName_APP_CREATED, &events_pb.EventData{
+			Value: &events_pb.EventData_AppCreatedData{
+				AppCreatedData: &events_pb.AppCreatedEventData{
+					App:           AppToEventModel(createdApp),
+					GitRepository: GitRepositoryToEventModel(createdGitRepository),
+					UserId:        createAppRequest.UserId,
 				},
-				UserId: createAppRequest.UserId,
 			},
-		},
+		})
 	})
+
+	if err == repositories.ErrAppNameInUse {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	if err != nil {
-		// FIXME: Handle this case
 		server.Logger.LogError(err.Error())
 		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	server.OutboxRelay.Notify()
+
 	return &app_service_pb.CreateAppResponse{
-		App: &app_service_pb.App{
-			Id:         createdApp.Id,
-			Name:       createdApp.Name,
-			DomainName: createdApp.DomainName,
-			Runtime:    createdApp.Runtime,
-			RepoUrl:    createdApp.RepoURL,
-			BuildCmd:   createdApp.BuildCMD,
-			StartCmd:   createdApp.StartCMD,
-			CreatedAt:  createdApp.CreatedAt.String(),
-		},
+		App: AppToProto(createdApp),
 	}, nil
 }
 
@@ -215,16 +265,7 @@ func (server *GRPCAppServiceServer) GetApp(ctx context.Context, getAppRequest *a
 	}
 
 	return &app_service_pb.GetAppResponse{
-		App: &app_service_pb.App{
-			Id:         app.Id,
-			Name:       app.Name,
-			DomainName: app.DomainName,
-			Runtime:    app.Runtime,
-			RepoUrl:    app.RepoURL,
-			BuildCmd:   app.BuildCMD,
-			StartCmd:   app.StartCMD,
-			CreatedAt:  app.CreatedAt.String(),
-		},
+		App: AppToProto(app),
 	}, nil
 }
 
@@ -239,21 +280,15 @@ func (server *GRPCAppServiceServer) GetApps(ctx context.Context, getAppsRequest
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	// TODO: create a util function
 	_apps := []*app_service_pb.App{}
 	apps_ids := []string{}
 	for _, app := range apps {
-		_app := app_service_pb.App{
-			Id:         app.Id,
-			Name:       app.Name,
-			DomainName: app.DomainName,
-			Runtime:    app.Runtime,
-			RepoUrl:    app.RepoURL,
-			BuildCmd:   app.BuildCMD,
-			StartCmd:   app.StartCMD,
-			CreatedAt:  app.CreatedAt.String(),
-		}
-		_apps = append(_apps, &_app)
+		// previews are listed with their parent app
+		if app.IsPreview() {
+			continue
+		}
+
+		_apps = append(_apps, AppToProto(&app))
 		apps_ids = append(apps_ids, app.Id)
 	}
 
@@ -287,6 +322,99 @@ func (server *GRPCAppServiceServer) UpdateApp(ctx context.Context, updateAppRequ
 		return nil, status.Error(codes.InvalidArgument, "Start Command cannot be empty")
 	}
 
+	app, err := server.AppRepository.GetAppById(ctx, updateAppRequest.ProjectId, updateAppRequest.AppId)
+	if err == repositories.ErrAppNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	schedule := app.Schedule
+	if updateAppRequest.Schedule != nil {
+		schedule = *updateAppRequest.Schedule
+	}
+
+	if err := ValidateSchedule(app.Type, schedule); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	previewsEnabled := app.PreviewsEnabled
+	if updateAppRequest.PreviewsEnabled != nil {
+		previewsEnabled = *updateAppRequest.PreviewsEnabled
+	}
+
+	if app.IsPreview() && previewsEnabled {
+		return nil, status.Error(codes.InvalidArgument, ErrPreviewsNotSupported.Error())
+	}
+
+	if err := ValidatePreviewsEnabled(app.Type, previewsEnabled); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	scaleToZeroIdleMinutes := app.ScaleToZeroIdleMinutes
+	if updateAppRequest.ScaleToZeroIdleMinutes != nil {
+		scaleToZeroIdleMinutes = *updateAppRequest.ScaleToZeroIdleMinutes
+	}
+
+	if err := ValidateScaleToZero(app.Type, scaleToZeroIdleMinutes); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	deploymentStrategy := app.DeploymentStrategy
+	if updateAppRequest.DeploymentStrategy != nil {
+		deploymentStrategy = repositories.DeploymentStrategy(*updateAppRequest.DeploymentStrategy)
+	}
+
+	canaryWeight := app.CanaryWeight
+	if updateAppRequest.CanaryWeight != nil {
+		canaryWeight = *updateAppRequest.CanaryWeight
+	}
+
+	deploymentStrategy, canaryWeight = NormalizeDeploymentStrategy(deploymentStrategy, canaryWeight)
+
+	_, err = server.DiskRepository.GetDiskByAppId(ctx, app.Id)
+	if err != nil && err != repositories.ErrDiskNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	hasDisk := err == nil
+
+	if err := ValidateDeploymentStrategy(app.Type, deploymentStrategy, canaryWeight, scaleToZeroIdleMinutes, hasDisk); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rollingMaxSurge := app.RollingMaxSurge
+	if updateAppRequest.RollingMaxSurge != nil {
+		rollingMaxSurge = *updateAppRequest.RollingMaxSurge
+	}
+
+	rollingMaxUnavailable := app.RollingMaxUnavailable
+	if updateAppRequest.RollingMaxUnavailable != nil {
+		rollingMaxUnavailable = *updateAppRequest.RollingMaxUnavailable
+	}
+
+	if err := ValidateRollingUpdate(rollingMaxSurge, rollingMaxUnavailable); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	preDeployCMD := app.PreDeployCMD
+	if updateAppRequest.PreDeployCmd != nil {
+		preDeployCMD = *updateAppRequest.PreDeployCmd
+	}
+
+	postDeployCMD := app.PostDeployCMD
+	if updateAppRequest.PostDeployCmd != nil {
+		postDeployCMD = *updateAppRequest.PostDeployCmd
+	}
+
+	if err := ValidateDeployCommands(app.Type, preDeployCMD, postDeployCMD); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	updatedApp, err := server.AppRepository.UpdateApp(
 		ctx,
 		updateAppRequest.ProjectId,
@@ -295,6 +423,18 @@ func (server *GRPCAppServiceServer) UpdateApp(ctx context.Context, updateAppRequ
 			Name:     *updateAppRequest.Name,
 			StartCMD: *updateAppRequest.StartCmd,
 			BuildCMD: *updateAppRequest.BuildCmd,
+			Schedule: schedule,
+
+			PreviewsEnabled:        previewsEnabled,
+			ScaleToZeroIdleMinutes: scaleToZeroIdleMinutes,
+
+			DeploymentStrategy:    deploymentStrategy,
+			RollingMaxSurge:       rollingMaxSurge,
+			RollingMaxUnavailable: rollingMaxUnavailable,
+			CanaryWeight:          canaryWeight,
+
+			PreDeployCMD:  preDeployCMD,
+			PostDeployCMD: postDeployCMD,
 		})
 
 	if err == repositories.ErrAppNameInUse {
@@ -308,16 +448,7 @@ func (server *GRPCAppServiceServer) UpdateApp(ctx context.Context, updateAppRequ
 	}
 
 	return &app_service_pb.UpdateAppResponse{
-		App: &app_service_pb.App{
-			Id:         updatedApp.Id,
-			Name:       updatedApp.Name,
-			DomainName: updatedApp.DomainName,
-			Runtime:    updatedApp.Runtime,
-			RepoUrl:    updatedApp.RepoURL,
-			BuildCmd:   updatedApp.BuildCMD,
-			StartCmd:   updatedApp.StartCMD,
-			CreatedAt:  updatedApp.CreatedAt.String(),
-		},
+		App: AppToProto(updatedApp),
 	}, nil
 }
 
@@ -341,19 +472,23 @@ func (server *GRPCAppServiceServer) DeleteApp(ctx context.Context, deleteAppRequ
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	err = server.EnvironmentVariablesRepository.DeleteEnvironmentVariableByAppId(ctx, deleteAppRequest.AppId)
+	// previews are torn down with their parent app
+	previews, err := server.AppRepository.GetPreviews(ctx, app.Id)
 	if err != nil {
 		span.SetAttributes(attribute.String("error", err.Error()))
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	err = server.GitRepositoryRepository.DeleteGitRepositoryByAppId(ctx, deleteAppRequest.AppId)
-	if err != nil {
-		span.SetAttributes(attribute.String("error", err.Error()))
-		return nil, status.Error(codes.Internal, err.Error())
-	}
+	err = server.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, preview := range previews {
+			err := server.deleteApp(ctx, tx, &preview)
+			if err != nil {
+				return err
+			}
+		}
 
-	err = server.AppRepository.DeleteAppById(ctx, deleteAppRequest.ProjectId, deleteAppRequest.AppId)
+		return server.deleteApp(ctx, tx, app)
+	})
 	if err == repositories.ErrAppNotFound {
 		span.SetAttributes(attribute.String("error", err.Error()))
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -364,31 +499,55 @@ func (server *GRPCAppServiceServer) DeleteApp(ctx context.Context, deleteAppRequ
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	err = server.EventBus.Publish(ctx, events_pb.EventName_APP_DELETED, &events_pb.EventData{
-		Value: &events_pb.EventData_AppDeletedData{
-			AppDeletedData: &events_pb.AppDeletedEventData{
-				AppId:   deleteAppRequest.AppId,
-				AppName: app.Name,
-			},
-		},
-	})
+	server.OutboxRelay.Notify()
+
+	return &app_service_pb.DeleteAppResponse{}, nil
+}
+
+func (server *GRPCAppServiceServer) SuspendApp(ctx context.Context, suspendAppRequest *app_service_pb.SuspendAppRequest) (*app_service_pb.SuspendAppResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("project.id", suspendAppRequest.ProjectId),
+		attribute.String("app.id", suspendAppRequest.AppId),
+	)
+
+	app, err := server.setAppSuspended(ctx, suspendAppRequest.ProjectId, suspendAppRequest.AppId, true)
 	if err != nil {
-		// FIXME: handle this case
-		server.Logger.LogError(err.Error())
-		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, err
 	}
 
-	return &app_service_pb.DeleteAppResponse{}, nil
+	return &app_service_pb.SuspendAppResponse{
+		App: AppToProto(app),
+	}, nil
 }
 
-func (server *GRPCAppServiceServer) GetEnvironmentVariables(ctx context.Context, getEnvironmentVariablesRequest *app_service_pb.GetEnvironmentVariablesRequest) (*app_service_pb.GetEnvironmentVariablesResponse, error) {
+func (server *GRPCAppServiceServer) ResumeApp(ctx context.Context, resumeAppRequest *app_service_pb.ResumeAppRequest) (*app_service_pb.ResumeAppResponse, error) {
 	span := trace.SpanFromContext(ctx)
 
-	span.SetAttributes(attribute.String("app.id", getEnvironmentVariablesRequest.AppId))
+	span.SetAttributes(
+		attribute.String("project.id", resumeAppRequest.ProjectId),
+		attribute.String("app.id", resumeAppRequest.AppId),
+	)
 
-	environmentVariables, err := server.EnvironmentVariablesRepository.GetEnvironmentVariable(ctx, getEnvironmentVariablesRequest.AppId)
+	app, err := server.setAppSuspended(ctx, resumeAppRequest.ProjectId, resumeAppRequest.AppId, false)
+	if err != nil {
+		return nil, err
+	}
 
-	if err == repositories.ErrEnvVarNotFound {
+	return &app_service_pb.ResumeAppResponse{
+		App: AppToProto(app),
+	}, nil
+}
+
+// setAppSuspended saves the state first so that a deployment running meanwhile reads it,
+// then asks deploy-service to apply it. The event is sent again when the state does not
+// change, in case the previous one was lost.
+func (server *GRPCAppServiceServer) setAppSuspended(ctx context.Context, projectId, appId string, suspended bool) (*repositories.App, error) {
+	span := trace.SpanFromContext(ctx)
+
+	app, err := server.AppRepository.SetAppSuspended(ctx, projectId, appId, suspended)
+	if err == repositories.ErrAppNotFound {
 		span.SetAttributes(attribute.String("error", err.Error()))
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
@@ -398,115 +557,1345 @@ func (server *GRPCAppServiceServer) GetEnvironmentVariables(ctx context.Context,
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	span.SetAttributes(attribute.String("environment_variables.id", environmentVariables.Id))
-
-	return &app_service_pb.GetEnvironmentVariablesResponse{
-		EnvironmentVariable: &app_service_pb.EnvironmentVariables{
-			Id:       environmentVariables.Id,
-			Value:    environmentVariables.Value,
-			CreateAt: environmentVariables.CreatedAt.String(),
+	eventName := events_pb.EventName_APP_RESUMED
+	eventData := &events_pb.EventData{
+		Value: &events_pb.EventData_AppResumedData{
+			AppResumedData: &events_pb.AppResumedEventData{AppId: app.Id},
 		},
-	}, nil
+	}
+	if suspended {
+		eventName = events_pb.EventName_APP_SUSPENDED
+		eventData = &events_pb.EventData{
+			Value: &events_pb.EventData_AppSuspendedData{
+				AppSuspendedData: &events_pb.AppSuspendedEventData{AppId: app.Id},
+			},
+		}
+	}
+
+	server.Logger.LogInfoF("Send %s Event", events_pb.EventName_name[int32(eventName)])
+	err = server.EventBus.Publish(ctx, eventName, eventData)
+	if err != nil {
+		server.Logger.LogError(err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return app, nil
 }
 
-func (server *GRPCAppServiceServer) CreateEnvironmentVariables(ctx context.Context, createEnvironmentVariablesRequest *app_service_pb.CreateEnvironmentVariablesRequest) (*app_service_pb.CreateEnvironmentVariablesResponse, error) {
-	span := trace.SpanFromContext(ctx)
+// deleteApp deletes the app with everything it owns and asks the other services to
+// release its resources, the event is saved in the outbox within tx.
+func (server *GRPCAppServiceServer) deleteApp(ctx context.Context, tx bun.Tx, app *repositories.App) error {
+	environmentVariablesRepository := server.EnvironmentVariablesRepository.WithTx(tx)
+	err := environmentVariablesRepository.DeleteEnvironmentVariableByAppId(ctx, app.Id)
+	if err != nil {
+		return err
+	}
 
-	span.SetAttributes(attribute.String("app.id", createEnvironmentVariablesRequest.AppId))
+	environmentGroupsRepository := server.EnvironmentGroupsRepository.WithTx(tx)
+	err = environmentGroupsRepository.DeleteAppLinksByAppIds(ctx, []string{app.Id})
+	if err != nil {
+		return err
+	}
 
-	environmentVariables, err := server.EnvironmentVariablesRepository.CreateEnvironmentVariables(ctx, createEnvironmentVariablesRequest.AppId, repositories.CreateEnvironmentVariableParams{
-		Value: createEnvironmentVariablesRequest.Value,
-	})
+	// The volume itself is kept by deploy-service for a grace period.
+	diskRepository := server.DiskRepository.WithTx(tx)
+	err = diskRepository.DeleteDisksByAppIds(ctx, []string{app.Id})
+	if err != nil {
+		return err
+	}
 
+	gitRepositoryRepository := server.GitRepositoryRepository.WithTx(tx)
+	err = gitRepositoryRepository.DeleteGitRepositoryByAppId(ctx, app.Id)
 	if err != nil {
-		span.SetAttributes(attribute.String("error", err.Error()))
-		return nil, status.Error(codes.Internal, err.Error())
+		return err
 	}
 
-	span.SetAttributes(attribute.String("environment_variables.id", environmentVariables.Id))
+	appRepository := server.AppRepository.WithTx(tx)
+	err = appRepository.DeleteAppById(ctx, app.ProjectId, app.Id)
+	if err != nil {
+		return err
+	}
 
-	return &app_service_pb.CreateEnvironmentVariablesResponse{
-		EnvironmentVariable: &app_service_pb.EnvironmentVariables{
-			Id:       environmentVariables.Id,
-			Value:    environmentVariables.Value,
-			CreateAt: environmentVariables.CreatedAt.String(),
+	return outbox.Enqueue(ctx, tx, events_pb.EventName_APP_DELETED, &events_pb.EventData{
+		Value: &events_pb.EventData_AppDeletedData{
+			AppDeletedData: &events_pb.AppDeletedEventData{
+				AppId:   app.Id,
+				AppName: app.Name,
+			},
 		},
-	}, nil
+	})
 }
 
-func (server *GRPCAppServiceServer) UpdateEnvironmentVariables(ctx context.Context, updateEnvironmentVariablesRequest *app_service_pb.UpdateEnvironmentVariablesRequest) (*app_service_pb.UpdateEnvironmentVariablesResponse, error) {
+// GetAppDeploymentConfig returns what deploy-service needs to generate the app resources.
+// It is meant for internal callers only and must not be exposed by the gateway.
+func (server *GRPCAppServiceServer) GetAppDeploymentConfig(ctx context.Context, getAppDeploymentConfigRequest *app_service_pb.GetAppDeploymentConfigRequest) (*app_service_pb.GetAppDeploymentConfigResponse, error) {
 	span := trace.SpanFromContext(ctx)
 
-	span.SetAttributes(attribute.String("app.id", updateEnvironmentVariablesRequest.AppId))
+	span.SetAttributes(attribute.String("app.id", getAppDeploymentConfigRequest.AppId))
 
-	_, err := server.EnvironmentVariablesRepository.GetEnvironmentVariable(ctx, updateEnvironmentVariablesRequest.AppId)
-	if err == repositories.ErrEnvVarNotFound {
+	app, err := server.AppRepository.GetAppByIdInternal(ctx, getAppDeploymentConfigRequest.AppId)
+	if err == repositories.ErrAppNotFound {
 		span.SetAttributes(attribute.String("error", err.Error()))
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
 	if err != nil {
-		environmentVariables, err := server.EnvironmentVariablesRepository.CreateEnvironmentVariables(ctx, updateEnvironmentVariablesRequest.AppId, repositories.CreateEnvironmentVariableParams{
-			Value: updateEnvironmentVariablesRequest.Value,
-		})
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-		if err != nil {
-			span.SetAttributes(attribute.String("error", err.Error()))
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+	span.SetAttributes(attribute.String("app.type", string(app.Type)))
 
-		span.SetAttributes(attribute.String("environment_variables.id", environmentVariables.Id))
+	config := &app_service_pb.AppDeploymentConfig{
+		AppId:      app.Id,
+		AppName:    app.Name,
+		DomainName: app.DomainName,
+		Type:       string(app.Type),
+		Schedule:   app.Schedule,
+		Suspended:  app.Suspended,
 
-		return &app_service_pb.UpdateEnvironmentVariablesResponse{
-			EnvironmentVariable: &app_service_pb.EnvironmentVariables{
-				Id:       environmentVariables.Id,
-				Value:    environmentVariables.Value,
-				CreateAt: environmentVariables.CreatedAt.String(),
-			},
-		}, nil
-	}
+		ScaleToZeroIdleMinutes: app.ScaleToZeroIdleMinutes,
 
-	environmentVariables, err := server.EnvironmentVariablesRepository.UpdateEnvironmentVariables(ctx, updateEnvironmentVariablesRequest.AppId, repositories.UpdateEnvironmentVariableParams{
-		Value: updateEnvironmentVariablesRequest.Value,
-	})
+		DeploymentStrategy:    string(app.DeploymentStrategy),
+		RollingMaxSurge:       app.RollingMaxSurge,
+		RollingMaxUnavailable: app.RollingMaxUnavailable,
+		CanaryWeight:          app.CanaryWeight,
 
-	if err == repositories.ErrEnvVarNotFound {
-		span.SetAttributes(attribute.String("error", err.Error()))
-		return nil, status.Error(codes.NotFound, err.Error())
+		PreDeployCmd:  app.PreDeployCMD,
+		PostDeployCmd: app.PostDeployCMD,
 	}
 
-	if err != nil {
+	disk, err := server.DiskRepository.GetDiskByAppId(ctx, app.Id)
+	if err != nil && err != repositories.ErrDiskNotFound {
 		span.SetAttributes(attribute.String("error", err.Error()))
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	span.SetAttributes(attribute.String("environment_variables.id", environmentVariables.Id))
+	if disk != nil {
+		config.Disk = DiskToProto(disk)
+	}
 
-	return &app_service_pb.UpdateEnvironmentVariablesResponse{
-		EnvironmentVariable: &app_service_pb.EnvironmentVariables{
-			Id:       environmentVariables.Id,
-			Value:    environmentVariables.Value,
-			CreateAt: environmentVariables.CreatedAt.String(),
-		},
+	return &app_service_pb.GetAppDeploymentConfigResponse{
+		Config: config,
 	}, nil
 }
 
-func (server *GRPCAppServiceServer) DeleteEnvironmentVariables(ctx context.Context, deleteEnvironmentVariablesRequest *app_service_pb.DeleteEnvironmentVariablesRequest) (*app_service_pb.DeleteEnvironmentVariablesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "Unimplemented")
-}
-
-func (server *GRPCAppServiceServer) BatchGetAppsCount(ctx context.Context, batchGetAppsCountRequest *app_service_pb.BatchGetAppsCountRequest) (*app_service_pb.BatchGetAppsCountResponse, error) {
+func (server *GRPCAppServiceServer) GetAppDisk(ctx context.Context, getAppDiskRequest *app_service_pb.GetAppDiskRequest) (*app_service_pb.GetAppDiskResponse, error) {
 	span := trace.SpanFromContext(ctx)
 
-	span.SetAttributes(attribute.StringSlice("projects.ids", batchGetAppsCountRequest.ProjectIds))
+	span.SetAttributes(
+		attribute.String("project.id", getAppDiskRequest.ProjectId),
+		attribute.String("app.id", getAppDiskRequest.AppId),
+	)
+
+	_, err := server.AppRepository.GetAppById(ctx, getAppDiskRequest.ProjectId, getAppDiskRequest.AppId)
+	if err == repositories.ErrAppNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
 
-	projectsAppsCounts, err := server.AppRepository.GetProjectsAppsCounts(ctx, batchGetAppsCountRequest.ProjectIds)
 	if err != nil {
 		span.SetAttributes(attribute.String("error", err.Error()))
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &app_service_pb.BatchGetAppsCountResponse{
-		ProjectAppsCount: projectsAppsCounts,
+	disk, err := server.DiskRepository.GetDiskByAppId(ctx, getAppDiskRequest.AppId)
+	if err == repositories.ErrDiskNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &app_service_pb.GetAppDiskResponse{
+		Disk: DiskToProto(disk),
 	}, nil
 }
+
+func (server *GRPCAppServiceServer) SetAppDisk(ctx context.Context, setAppDiskRequest *app_service_pb.SetAppDiskRequest) (*app_service_pb.SetAppDiskResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("project.id", setAppDiskRequest.ProjectId),
+		attribute.String("app.id", setAppDiskRequest.AppId),
+		attribute.String("disk.mount_path", setAppDiskRequest.MountPath),
+		attribute.Int("disk.size_gb", int(setAppDiskRequest.SizeGb)),
+	)
+
+	app, err := server.AppRepository.GetAppById(ctx, setAppDiskRequest.ProjectId, setAppDiskRequest.AppId)
+	if err == repositories.ErrAppNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	currentDisk, err := server.DiskRepository.GetDiskByAppId(ctx, app.Id)
+	if err != nil && err != repositories.ErrDiskNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = ValidateDisk(app.Type, setAppDiskRequest.MountPath, setAppDiskRequest.SizeGb, currentDisk)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if app.DeploymentStrategy != repositories.DeploymentStrategyRolling {
+		span.SetAttributes(attribute.String("error", ErrDeploymentStrategyWithDisk.Error()))
+		return nil, status.Error(codes.InvalidArgument, ErrDeploymentStrategyWithDisk.Error())
+	}
+
+	disk, err := server.DiskRepository.SetDisk(ctx, app.Id, repositories.SetDiskParams{
+		MountPath: setAppDiskRequest.MountPath,
+		SizeGB:    setAppDiskRequest.SizeGb,
+	})
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	span.SetAttributes(attribute.String("disk.id", disk.Id))
+
+	return &app_service_pb.SetAppDiskResponse{
+		Disk: DiskToProto(disk),
+	}, nil
+}
+
+func (server *GRPCAppServiceServer) DeleteAppDisk(ctx context.Context, deleteAppDiskRequest *app_service_pb.DeleteAppDiskRequest) (*app_service_pb.DeleteAppDiskResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("project.id", deleteAppDiskRequest.ProjectId),
+		attribute.String("app.id", deleteAppDiskRequest.AppId),
+	)
+
+	_, err := server.AppRepository.GetAppById(ctx, deleteAppDiskRequest.ProjectId, deleteAppDiskRequest.AppId)
+	if err == repositories.ErrAppNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = server.DiskRepository.DeleteDisk(ctx, deleteAppDiskRequest.AppId)
+	if err == repositories.ErrDiskNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &app_service_pb.DeleteAppDiskResponse{}, nil
+}
+
+func (server *GRPCAppServiceServer) GetEnvironmentVariables(ctx context.Context, getEnvironmentVariablesRequest *app_service_pb.GetEnvironmentVariablesRequest) (*app_service_pb.GetEnvironmentVariablesResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.String("app.id", getEnvironmentVariablesRequest.AppId))
+
+	environmentVariables, err := server.EnvironmentVariablesRepository.GetEnvironmentVariables(ctx, getEnvironmentVariablesRequest.AppId)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	span.SetAttributes(attribute.Int("environment_variables.count", len(environmentVariables)))
+
+	return &app_service_pb.GetEnvironmentVariablesResponse{
+		EnvironmentVariable:  EnvironmentVariablesToLegacyProto(getEnvironmentVariablesRequest.AppId, environmentVariables),
+		EnvironmentVariables: EnvironmentVariableListToProto(environmentVariables),
+	}, nil
+}
+
+func (server *GRPCAppServiceServer) CreateEnvironmentVariables(ctx context.Context, createEnvironmentVariablesRequest *app_service_pb.CreateEnvironmentVariablesRequest) (*app_service_pb.CreateEnvironmentVariablesResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.String("app.id", createEnvironmentVariablesRequest.AppId))
+
+	values, err := ParseEnvironmentVariablesJSON(createEnvironmentVariablesRequest.Value)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	setEnvironmentVariablesParams, err := server.mergeEnvironmentVariables(ctx, createEnvironmentVariablesRequest.AppId, values)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var environmentVariables []repositories.EnvironmentVariable
+	err = server.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		environmentVariablesRepository := server.EnvironmentVariablesRepository.WithTx(tx)
+
+		var err error
+		environmentVariables, err = environmentVariablesRepository.SetEnvironmentVariables(ctx, createEnvironmentVariablesRequest.AppId, setEnvironmentVariablesParams)
+		if err != nil {
+			return err
+		}
+
+		return server.enqueueEnvUpdated(ctx, tx, createEnvironmentVariablesRequest.AppId, createEnvironmentVariablesRequest.SkipRestart)
+	})
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	server.OutboxRelay.Notify()
+
+	span.SetAttributes(attribute.Int("environment_variables.count", len(environmentVariables)))
+
+	return &app_service_pb.CreateEnvironmentVariablesResponse{
+		EnvironmentVariable: EnvironmentVariablesToLegacyProto(createEnvironmentVariablesRequest.AppId, environmentVariables),
+	}, nil
+}
+
+func (server *GRPCAppServiceServer) UpdateEnvironmentVariables(ctx context.Context, updateEnvironmentVariablesRequest *app_service_pb.UpdateEnvironmentVariablesRequest) (*app_service_pb.UpdateEnvironmentVariablesResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.String("app.id", updateEnvironmentVariablesRequest.AppId))
+
+	values, err := ParseEnvironmentVariablesJSON(updateEnvironmentVariablesRequest.Value)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	setEnvironmentVariablesParams, err := server.mergeEnvironmentVariables(ctx, updateEnvironmentVariablesRequest.AppId, values)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var environmentVariables []repositories.EnvironmentVariable
+	err = server.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		environmentVariablesRepository := server.EnvironmentVariablesRepository.WithTx(tx)
+
+		var err error
+		environmentVariables, err = environmentVariablesRepository.ReplaceEnvironmentVariables(ctx, updateEnvironmentVariablesRequest.AppId, setEnvironmentVariablesParams)
+		if err != nil {
+			return err
+		}
+
+		return server.enqueueEnvUpdated(ctx, tx, updateEnvironmentVariablesRequest.AppId, updateEnvironmentVariablesRequest.SkipRestart)
+	})
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	server.OutboxRelay.Notify()
+
+	span.SetAttributes(attribute.Int("environment_variables.count", len(environmentVariables)))
+
+	return &app_service_pb.UpdateEnvironmentVariablesResponse{
+		EnvironmentVariable: EnvironmentVariablesToLegacyProto(updateEnvironmentVariablesRequest.AppId, environmentVariables),
+	}, nil
+}
+
+func (server *GRPCAppServiceServer) DeleteEnvironmentVariables(ctx context.Context, deleteEnvironmentVariablesRequest *app_service_pb.DeleteEnvironmentVariablesRequest) (*app_service_pb.DeleteEnvironmentVariablesResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.String("app.id", deleteEnvironmentVariablesRequest.AppId))
+
+	err := server.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		environmentVariablesRepository := server.EnvironmentVariablesRepository.WithTx(tx)
+
+		err := environmentVariablesRepository.DeleteEnvironmentVariableByAppId(ctx, deleteEnvironmentVariablesRequest.AppId)
+		if err != nil {
+			return err
+		}
+
+		return server.enqueueEnvUpdated(ctx, tx, deleteEnvironmentVariablesRequest.AppId, deleteEnvironmentVariablesRequest.SkipRestart)
+	})
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	server.OutboxRelay.Notify()
+
+	return &app_service_pb.DeleteEnvironmentVariablesResponse{}, nil
+}
+
+func (server *GRPCAppServiceServer) BatchGetAppsCount(ctx context.Context, batchGetAppsCountRequest *app_service_pb.BatchGetAppsCountRequest) (*app_service_pb.BatchGetAppsCountResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.StringSlice("projects.ids", batchGetAppsCountRequest.ProjectIds))
+
+	projectsAppsCounts, err := server.AppRepository.GetProjectsAppsCounts(ctx, batchGetAppsCountRequest.ProjectIds)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &app_service_pb.BatchGetAppsCountResponse{
+		ProjectAppsCount: projectsAppsCounts,
+	}, nil
+}
+
+func (server *GRPCAppServiceServer) SetEnvironmentVariable(ctx context.Context, setEnvironmentVariableRequest *app_service_pb.SetEnvironmentVariableRequest) (*app_service_pb.SetEnvironmentVariableResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("app.id", setEnvironmentVariableRequest.AppId),
+		attribute.String("environment_variable.key", setEnvironmentVariableRequest.Key),
+	)
+
+	if len(setEnvironmentVariableRequest.Key) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Key is required")
+	}
+
+	if !dotenv.IsValidKey(setEnvironmentVariableRequest.Key) {
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidEnvironmentVariableName.Error())
+	}
+
+	var existing *repositories.SetEnvironmentVariableParams
+	existingEnvironmentVariable, err := server.EnvironmentVariablesRepository.GetEnvironmentVariable(ctx, setEnvironmentVariableRequest.AppId, setEnvironmentVariableRequest.Key)
+	if err != nil && err != repositories.ErrEnvVarNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if existingEnvironmentVariable != nil {
+		existing = &repositories.SetEnvironmentVariableParams{
+			Value:    existingEnvironmentVariable.Value,
+			IsSecret: existingEnvironmentVariable.IsSecret,
+		}
+	}
+
+	var environmentVariable *repositories.EnvironmentVariable
+	err = server.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		environmentVariablesRepository := server.EnvironmentVariablesRepository.WithTx(tx)
+
+		var err error
+		environmentVariable, err = environmentVariablesRepository.SetEnvironmentVariable(ctx, setEnvironmentVariableRequest.AppId, mergeEnvironmentVariable(setEnvironmentVariableRequest.Key, setEnvironmentVariableRequest.Value, setEnvironmentVariableRequest.IsSecret, existing))
+		if err != nil {
+			return err
+		}
+
+		return server.enqueueEnvUpdated(ctx, tx, setEnvironmentVariableRequest.AppId, setEnvironmentVariableRequest.SkipRestart)
+	})
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	server.OutboxRelay.Notify()
+
+	span.SetAttributes(attribute.String("environment_variable.id", environmentVariable.Id))
+
+	return &app_service_pb.SetEnvironmentVariableResponse{
+		EnvironmentVariable: EnvironmentVariableToProto(environmentVariable),
+	}, nil
+}
+
+func (server *GRPCAppServiceServer) DeleteEnvironmentVariable(ctx context.Context, deleteEnvironmentVariableRequest *app_service_pb.DeleteEnvironmentVariableRequest) (*app_service_pb.DeleteEnvironmentVariableResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("app.id", deleteEnvironmentVariableRequest.AppId),
+		attribute.String("environment_variable.key", deleteEnvironmentVariableRequest.Key),
+	)
+
+	err := server.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		environmentVariablesRepository := server.EnvironmentVariablesRepository.WithTx(tx)
+
+		err := environmentVariablesRepository.DeleteEnvironmentVariable(ctx, deleteEnvironmentVariableRequest.AppId, deleteEnvironmentVariableRequest.Key)
+		if err != nil {
+			return err
+		}
+
+		return server.enqueueEnvUpdated(ctx, tx, deleteEnvironmentVariableRequest.AppId, deleteEnvironmentVariableRequest.SkipRestart)
+	})
+	if err == repositories.ErrEnvVarNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	server.OutboxRelay.Notify()
+
+	return &app_service_pb.DeleteEnvironmentVariableResponse{}, nil
+}
+
+// ResolveEnvironmentVariables returns the decrypted values, including secrets, of the linked
+// environment groups merged with the app variables. It is meant for internal callers
+// (deploy-service) only and must not be exposed by the gateway.
+func (server *GRPCAppServiceServer) ResolveEnvironmentVariables(ctx context.Context, resolveEnvironmentVariablesRequest *app_service_pb.ResolveEnvironmentVariablesRequest) (*app_service_pb.ResolveEnvironmentVariablesResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.String("app.id", resolveEnvironmentVariablesRequest.AppId))
+
+	app, err := server.AppRepository.GetAppByIdInternal(ctx, resolveEnvironmentVariablesRequest.AppId)
+	if err == repositories.ErrAppNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	values := map[string]string{}
+
+	// Previews inherit the variables of their parent app, their own variables override them.
+	if app.IsPreview() {
+		span.SetAttributes(attribute.String("app.parent_app_id", app.ParentAppId))
+
+		values, err = server.resolveEnvironmentVariables(ctx, app.ParentAppId)
+		if err != nil {
+			span.SetAttributes(attribute.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	appValues, err := server.resolveEnvironmentVariables(ctx, app.Id)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	for key, value := range appValues {
+		values[key] = value
+	}
+
+	span.SetAttributes(attribute.Int("environment_variables.count", len(values)))
+
+	return &app_service_pb.ResolveEnvironmentVariablesResponse{
+		EnvironmentVariables: values,
+	}, nil
+}
+
+func (server *GRPCAppServiceServer) resolveEnvironmentVariables(ctx context.Context, appId string) (map[string]string, error) {
+	// Linked groups are applied first so the app's own variables always win.
+	values, err := server.EnvironmentGroupsRepository.ResolveAppEnvironmentGroupsVariables(ctx, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	environmentVariables, err := server.EnvironmentVariablesRepository.GetEnvironmentVariables(ctx, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, environmentVariable := range environmentVariables {
+		values[environmentVariable.Key] = environmentVariable.Value
+	}
+
+	return values, nil
+}
+
+func (server *GRPCAppServiceServer) ImportEnvironmentVariables(ctx context.Context, importEnvironmentVariablesRequest *app_service_pb.ImportEnvironmentVariablesRequest) (*app_service_pb.ImportEnvironmentVariablesResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("app.id", importEnvironmentVariablesRequest.AppId),
+		attribute.Bool("environment_variables.replace", importEnvironmentVariablesRequest.Replace),
+	)
+
+	variables, err := dotenv.Parse(importEnvironmentVariablesRequest.Content)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	values := make(map[string]string, len(variables))
+	for _, variable := range variables {
+		values[variable.Key] = variable.Value
+	}
+
+	setEnvironmentVariablesParams, err := server.mergeEnvironmentVariables(ctx, importEnvironmentVariablesRequest.AppId, values)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var environmentVariables []repositories.EnvironmentVariable
+	err = server.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		environmentVariablesRepository := server.EnvironmentVariablesRepository.WithTx(tx)
+
+		var err error
+		if importEnvironmentVariablesRequest.Replace {
+			environmentVariables, err = environmentVariablesRepository.ReplaceEnvironmentVariables(ctx, importEnvironmentVariablesRequest.AppId, setEnvironmentVariablesParams)
+		} else {
+			environmentVariables, err = environmentVariablesRepository.SetEnvironmentVariables(ctx, importEnvironmentVariablesRequest.AppId, setEnvironmentVariablesParams)
+		}
+		if err != nil {
+			return err
+		}
+
+		return server.enqueueEnvUpdated(ctx, tx, importEnvironmentVariablesRequest.AppId, importEnvironmentVariablesRequest.SkipRestart)
+	})
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	server.OutboxRelay.Notify()
+
+	span.SetAttributes(attribute.Int("environment_variables.count", len(environmentVariables)))
+
+	return &app_service_pb.ImportEnvironmentVariablesResponse{
+		EnvironmentVariables: EnvironmentVariableListToProto(environmentVariables),
+	}, nil
+}
+
+// ExportEnvironmentVariables renders the app variables as a .env file. Secrets are masked, importing
+// the file back keeps their stored values.
+func (server *GRPCAppServiceServer) ExportEnvironmentVariables(ctx context.Context, exportEnvironmentVariablesRequest *app_service_pb.ExportEnvironmentVariablesRequest) (*app_service_pb.ExportEnvironmentVariablesResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.String("app.id", exportEnvironmentVariablesRequest.AppId))
+
+	environmentVariables, err := server.EnvironmentVariablesRepository.GetEnvironmentVariables(ctx, exportEnvironmentVariablesRequest.AppId)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	span.SetAttributes(attribute.Int("environment_variables.count", len(environmentVariables)))
+
+	values := make(map[string]string, len(environmentVariables))
+	for _, environmentVariable := range environmentVariables {
+		values[environmentVariable.Key] = EnvironmentVariableToProto(&environmentVariable).Value
+	}
+
+	return &app_service_pb.ExportEnvironmentVariablesResponse{
+		Content: dotenv.Format(values),
+	}, nil
+}
+
+func (server *GRPCAppServiceServer) CreateEnvironmentGroup(ctx context.Context, createEnvironmentGroupRequest *app_service_pb.CreateEnvironmentGroupRequest) (*app_service_pb.CreateEnvironmentGroupResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.String("project.id", createEnvironmentGroupRequest.ProjectId))
+
+	if len(createEnvironmentGroupRequest.Name) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Name is required")
+	}
+
+	environmentGroup, err := server.EnvironmentGroupsRepository.CreateEnvironmentGroup(ctx, createEnvironmentGroupRequest.ProjectId, createEnvironmentGroupRequest.Name)
+	if err == repositories.ErrEnvironmentGroupNameInUse {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	span.SetAttributes(attribute.String("environment_group.id", environmentGroup.Id))
+
+	return &app_service_pb.CreateEnvironmentGroupResponse{
+		EnvironmentGroup: EnvironmentGroupToProto(environmentGroup),
+	}, nil
+}
+
+func (server *GRPCAppServiceServer) GetEnvironmentGroup(ctx context.Context, getEnvironmentGroupRequest *app_service_pb.GetEnvironmentGroupRequest) (*app_service_pb.GetEnvironmentGroupResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("project.id", getEnvironmentGroupRequest.ProjectId),
+		attribute.String("environment_group.id", getEnvironmentGroupRequest.GroupId),
+	)
+
+	environmentGroup, err := server.EnvironmentGroupsRepository.GetEnvironmentGroupById(ctx, getEnvironmentGroupRequest.ProjectId, getEnvironmentGroupRequest.GroupId)
+	if err == repositories.ErrEnvironmentGroupNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	environmentGroupVariables, err := server.EnvironmentGroupsRepository.GetEnvironmentGroupVariables(ctx, environmentGroup.Id)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	appIds, err := server.EnvironmentGroupsRepository.GetLinkedAppIds(ctx, environmentGroup.Id)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	_environmentGroupVariables := make([]*app_service_pb.EnvironmentGroupVariable, 0, len(environmentGroupVariables))
+	for _, environmentGroupVariable := range environmentGroupVariables {
+		_environmentGroupVariables = append(_environmentGroupVariables, EnvironmentGroupVariableToProto(&environmentGroupVariable))
+	}
+
+	return &app_service_pb.GetEnvironmentGroupResponse{
+		EnvironmentGroup: EnvironmentGroupToProto(environmentGroup),
+		Variables:        _environmentGroupVariables,
+		AppIds:           appIds,
+	}, nil
+}
+
+func (server *GRPCAppServiceServer) GetEnvironmentGroups(ctx context.Context, getEnvironmentGroupsRequest *app_service_pb.GetEnvironmentGroupsRequest) (*app_service_pb.GetEnvironmentGroupsResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.String("project.id", getEnvironmentGroupsRequest.ProjectId))
+
+	environmentGroups, err := server.EnvironmentGroupsRepository.GetEnvironmentGroups(ctx, getEnvironmentGroupsRequest.ProjectId)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	span.SetAttributes(attribute.Int("environment_groups.count", len(environmentGroups)))
+
+	_environmentGroups := make([]*app_service_pb.EnvironmentGroup, 0, len(environmentGroups))
+	for _, environmentGroup := range environmentGroups {
+		_environmentGroups = append(_environmentGroups, EnvironmentGroupToProto(&environmentGroup))
+	}
+
+	return &app_service_pb.GetEnvironmentGroupsResponse{
+		EnvironmentGroups: _environmentGroups,
+	}, nil
+}
+
+func (server *GRPCAppServiceServer) DeleteEnvironmentGroup(ctx context.Context, deleteEnvironmentGroupRequest *app_service_pb.DeleteEnvironmentGroupRequest) (*app_service_pb.DeleteEnvironmentGroupResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("project.id", deleteEnvironmentGroupRequest.ProjectId),
+		attribute.String("environment_group.id", deleteEnvironmentGroupRequest.GroupId),
+	)
+
+	// Linked apps are collected before the links are deleted with the group.
+	appIds, err := server.EnvironmentGroupsRepository.GetLinkedAppIds(ctx, deleteEnvironmentGroupRequest.GroupId)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = server.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		environmentGroupsRepository := server.EnvironmentGroupsRepository.WithTx(tx)
+
+		err := environmentGroupsRepository.DeleteEnvironmentGroup(ctx, deleteEnvironmentGroupRequest.ProjectId, deleteEnvironmentGroupRequest.GroupId)
+		if err != nil {
+			return err
+		}
+
+		for _, appId := range appIds {
+			err := server.enqueueEnvUpdated(ctx, tx, appId, deleteEnvironmentGroupRequest.SkipRestart)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err == repositories.ErrEnvironmentGroupNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	server.OutboxRelay.Notify()
+
+	return &app_service_pb.DeleteEnvironmentGroupResponse{}, nil
+}
+
+func (server *GRPCAppServiceServer) SetEnvironmentGroupVariable(ctx context.Context, setEnvironmentGroupVariableRequest *app_service_pb.SetEnvironmentGroupVariableRequest) (*app_service_pb.SetEnvironmentGroupVariableResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("project.id", setEnvironmentGroupVariableRequest.ProjectId),
+		attribute.String("environment_group.id", setEnvironmentGroupVariableRequest.GroupId),
+		attribute.String("environment_variable.key", setEnvironmentGroupVariableRequest.Key),
+	)
+
+	if len(setEnvironmentGroupVariableRequest.Key) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Key is required")
+	}
+
+	if !dotenv.IsValidKey(setEnvironmentGroupVariableRequest.Key) {
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidEnvironmentVariableName.Error())
+	}
+
+	environmentGroup, err := server.EnvironmentGroupsRepository.GetEnvironmentGroupById(ctx, setEnvironmentGroupVariableRequest.ProjectId, setEnvironmentGroupVariableRequest.GroupId)
+	if err == repositories.ErrEnvironmentGroupNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	existingEnvironmentGroupVariables, err := server.EnvironmentGroupsRepository.GetEnvironmentGroupVariables(ctx, environmentGroup.Id)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var existing *repositories.SetEnvironmentVariableParams
+	for _, existingEnvironmentGroupVariable := range existingEnvironmentGroupVariables {
+		if existingEnvironmentGroupVariable.Key == setEnvironmentGroupVariableRequest.Key {
+			existing = &repositories.SetEnvironmentVariableParams{
+				Value:    existingEnvironmentGroupVariable.Value,
+				IsSecret: existingEnvironmentGroupVariable.IsSecret,
+			}
+			break
+		}
+	}
+
+	var environmentGroupVariable *repositories.EnvironmentGroupVariable
+	err = server.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		environmentGroupsRepository := server.EnvironmentGroupsRepository.WithTx(tx)
+
+		var err error
+		environmentGroupVariable, err = environmentGroupsRepository.SetEnvironmentGroupVariable(ctx, environmentGroup.Id, mergeEnvironmentVariable(setEnvironmentGroupVariableRequest.Key, setEnvironmentGroupVariableRequest.Value, setEnvironmentGroupVariableRequest.IsSecret, existing))
+		if err != nil {
+			return err
+		}
+
+		return server.enqueueEnvironmentGroupUpdated(ctx, tx, environmentGroup.Id, setEnvironmentGroupVariableRequest.SkipRestart)
+	})
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	server.OutboxRelay.Notify()
+
+	return &app_service_pb.SetEnvironmentGroupVariableResponse{
+		Variable: EnvironmentGroupVariableToProto(environmentGroupVariable),
+	}, nil
+}
+
+func (server *GRPCAppServiceServer) DeleteEnvironmentGroupVariable(ctx context.Context, deleteEnvironmentGroupVariableRequest *app_service_pb.DeleteEnvironmentGroupVariableRequest) (*app_service_pb.DeleteEnvironmentGroupVariableResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("project.id", deleteEnvironmentGroupVariableRequest.ProjectId),
+		attribute.String("environment_group.id", deleteEnvironmentGroupVariableRequest.GroupId),
+		attribute.String("environment_variable.key", deleteEnvironmentGroupVariableRequest.Key),
+	)
+
+	environmentGroup, err := server.EnvironmentGroupsRepository.GetEnvironmentGroupById(ctx, deleteEnvironmentGroupVariableRequest.ProjectId, deleteEnvironmentGroupVariableRequest.GroupId)
+	if err == repositories.ErrEnvironmentGroupNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = server.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		environmentGroupsRepository := server.EnvironmentGroupsRepository.WithTx(tx)
+
+		err := environmentGroupsRepository.DeleteEnvironmentGroupVariable(ctx, environmentGroup.Id, deleteEnvironmentGroupVariableRequest.Key)
+		if err != nil {
+			return err
+		}
+
+		return server.enqueueEnvironmentGroupUpdated(ctx, tx, environmentGroup.Id, deleteEnvironmentGroupVariableRequest.SkipRestart)
+	})
+	if err == repositories.ErrEnvVarNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	server.OutboxRelay.Notify()
+
+	return &app_service_pb.DeleteEnvironmentGroupVariableResponse{}, nil
+}
+
+func (server *GRPCAppServiceServer) LinkEnvironmentGroup(ctx context.Context, linkEnvironmentGroupRequest *app_service_pb.LinkEnvironmentGroupRequest) (*app_service_pb.LinkEnvironmentGroupResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("project.id", linkEnvironmentGroupRequest.ProjectId),
+		attribute.String("environment_group.id", linkEnvironmentGroupRequest.GroupId),
+		attribute.String("app.id", linkEnvironmentGroupRequest.AppId),
+	)
+
+	err := server.validateEnvironmentGroupLink(ctx, linkEnvironmentGroupRequest.ProjectId, linkEnvironmentGroupRequest.GroupId, linkEnvironmentGroupRequest.AppId)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, err
+	}
+
+	err = server.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		environmentGroupsRepository := server.EnvironmentGroupsRepository.WithTx(tx)
+
+		err := environmentGroupsRepository.LinkApp(ctx, linkEnvironmentGroupRequest.GroupId, linkEnvironmentGroupRequest.AppId)
+		if err != nil {
+			return err
+		}
+
+		return server.enqueueEnvUpdated(ctx, tx, linkEnvironmentGroupRequest.AppId, linkEnvironmentGroupRequest.SkipRestart)
+	})
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	server.OutboxRelay.Notify()
+
+	return &app_service_pb.LinkEnvironmentGroupResponse{}, nil
+}
+
+func (server *GRPCAppServiceServer) UnlinkEnvironmentGroup(ctx context.Context, unlinkEnvironmentGroupRequest *app_service_pb.UnlinkEnvironmentGroupRequest) (*app_service_pb.UnlinkEnvironmentGroupResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("project.id", unlinkEnvironmentGroupRequest.ProjectId),
+		attribute.String("environment_group.id", unlinkEnvironmentGroupRequest.GroupId),
+		attribute.String("app.id", unlinkEnvironmentGroupRequest.AppId),
+	)
+
+	err := server.validateEnvironmentGroupLink(ctx, unlinkEnvironmentGroupRequest.ProjectId, unlinkEnvironmentGroupRequest.GroupId, unlinkEnvironmentGroupRequest.AppId)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, err
+	}
+
+	err = server.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		environmentGroupsRepository := server.EnvironmentGroupsRepository.WithTx(tx)
+
+		err := environmentGroupsRepository.UnlinkApp(ctx, unlinkEnvironmentGroupRequest.GroupId, unlinkEnvironmentGroupRequest.AppId)
+		if err != nil {
+			return err
+		}
+
+		return server.enqueueEnvUpdated(ctx, tx, unlinkEnvironmentGroupRequest.AppId, unlinkEnvironmentGroupRequest.SkipRestart)
+	})
+	if err == repositories.ErrEnvironmentGroupLinkNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	server.OutboxRelay.Notify()
+
+	return &app_service_pb.UnlinkEnvironmentGroupResponse{}, nil
+}
+
+func (server *GRPCAppServiceServer) GetAppPreviews(ctx context.Context, getAppPreviewsRequest *app_service_pb.GetAppPreviewsRequest) (*app_service_pb.GetAppPreviewsResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("project.id", getAppPreviewsRequest.ProjectId),
+		attribute.String("app.id", getAppPreviewsRequest.AppId),
+	)
+
+	app, err := server.AppRepository.GetAppById(ctx, getAppPreviewsRequest.ProjectId, getAppPreviewsRequest.AppId)
+	if err == repositories.ErrAppNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	previews, err := server.AppRepository.GetPreviews(ctx, app.Id)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	_previews := []*app_service_pb.App{}
+	for _, preview := range previews {
+		_previews = append(_previews, AppToProto(&preview))
+	}
+
+	span.SetAttributes(attribute.Int("apps.count", len(_previews)))
+
+	return &app_service_pb.GetAppPreviewsResponse{
+		Apps: _previews,
+	}, nil
+}
+
+// GetExistingAppIds is meant for internal callers (the janitor of the deploy service) only.
+func (server *GRPCAppServiceServer) GetExistingAppIds(ctx context.Context, getExistingAppIdsRequest *app_service_pb.GetExistingAppIdsRequest) (*app_service_pb.GetExistingAppIdsResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.Int("apps.count", len(getExistingAppIdsRequest.AppIds)))
+
+	appIds, err := server.AppRepository.GetExistingAppIds(ctx, getExistingAppIdsRequest.AppIds)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	span.SetAttributes(attribute.Int("apps.existing_count", len(appIds)))
+
+	return &app_service_pb.GetExistingAppIdsResponse{
+		AppIds: appIds,
+	}, nil
+}
+
+// GetPreviewSourceApps is meant for internal callers (the webhooks of the gateway) only.
+func (server *GRPCAppServiceServer) GetPreviewSourceApps(ctx context.Context, getPreviewSourceAppsRequest *app_service_pb.GetPreviewSourceAppsRequest) (*app_service_pb.GetPreviewSourceAppsResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.String("git_repository.clone_url", getPreviewSourceAppsRequest.CloneUrl))
+
+	apps, err := server.AppRepository.GetPreviewSourceApps(ctx, CloneURLVariants(getPreviewSourceAppsRequest.CloneUrl))
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	_apps := []*app_service_pb.App{}
+	apps_ids := []string{}
+	for _, app := range apps {
+		_apps = append(_apps, AppToProto(&app))
+		apps_ids = append(apps_ids, app.Id)
+	}
+
+	span.SetAttributes(
+		attribute.StringSlice("apps.ids", apps_ids),
+		attribute.Int("apps.count", len(apps_ids)),
+	)
+
+	return &app_service_pb.GetPreviewSourceAppsResponse{
+		Apps: _apps,
+	}, nil
+}
+
+// DeployPullRequestPreview is meant for internal callers (the webhooks of the gateway) only.
+func (server *GRPCAppServiceServer) DeployPullRequestPreview(ctx context.Context, deployPullRequestPreviewRequest *app_service_pb.DeployPullRequestPreviewRequest) (*app_service_pb.DeployPullRequestPreviewResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("app.parent_app_id", deployPullRequestPreviewRequest.ParentAppId),
+		attribute.String("user.id", deployPullRequestPreviewRequest.UserId),
+		attribute.Int("app.pull_request_number", int(deployPullRequestPreviewRequest.PullRequestNumber)),
+	)
+
+	if deployPullRequestPreviewRequest.PullRequestNumber <= 0 {
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidPullRequestNumber.Error())
+	}
+
+	parentApp, err := server.AppRepository.GetAppByIdInternal(ctx, deployPullRequestPreviewRequest.ParentAppId)
+	if err == repositories.ErrAppNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !parentApp.PreviewsEnabled || parentApp.IsPreview() {
+		return nil, status.Error(codes.FailedPrecondition, ErrPreviewsDisabled.Error())
+	}
+
+	preview, err := server.AppRepository.GetPreview(ctx, parentApp.Id, deployPullRequestPreviewRequest.PullRequestNumber)
+	if err != nil && err != repositories.ErrAppNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// new commits pushed to the pull request rebuild the existing preview
+	if preview != nil {
+		span.SetAttributes(attribute.String("app.id", preview.Id))
+
+		gitRepository, err := server.GitRepositoryRepository.GetGitRepository(ctx, preview.Id)
+		if err != nil {
+			span.SetAttributes(attribute.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		// set before the build can report back, a new build may start from any status
+		preview, err = server.AppRepository.UpdateAppStatus(ctx, preview.Id, repositories.AppStatusBuilding, repositories.UpdateAppStatusParams{})
+		if err != nil {
+			server.Logger.LogError(err.Error())
+			span.SetAttributes(attribute.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		server.Logger.LogInfo("Send AppBuildRequested Event")
+		err = server.EventBus.Publish(ctx, events_pb.EventName_APP_BUILD_REQUESTED, &events_pb.EventData{
+			Value: &events_pb.EventData_AppBuildRequestedData{
+				AppBuildRequestedData: &events_pb.AppBuildRequestedEventData{
+					UserId:        deployPullRequestPreviewRequest.UserId,
+					App:           AppToEventModel(preview),
+					GitRepository: GitRepositoryToEventModel(gitRepository),
+					GitRef:        preview.GitRef,
+				},
+			},
+		})
+		if err != nil {
+			server.Logger.LogError(err.Error())
+			span.SetAttributes(attribute.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		return &app_service_pb.DeployPullRequestPreviewResponse{
+			App: AppToProto(preview),
+		}, nil
+	}
+
+	parentGitRepository, err := server.GitRepositoryRepository.GetGitRepository(ctx, parentApp.Id)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	previewName := PreviewAppName(parentApp.Name, deployPullRequestPreviewRequest.PullRequestNumber)
+	var createdPreview *repositories.App
+	err = server.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		appRepository := server.AppRepository.WithTx(tx)
+
+		var err error
+		createdPreview, err = appRepository.CreateApp(ctx, parentApp.ProjectId, repositories.CreateAppParams{
+			Name:       previewName,
+			Runtime:    parentApp.Runtime,
+			RepoURL:    parentApp.RepoURL,
+			StartCMD:   parentApp.StartCMD,
+			BuildCMD:   parentApp.BuildCMD,
+			DomainName: utils.GetDomainName(previewName),
+			Type:       parentApp.Type,
+			PublishDir: parentApp.PublishDir,
+
+			ParentAppId:       parentApp.Id,
+			PullRequestNumber: deployPullRequestPreviewRequest.PullRequestNumber,
+			GitRef:            PullRequestGitRef(deployPullRequestPreviewRequest.PullRequestNumber),
+
+			ScaleToZeroIdleMinutes: parentApp.ScaleToZeroIdleMinutes,
+
+			// previews are short lived, they are replaced in place
+			DeploymentStrategy: repositories.DeploymentStrategyRolling,
+			// the pre-deploy and post-deploy commands are not copied, a preview inherits the
+			// environment of its parent and would run its migrations against the same database
+		})
+		if err != nil {
+			return err
+		}
+
+		span.SetAttributes(attribute.String("app.id", createdPreview.Id))
+
+		gitRepositoryRepository := server.GitRepositoryRepository.WithTx(tx)
+		createdGitRepository, err := gitRepositoryRepository.CreateGitRepository(ctx, createdPreview.Id, repositories.CreateGitRepository{
+			Provider:  parentGitRepository.Provider,
+			CloneURL:  parentGitRepository.CloneURL,
+			IsPrivate: parentGitRepository.IsPrivate,
+		})
+		if err != nil {
+			return err
+		}
+
+		server.Logger.LogInfo("Send AppCreated Event")
+		return outbox.Enqueue(ctx, tx, events_pb.EventName_APP_CREATED, &events_pb.EventData{
+			Value: &events_pb.EventData_AppCreatedData{
+				AppCreatedData: &events_pb.AppCreatedEventData{
+					App:           AppToEventModel(createdPreview),
+					GitRepository: GitRepositoryToEventModel(createdGitRepository),
+					UserId:        deployPullRequestPreviewRequest.UserId,
+					GitRef:        createdPreview.GitRef,
+				},
+			},
+		})
+	})
+
+	if err == repositories.ErrAppNameInUse || err == repositories.ErrDomainNameInUse {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+
+	if err != nil {
+		server.Logger.LogError(err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	server.OutboxRelay.Notify()
+
+	return &app_service_pb.DeployPullRequestPreviewResponse{
+		App: AppToProto(createdPreview),
+	}, nil
+}
+
+// DeletePullRequestPreview is meant for internal callers (the webhooks of the gateway) only.
+// Deleting a preview that does not exist succeeds, closing a pull request twice is not an error.
+func (server *GRPCAppServiceServer) DeletePullRequestPreview(ctx context.Context, deletePullRequestPreviewRequest *app_service_pb.DeletePullRequestPreviewRequest) (*app_service_pb.DeletePullRequestPreviewResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("app.parent_app_id", deletePullRequestPreviewRequest.ParentAppId),
+		attribute.Int("app.pull_request_number", int(deletePullRequestPreviewRequest.PullRequestNumber)),
+	)
+
+	preview, err := server.AppRepository.GetPreview(ctx, deletePullRequestPreviewRequest.ParentAppId, deletePullRequestPreviewRequest.PullRequestNumber)
+	if err == repositories.ErrAppNotFound {
+		return &app_service_pb.DeletePullRequestPreviewResponse{}, nil
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	span.SetAttributes(attribute.String("app.id", preview.Id))
+
+	err = server.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		return server.deleteApp(ctx, tx, preview)
+	})
+	if err != nil && err != repositories.ErrAppNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	server.OutboxRelay.Notify()
+
+	return &app_service_pb.DeletePullRequestPreviewResponse{}, nil
+}
+
+// validateEnvironmentGroupLink makes sure both the group and the app belong to the project.
+func (server *GRPCAppServiceServer) validateEnvironmentGroupLink(ctx context.Context, projectId, groupId, appId string) error {
+	_, err := server.EnvironmentGroupsRepository.GetEnvironmentGroupById(ctx, projectId, groupId)
+	if err == repositories.ErrEnvironmentGroupNotFound {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	_, err = server.AppRepository.GetAppById(ctx, projectId, appId)
+	if err == repositories.ErrAppNotFound {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+// enqueueEnvironmentGroupUpdated restarts every app linked to the group, tx is the transaction of the change.
+func (server *GRPCAppServiceServer) enqueueEnvironmentGroupUpdated(ctx context.Context, tx bun.Tx, groupId string, skipRestart bool) error {
+	span := trace.SpanFromContext(ctx)
+
+	environmentGroupsRepository := server.EnvironmentGroupsRepository.WithTx(tx)
+	appIds, err := environmentGroupsRepository.GetLinkedAppIds(ctx, groupId)
+	if err != nil {
+		return err
+	}
+
+	span.SetAttributes(attribute.Int("apps.count", len(appIds)))
+
+	for _, appId := range appIds {
+		err := server.enqueueEnvUpdated(ctx, tx, appId, skipRestart)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// enqueueEnvUpdated asks deploy-service to roll the running app with the new values, unless the
+// caller chose to save them for the next deployment only. The event is saved in the outbox with the
+// change, db is its transaction.
+func (server *GRPCAppServiceServer) enqueueEnvUpdated(ctx context.Context, db bun.IDB, appId string, skipRestart bool) error {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.Bool("environment_variables.skip_restart", skipRestart))
+	if skipRestart {
+		return nil
+	}
+
+	server.Logger.LogInfo("Send AppEnvUpdated Event")
+	return outbox.Enqueue(ctx, db, events_pb.EventName_APP_ENV_UPDATED, &events_pb.EventData{
+		Value: &events_pb.EventData_AppEnvUpdatedData{
+			AppEnvUpdatedData: &events_pb.AppEnvUpdatedEventData{
+				AppId: appId,
+			},
+		},
+	})
+}
+
+// mergeEnvironmentVariables converts the values sent to the bulk endpoints into upsert params.
+// Secrets sent back with the masked value keep their stored value.
+func (server *GRPCAppServiceServer) mergeEnvironmentVariables(ctx context.Context, appId string, values map[string]string) ([]repositories.SetEnvironmentVariableParams, error) {
+	existingEnvironmentVariables, err := server.EnvironmentVariablesRepository.GetEnvironmentVariables(ctx, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]repositories.EnvironmentVariable, len(existingEnvironmentVariables))
+	for _, environmentVariable := range existingEnvironmentVariables {
+		existing[environmentVariable.Key] = environmentVariable
+	}
+
+	setEnvironmentVariablesParams := make([]repositories.SetEnvironmentVariableParams, 0, len(values))
+	for key, value := range values {
+		var existingParams *repositories.SetEnvironmentVariableParams
+		if environmentVariable, ok := existing[key]; ok {
+			existingParams = &repositories.SetEnvironmentVariableParams{Value: environmentVariable.Value, IsSecret: environmentVariable.IsSecret}
+		}
+
+		setEnvironmentVariablesParams = append(setEnvironmentVariablesParams, mergeEnvironmentVariable(key, value, nil, existingParams))
+	}
+
+	return setEnvironmentVariablesParams, nil
+}
+
+// mergeEnvironmentVariable converts a value sent for key into upsert params. An existing secret stays
+// secret unless isSecret clears it, and keeps its stored value when it is sent back with the masked value.
+func mergeEnvironmentVariable(key, value string, isSecret *bool, existing *repositories.SetEnvironmentVariableParams) repositories.SetEnvironmentVariableParams {
+	params := repositories.SetEnvironmentVariableParams{Key: key, Value: value}
+	if existing != nil && existing.IsSecret {
+		params.IsSecret = true
+		if value == SecretValueMask {
+			params.Value = existing.Value
+		}
+	}
+
+	if isSecret != nil {
+		params.IsSecret = *isSecret
+	}
+
+	return params
+}
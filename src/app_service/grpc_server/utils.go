@@ -0,0 +1,442 @@
+package grpc_server
+
+import (
+	"app/dotenv"
+	"app/proto/app_service_pb"
+	"app/repositories"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"apps-hosting.com/messaging/proto/models_pb"
+)
+
+// SecretValueMask replaces the value of secret environment variables in every response.
+const SecretValueMask = "********"
+
+var (
+	ErrInvalidEnvironmentVariablesJSON = errors.New("environment variables must be a JSON object of strings")
+	ErrInvalidSchedule                 = errors.New("schedule must be a cron expression with five fields or one of @yearly, @annually, @monthly, @weekly, @daily, @midnight, @hourly")
+	ErrUnexpectedSchedule              = errors.New("schedule is only supported by cron jobs")
+	ErrInvalidPublishDir               = errors.New("publish directory must be a relative path inside the repository")
+	ErrUnexpectedPublishDir            = errors.New("publish directory is only supported by static sites")
+	ErrInvalidEnvironmentVariableName  = errors.New("environment variable names must start with a letter or an underscore and contain only letters, digits and underscores")
+	ErrDiskNotSupported                = errors.New("disks are only supported by web services and workers")
+	ErrInvalidMountPath                = errors.New("mount path must be an absolute path other than / and outside of /proc, /sys and /dev")
+	ErrInvalidDiskSize                 = errors.New("disk size must be between 1 and 100 GB")
+	ErrDiskCannotShrink                = errors.New("disk size cannot be decreased")
+	ErrPreviewsNotSupported            = errors.New("previews are only supported by web services and static sites")
+	ErrPreviewsDisabled                = errors.New("previews are not enabled for this app")
+	ErrInvalidPullRequestNumber        = errors.New("pull request number must be positive")
+	ErrScaleToZeroNotSupported         = errors.New("scale to zero is only supported by web services and static sites")
+	ErrInvalidScaleToZeroIdleMinutes   = errors.New("scale to zero idle period must be between 5 and 1440 minutes")
+	ErrUnsupportedDeploymentStrategy   = errors.New("deployment strategy must be one of rolling, blue_green or canary")
+	ErrDeploymentStrategyNotSupported  = errors.New("blue/green and canary deployments are only supported by web services and static sites")
+	ErrDeploymentStrategyWithDisk      = errors.New("blue/green and canary deployments are not supported by apps with a disk")
+	ErrCanaryWithScaleToZero           = errors.New("canary deployments are not supported by apps scaled to zero when idle")
+	ErrInvalidCanaryWeight             = errors.New("canary weight must be between 1 and 99 percent")
+	ErrInvalidRollingUpdateValue       = errors.New("rolling update max surge and max unavailable must be a number of instances or a percentage")
+	ErrInvalidRollingUpdate            = errors.New("rolling update max surge and max unavailable cannot both be 0")
+	ErrDeployCommandsNotSupported      = errors.New("pre-deploy and post-deploy commands are only supported by web services and workers")
+)
+
+const MaxDiskSizeGB = 100
+
+// DefaultCanaryWeight is used when a canary app does not set its weight.
+const DefaultCanaryWeight = 10
+
+const (
+	MinScaleToZeroIdleMinutes = 5
+	MaxScaleToZeroIdleMinutes = 24 * 60
+)
+
+var reservedMountPaths = []string{"/proc", "/sys", "/dev"}
+
+func ParseEnvironmentVariablesJSON(value string) (map[string]string, error) {
+	values := map[string]string{}
+	if err := json.Unmarshal([]byte(value), &values); err != nil {
+		return nil, ErrInvalidEnvironmentVariablesJSON
+	}
+
+	for key := range values {
+		if !dotenv.IsValidKey(key) {
+			return nil, ErrInvalidEnvironmentVariableName
+		}
+	}
+
+	return values, nil
+}
+
+var scheduleMacros = []string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly"}
+
+// ValidateSchedule requires a schedule for cron jobs only. The expression itself is
+// checked by Kubernetes, here we only reject what is obviously not a cron expression.
+func ValidateSchedule(appType repositories.AppType, schedule string) error {
+	if appType != repositories.AppTypeCronJob {
+		if len(schedule) != 0 {
+			return ErrUnexpectedSchedule
+		}
+		return nil
+	}
+
+	if strings.HasPrefix(schedule, "@") {
+		for _, macro := range scheduleMacros {
+			if schedule == macro {
+				return nil
+			}
+		}
+		return ErrInvalidSchedule
+	}
+
+	if len(strings.Fields(schedule)) != 5 {
+		return ErrInvalidSchedule
+	}
+
+	return nil
+}
+
+// NormalizePublishDir defaults the publish directory of static sites and makes sure it
+// cannot point outside of the cloned repository.
+func NormalizePublishDir(appType repositories.AppType, publishDir string) (string, error) {
+	if appType != repositories.AppTypeStaticSite {
+		if len(publishDir) != 0 {
+			return "", ErrUnexpectedPublishDir
+		}
+		return "", nil
+	}
+
+	if len(publishDir) == 0 {
+		return repositories.DefaultPublishDir, nil
+	}
+
+	publishDir = path.Clean(publishDir)
+	if path.IsAbs(publishDir) || publishDir == ".." || strings.HasPrefix(publishDir, "../") {
+		return "", ErrInvalidPublishDir
+	}
+
+	return publishDir, nil
+}
+
+// ValidateDisk checks a disk before it is attached to the app. Volumes can be
+// expanded in place but never shrunk, so the size of an existing disk can only grow.
+func ValidateDisk(appType repositories.AppType, mountPath string, sizeGB int32, currentDisk *repositories.Disk) error {
+	if appType != repositories.AppTypeWebService && appType != repositories.AppTypeWorker {
+		return ErrDiskNotSupported
+	}
+
+	if !path.IsAbs(mountPath) || path.Clean(mountPath) != mountPath || mountPath == "/" {
+		return ErrInvalidMountPath
+	}
+
+	for _, reservedMountPath := range reservedMountPaths {
+		if mountPath == reservedMountPath || strings.HasPrefix(mountPath, reservedMountPath+"/") {
+			return ErrInvalidMountPath
+		}
+	}
+
+	if sizeGB < 1 || sizeGB > MaxDiskSizeGB {
+		return ErrInvalidDiskSize
+	}
+
+	if currentDisk != nil && sizeGB < currentDisk.SizeGB {
+		return ErrDiskCannotShrink
+	}
+
+	return nil
+}
+
+// ValidatePreviewsEnabled only allows previews for the apps reachable at a domain name.
+func ValidatePreviewsEnabled(appType repositories.AppType, previewsEnabled bool) error {
+	if previewsEnabled && appType != repositories.AppTypeWebService && appType != repositories.AppTypeStaticSite {
+		return ErrPreviewsNotSupported
+	}
+
+	return nil
+}
+
+// ValidateScaleToZero only allows apps reached over http to be stopped when idle, the
+// activator has to receive their requests to start them again.
+func ValidateScaleToZero(appType repositories.AppType, idleMinutes int32) error {
+	if idleMinutes == 0 {
+		return nil
+	}
+
+	if appType != repositories.AppTypeWebService && appType != repositories.AppTypeStaticSite {
+		return ErrScaleToZeroNotSupported
+	}
+
+	if idleMinutes < MinScaleToZeroIdleMinutes || idleMinutes > MaxScaleToZeroIdleMinutes {
+		return ErrInvalidScaleToZeroIdleMinutes
+	}
+
+	return nil
+}
+
+// NormalizeDeploymentStrategy defaults an empty strategy to rolling updates and an empty
+// canary weight to DefaultCanaryWeight.
+func NormalizeDeploymentStrategy(strategy repositories.DeploymentStrategy, canaryWeight int32) (repositories.DeploymentStrategy, int32) {
+	if len(strategy) == 0 {
+		strategy = repositories.DeploymentStrategyRolling
+	}
+
+	if strategy == repositories.DeploymentStrategyCanary && canaryWeight == 0 {
+		canaryWeight = DefaultCanaryWeight
+	}
+
+	return strategy, canaryWeight
+}
+
+// ValidateDeploymentStrategy keeps blue/green and canary deployments to the apps behind a service,
+// both run the new version next to the previous one before moving the traffic.
+func ValidateDeploymentStrategy(appType repositories.AppType, strategy repositories.DeploymentStrategy, canaryWeight, scaleToZeroIdleMinutes int32, hasDisk bool) error {
+	if !slices.Contains(repositories.DeploymentStrategies, strategy) {
+		return ErrUnsupportedDeploymentStrategy
+	}
+
+	if strategy == repositories.DeploymentStrategyRolling {
+		return nil
+	}
+
+	if appType != repositories.AppTypeWebService && appType != repositories.AppTypeStaticSite {
+		return ErrDeploymentStrategyNotSupported
+	}
+
+	// a ReadWriteOnce volume cannot be mounted by both versions
+	if hasDisk {
+		return ErrDeploymentStrategyWithDisk
+	}
+
+	if strategy == repositories.DeploymentStrategyCanary {
+		// the activator only forwards to the main service of the app
+		if scaleToZeroIdleMinutes != 0 {
+			return ErrCanaryWithScaleToZero
+		}
+
+		if canaryWeight < 1 || canaryWeight > 99 {
+			return ErrInvalidCanaryWeight
+		}
+	}
+
+	return nil
+}
+
+// ValidateRollingUpdate checks the values like Kubernetes does, so that a bad value
+// is refused here instead of failing the next deployment.
+func ValidateRollingUpdate(maxSurge, maxUnavailable string) error {
+	isZero := func(value string) (bool, error) {
+		number, isPercentage := strings.CutSuffix(value, "%")
+		parsed, err := strconv.Atoi(number)
+		if err != nil || parsed < 0 || (isPercentage && parsed > 100) {
+			return false, ErrInvalidRollingUpdateValue
+		}
+
+		return parsed == 0, nil
+	}
+
+	maxSurgeIsZero, maxUnavailableIsZero := false, false
+	var err error
+
+	if len(maxSurge) != 0 {
+		if maxSurgeIsZero, err = isZero(maxSurge); err != nil {
+			return err
+		}
+	}
+
+	if len(maxUnavailable) != 0 {
+		if maxUnavailableIsZero, err = isZero(maxUnavailable); err != nil {
+			return err
+		}
+	}
+
+	if maxSurgeIsZero && maxUnavailableIsZero {
+		return ErrInvalidRollingUpdate
+	}
+
+	return nil
+}
+
+// ValidateDeployCommands keeps the pre-deploy and post-deploy commands to the apps built from
+// their code, static sites are served from an nginx image.
+func ValidateDeployCommands(appType repositories.AppType, preDeployCMD, postDeployCMD string) error {
+	if len(preDeployCMD) == 0 && len(postDeployCMD) == 0 {
+		return nil
+	}
+
+	if appType != repositories.AppTypeWebService && appType != repositories.AppTypeWorker {
+		return ErrDeployCommandsNotSupported
+	}
+
+	return nil
+}
+
+// PreviewAppName names the preview after its parent so that it is served at <app>-pr-<n>.
+func PreviewAppName(parentAppName string, pullRequestNumber int32) string {
+	return fmt.Sprintf("%s-pr-%d", parentAppName, pullRequestNumber)
+}
+
+// PullRequestGitRef is the ref GitHub keeps on the base repository for the head of a
+// pull request, it also works for pull requests opened from forks.
+func PullRequestGitRef(pullRequestNumber int32) string {
+	return fmt.Sprintf("refs/pull/%d/head", pullRequestNumber)
+}
+
+// CloneURLVariants returns the forms a GitHub clone url can be stored with.
+func CloneURLVariants(cloneURL string) []string {
+	cloneURL = strings.TrimSuffix(cloneURL, "/")
+	withoutSuffix := strings.TrimSuffix(cloneURL, ".git")
+	return []string{withoutSuffix, withoutSuffix + ".git"}
+}
+
+func AppToProto(app *repositories.App) *app_service_pb.App {
+	return &app_service_pb.App{
+		Id:         app.Id,
+		ProjectId:  app.ProjectId,
+		Name:       app.Name,
+		DomainName: app.DomainName,
+		Runtime:    app.Runtime,
+		RepoUrl:    app.RepoURL,
+		BuildCmd:   app.BuildCMD,
+		StartCmd:   app.StartCMD,
+		CreatedAt:  app.CreatedAt.String(),
+		Type:       string(app.Type),
+		Schedule:   app.Schedule,
+		PublishDir: app.PublishDir,
+
+		PreviewsEnabled:   app.PreviewsEnabled,
+		ParentAppId:       app.ParentAppId,
+		PullRequestNumber: app.PullRequestNumber,
+		Suspended:         app.Suspended,
+
+		ScaleToZeroIdleMinutes: app.ScaleToZeroIdleMinutes,
+
+		DeploymentStrategy:    string(app.DeploymentStrategy),
+		RollingMaxSurge:       app.RollingMaxSurge,
+		RollingMaxUnavailable: app.RollingMaxUnavailable,
+		CanaryWeight:          app.CanaryWeight,
+
+		PreDeployCmd:  app.PreDeployCMD,
+		PostDeployCmd: app.PostDeployCMD,
+
+		Status:                 string(app.Status),
+		StatusUpdatedAt:        app.StatusUpdatedAt.String(),
+		LastBuildId:            app.LastBuildId,
+		LastCommitHash:         app.LastCommitHash,
+		LastDeployedBuildId:    app.LastDeployedBuildId,
+		LastDeployedCommitHash: app.LastDeployedCommitHash,
+	}
+}
+
+// AppToEventModel converts the app for the events read by build-service.
+func AppToEventModel(app *repositories.App) *models_pb.App {
+	return &models_pb.App{
+		Id:         app.Id,
+		ProjectId:  app.ProjectId,
+		Name:       app.Name,
+		DomainName: app.DomainName,
+		Runtime:    app.Runtime,
+		RepoUrl:    app.RepoURL,
+		BuildCmd:   app.BuildCMD,
+		StartCmd:   app.StartCMD,
+		CreatedAt:  app.CreatedAt.String(),
+		Type:       string(app.Type),
+		PublishDir: app.PublishDir,
+	}
+}
+
+// FIXME: Mapping should be done in the gateway-service
+var gitProviders = map[string]models_pb.GitProvider{
+	"github": models_pb.GitProvider_GITHUB,
+}
+
+func GitRepositoryToEventModel(gitRepository *repositories.GitRepository) *models_pb.GitRepository {
+	return &models_pb.GitRepository{
+		Id:        gitRepository.Id,
+		AppId:     gitRepository.AppId,
+		CloneUrl:  gitRepository.CloneURL,
+		IsPrivate: gitRepository.IsPrivate,
+		Provider:  gitProviders[gitRepository.Provider],
+		CreatedAt: gitRepository.CreatedAt.String(),
+	}
+}
+
+func EnvironmentVariableToProto(environmentVariable *repositories.EnvironmentVariable) *app_service_pb.EnvironmentVariable {
+	value := environmentVariable.Value
+	if environmentVariable.IsSecret {
+		value = SecretValueMask
+	}
+
+	return &app_service_pb.EnvironmentVariable{
+		Id:        environmentVariable.Id,
+		AppId:     environmentVariable.AppId,
+		Key:       environmentVariable.Key,
+		Value:     value,
+		IsSecret:  environmentVariable.IsSecret,
+		CreatedAt: environmentVariable.CreatedAt.String(),
+		UpdatedAt: environmentVariable.UpdatedAt.String(),
+	}
+}
+
+func EnvironmentVariableListToProto(environmentVariables []repositories.EnvironmentVariable) []*app_service_pb.EnvironmentVariable {
+	_environmentVariables := make([]*app_service_pb.EnvironmentVariable, 0, len(environmentVariables))
+	for _, environmentVariable := range environmentVariables {
+		_environmentVariables = append(_environmentVariables, EnvironmentVariableToProto(&environmentVariable))
+	}
+	return _environmentVariables
+}
+
+func EnvironmentGroupToProto(environmentGroup *repositories.EnvironmentGroup) *app_service_pb.EnvironmentGroup {
+	return &app_service_pb.EnvironmentGroup{
+		Id:        environmentGroup.Id,
+		ProjectId: environmentGroup.ProjectId,
+		Name:      environmentGroup.Name,
+		CreatedAt: environmentGroup.CreatedAt.String(),
+	}
+}
+
+func EnvironmentGroupVariableToProto(environmentGroupVariable *repositories.EnvironmentGroupVariable) *app_service_pb.EnvironmentGroupVariable {
+	value := environmentGroupVariable.Value
+	if environmentGroupVariable.IsSecret {
+		value = SecretValueMask
+	}
+
+	return &app_service_pb.EnvironmentGroupVariable{
+		Id:        environmentGroupVariable.Id,
+		GroupId:   environmentGroupVariable.GroupId,
+		Key:       environmentGroupVariable.Key,
+		Value:     value,
+		IsSecret:  environmentGroupVariable.IsSecret,
+		CreatedAt: environmentGroupVariable.CreatedAt.String(),
+		UpdatedAt: environmentGroupVariable.UpdatedAt.String(),
+	}
+}
+
+// EnvironmentVariablesToLegacyProto renders the variables as the JSON object
+// still used by the bulk endpoints, with secret values masked.
+func EnvironmentVariablesToLegacyProto(appId string, environmentVariables []repositories.EnvironmentVariable) *app_service_pb.EnvironmentVariables {
+	values := make(map[string]string, len(environmentVariables))
+	for _, environmentVariable := range environmentVariables {
+		values[environmentVariable.Key] = EnvironmentVariableToProto(&environmentVariable).Value
+	}
+
+	value, _ := json.Marshal(values)
+
+	return &app_service_pb.EnvironmentVariables{
+		Id:    appId,
+		Value: string(value),
+	}
+}
+
+func DiskToProto(disk *repositories.Disk) *app_service_pb.Disk {
+	return &app_service_pb.Disk{
+		Id:        disk.Id,
+		AppId:     disk.AppId,
+		MountPath: disk.MountPath,
+		SizeGb:    disk.SizeGB,
+		CreatedAt: disk.CreatedAt.String(),
+		UpdatedAt: disk.UpdatedAt.String(),
+	}
+}
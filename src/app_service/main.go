@@ -2,6 +2,7 @@ package main
 
 import (
 	"app/database"
+	"app/encryption"
 	"app/eventshandlers"
 	"app/grpc_server"
 	"app/proto/app_service_pb"
@@ -12,6 +13,8 @@ import (
 
 	"apps-hosting.com/logging"
 	"apps-hosting.com/messaging"
+	"apps-hosting.com/messaging/dedup"
+	"apps-hosting.com/messaging/outbox"
 	"apps-hosting.com/messaging/proto/events_pb"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
@@ -64,11 +67,24 @@ func main() {
 	logger.LogInfo("Connecting to the app_service Database.")
 	database := database.NewDatabase()
 
+	encryptionKeyId := os.Getenv("ENCRYPTION_KEY_ID")
+	if encryptionKeyId == "" {
+		encryptionKeyId = "local"
+	}
+
+	keyProvider, err := encryption.NewLocalKeyProvider(encryptionKeyId, os.Getenv("ENCRYPTION_MASTER_KEY"))
+	if err != nil {
+		panic(err)
+	}
+	encryptor := encryption.NewEnvelopeEncryptor(keyProvider)
+
 	appRepository := repositories.NewAppRepository(database, logger)
-	environmentVariablesRepository := repositories.NewEnvironmentVariablesRepository(database, logger)
+	environmentVariablesRepository := repositories.NewEnvironmentVariablesRepository(database, encryptor, logger)
+	environmentGroupsRepository := repositories.NewEnvironmentGroupsRepository(database, encryptor, logger)
+	diskRepository := repositories.NewDiskRepository(database, logger)
 	gitRepositoryRepository := repositories.NewGitRepositoryRepository(database, logger)
 
-	_, err := appRepository.CreateAppsTable()
+	_, err = appRepository.CreateAppsTable()
 	if err != nil {
 		panic(err)
 	}
@@ -78,41 +94,83 @@ func main() {
 		panic(err)
 	}
 
+	err = environmentVariablesRepository.MigrateLegacyEnvironmentVariables()
+	if err != nil {
+		panic(err)
+	}
+
+	err = environmentGroupsRepository.CreateEnvironmentGroupsTables()
+	if err != nil {
+		panic(err)
+	}
+
+	_, err = diskRepository.CreateDisksTable()
+	if err != nil {
+		panic(err)
+	}
+
 	_, err = gitRepositoryRepository.CreateGitRepositoryRepositoryTable()
 	if err != nil {
 		panic(err)
 	}
 
+	err = outbox.CreateOutboxTable(ctx, database)
+	if err != nil {
+		panic(err)
+	}
+
 	natsURL := os.Getenv("NATS_URL")
-	eventBus, err := messaging.NewEventBus(
-		serviceName,
-		natsURL,
-		events_pb.StreamName_APP_STREAM,
-		[]events_pb.EventName{
-			events_pb.EventName_APP_CREATED,
-			events_pb.EventName_APP_DELETED,
-		},
-	)
+	eventBus, err := messaging.NewEventBus(serviceName, natsURL, messaging.AppStream)
+
+	if err != nil {
+		panic(err)
+	}
 
+	deduplicator := dedup.NewDeduplicator(database)
+	err = deduplicator.CreateProcessedMessagesTable(ctx)
 	if err != nil {
 		panic(err)
 	}
+	go deduplicator.Run(ctx)
+
+	outboxRelay := outbox.NewRelay(database, eventBus, outbox.DefaultRelayInterval)
+	go outboxRelay.Run(ctx)
 
 	eventsHandlers := eventshandlers.NewEventsHandlers(
-		*eventBus,
+		database,
+		outboxRelay,
+		eventBus,
 		appRepository,
 		environmentVariablesRepository,
+		environmentGroupsRepository,
+		diskRepository,
 		gitRepositoryRepository,
 		logger,
 	)
 
-	err = eventBus.Subscribe(events_pb.EventName_PROJECT_DELETED, eventsHandlers.HandleProjectDeletedEvent)
+	err = eventBus.Subscribe(events_pb.EventName_PROJECT_DELETED, deduplicator.Idempotent(eventsHandlers.HandleProjectDeletedEvent))
 	if err != nil {
 		logger.LogErrorF("failed to subscribe to '%s': %v", events_pb.EventName_name[int32(events_pb.EventName_PROJECT_DELETED)], err)
 	}
+	err = eventBus.Subscribe(events_pb.EventName_BUILD_COMPLETED, deduplicator.Idempotent(eventsHandlers.HandleBuildCompletedEvent))
+	if err != nil {
+		logger.LogErrorF("failed to subscribe to '%s': %v", events_pb.EventName_name[int32(events_pb.EventName_BUILD_COMPLETED)], err)
+	}
+	err = eventBus.Subscribe(events_pb.EventName_BUILD_FAILED, deduplicator.Idempotent(eventsHandlers.HandleBuildFailedEvent))
+	if err != nil {
+		logger.LogErrorF("failed to subscribe to '%s': %v", events_pb.EventName_name[int32(events_pb.EventName_BUILD_FAILED)], err)
+	}
+	err = eventBus.Subscribe(events_pb.EventName_DEPLOY_COMPLETED, deduplicator.Idempotent(eventsHandlers.HandleDeployCompletedEvent))
+	if err != nil {
+		logger.LogErrorF("failed to subscribe to '%s': %v", events_pb.EventName_name[int32(events_pb.EventName_DEPLOY_COMPLETED)], err)
+	}
+	err = eventBus.Subscribe(events_pb.EventName_DEPLOY_FAILED, deduplicator.Idempotent(eventsHandlers.HandleDeployFailedEvent))
+	if err != nil {
+		logger.LogErrorF("failed to subscribe to '%s': %v", events_pb.EventName_name[int32(events_pb.EventName_DEPLOY_FAILED)], err)
+	}
 
 	grpcServer := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
-	grpcAppServiceServer := grpc_server.NewGRPCAppServiceServer(appRepository, environmentVariablesRepository, gitRepositoryRepository, *eventBus, logger)
+	grpcAppServiceServer := grpc_server.NewGRPCAppServiceServer(database, appRepository, environmentVariablesRepository, environmentGroupsRepository, diskRepository, gitRepositoryRepository, eventBus, outboxRelay, logger)
 	app_service_pb.RegisterAppServiceServer(grpcServer, grpcAppServiceServer)
 
 	PORT := os.Getenv("PORT")
@@ -19,8 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DeployService_GetDeployments_FullMethodName = "/deploy_service.DeployService/GetDeployments"
-	DeployService_Health_FullMethodName         = "/deploy_service.DeployService/Health"
+	DeployService_GetDeployments_FullMethodName      = "/deploy_service.DeployService/GetDeployments"
+	DeployService_PromoteDeployment_FullMethodName   = "/deploy_service.DeployService/PromoteDeployment"
+	DeployService_AbortDeployment_FullMethodName     = "/deploy_service.DeployService/AbortDeployment"
+	DeployService_GetAppRuntimeStatus_FullMethodName = "/deploy_service.DeployService/GetAppRuntimeStatus"
+	DeployService_GetAppInstances_FullMethodName     = "/deploy_service.DeployService/GetAppInstances"
+	DeployService_RestartApp_FullMethodName          = "/deploy_service.DeployService/RestartApp"
+	DeployService_RestartInstance_FullMethodName     = "/deploy_service.DeployService/RestartInstance"
+	DeployService_WatchAppEvents_FullMethodName      = "/deploy_service.DeployService/WatchAppEvents"
+	DeployService_Health_FullMethodName              = "/deploy_service.DeployService/Health"
 )
 
 // DeployServiceClient is the client API for DeployService service.
@@ -28,6 +35,20 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DeployServiceClient interface {
 	GetDeployments(ctx context.Context, in *GetDeploymentsRequest, opts ...grpc.CallOption) (*GetDeploymentsResponse, error)
+	// PromoteDeployment sends every request to the canary deployment of the app and removes the previous one.
+	PromoteDeployment(ctx context.Context, in *PromoteDeploymentRequest, opts ...grpc.CallOption) (*PromoteDeploymentResponse, error)
+	// AbortDeployment removes the canary deployment of the app, the previous one keeps serving.
+	AbortDeployment(ctx context.Context, in *AbortDeploymentRequest, opts ...grpc.CallOption) (*AbortDeploymentResponse, error)
+	// GetAppRuntimeStatus reads the replicas and the pods of the app from the cluster.
+	GetAppRuntimeStatus(ctx context.Context, in *GetAppRuntimeStatusRequest, opts ...grpc.CallOption) (*GetAppRuntimeStatusResponse, error)
+	// GetAppInstances returns the pods of the app and the recent Kubernetes events about its resources.
+	GetAppInstances(ctx context.Context, in *GetAppInstancesRequest, opts ...grpc.CallOption) (*GetAppInstancesResponse, error)
+	// RestartApp replaces the instances of the app one by one, RestartInstance replaces a single one.
+	// Both are recorded in the deployments of the app.
+	RestartApp(ctx context.Context, in *RestartAppRequest, opts ...grpc.CallOption) (*RestartAppResponse, error)
+	RestartInstance(ctx context.Context, in *RestartInstanceRequest, opts ...grpc.CallOption) (*RestartInstanceResponse, error)
+	// WatchAppEvents streams the app.*, build.* and deploy.* events about the app as they are published.
+	WatchAppEvents(ctx context.Context, in *WatchAppEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchAppEventsResponse], error)
 	Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
 }
 
@@ -49,6 +70,85 @@ func (c *deployServiceClient) GetDeployments(ctx context.Context, in *GetDeploym
 	return out, nil
 }
 
+func (c *deployServiceClient) PromoteDeployment(ctx context.Context, in *PromoteDeploymentRequest, opts ...grpc.CallOption) (*PromoteDeploymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromoteDeploymentResponse)
+	err := c.cc.Invoke(ctx, DeployService_PromoteDeployment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deployServiceClient) AbortDeployment(ctx context.Context, in *AbortDeploymentRequest, opts ...grpc.CallOption) (*AbortDeploymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbortDeploymentResponse)
+	err := c.cc.Invoke(ctx, DeployService_AbortDeployment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deployServiceClient) GetAppRuntimeStatus(ctx context.Context, in *GetAppRuntimeStatusRequest, opts ...grpc.CallOption) (*GetAppRuntimeStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAppRuntimeStatusResponse)
+	err := c.cc.Invoke(ctx, DeployService_GetAppRuntimeStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deployServiceClient) GetAppInstances(ctx context.Context, in *GetAppInstancesRequest, opts ...grpc.CallOption) (*GetAppInstancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAppInstancesResponse)
+	err := c.cc.Invoke(ctx, DeployService_GetAppInstances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deployServiceClient) RestartApp(ctx context.Context, in *RestartAppRequest, opts ...grpc.CallOption) (*RestartAppResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestartAppResponse)
+	err := c.cc.Invoke(ctx, DeployService_RestartApp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deployServiceClient) RestartInstance(ctx context.Context, in *RestartInstanceRequest, opts ...grpc.CallOption) (*RestartInstanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestartInstanceResponse)
+	err := c.cc.Invoke(ctx, DeployService_RestartInstance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deployServiceClient) WatchAppEvents(ctx context.Context, in *WatchAppEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchAppEventsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DeployService_ServiceDesc.Streams[0], DeployService_WatchAppEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchAppEventsRequest, WatchAppEventsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DeployService_WatchAppEventsClient = grpc.ServerStreamingClient[WatchAppEventsResponse]
+
 func (c *deployServiceClient) Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HealthResponse)
@@ -64,6 +164,20 @@ func (c *deployServiceClient) Health(ctx context.Context, in *HealthRequest, opt
 // for forward compatibility.
 type DeployServiceServer interface {
 	GetDeployments(context.Context, *GetDeploymentsRequest) (*GetDeploymentsResponse, error)
+	// PromoteDeployment sends every request to the canary deployment of the app and removes the previous one.
+	PromoteDeployment(context.Context, *PromoteDeploymentRequest) (*PromoteDeploymentResponse, error)
+	// AbortDeployment removes the canary deployment of the app, the previous one keeps serving.
+	AbortDeployment(context.Context, *AbortDeploymentRequest) (*AbortDeploymentResponse, error)
+	// GetAppRuntimeStatus reads the replicas and the pods of the app from the cluster.
+	GetAppRuntimeStatus(context.Context, *GetAppRuntimeStatusRequest) (*GetAppRuntimeStatusResponse, error)
+	// GetAppInstances returns the pods of the app and the recent Kubernetes events about its resources.
+	GetAppInstances(context.Context, *GetAppInstancesRequest) (*GetAppInstancesResponse, error)
+	// RestartApp replaces the instances of the app one by one, RestartInstance replaces a single one.
+	// Both are recorded in the deployments of the app.
+	RestartApp(context.Context, *RestartAppRequest) (*RestartAppResponse, error)
+	RestartInstance(context.Context, *RestartInstanceRequest) (*RestartInstanceResponse, error)
+	// WatchAppEvents streams the app.*, build.* and deploy.* events about the app as they are published.
+	WatchAppEvents(*WatchAppEventsRequest, grpc.ServerStreamingServer[WatchAppEventsResponse]) error
 	Health(context.Context, *HealthRequest) (*HealthResponse, error)
 	mustEmbedUnimplementedDeployServiceServer()
 }
@@ -78,6 +192,27 @@ type UnimplementedDeployServiceServer struct{}
 func (UnimplementedDeployServiceServer) GetDeployments(context.Context, *GetDeploymentsRequest) (*GetDeploymentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDeployments not implemented")
 }
+func (UnimplementedDeployServiceServer) PromoteDeployment(context.Context, *PromoteDeploymentRequest) (*PromoteDeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteDeployment not implemented")
+}
+func (UnimplementedDeployServiceServer) AbortDeployment(context.Context, *AbortDeploymentRequest) (*AbortDeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortDeployment not implemented")
+}
+func (UnimplementedDeployServiceServer) GetAppRuntimeStatus(context.Context, *GetAppRuntimeStatusRequest) (*GetAppRuntimeStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppRuntimeStatus not implemented")
+}
+func (UnimplementedDeployServiceServer) GetAppInstances(context.Context, *GetAppInstancesRequest) (*GetAppInstancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppInstances not implemented")
+}
+func (UnimplementedDeployServiceServer) RestartApp(context.Context, *RestartAppRequest) (*RestartAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartApp not implemented")
+}
+func (UnimplementedDeployServiceServer) RestartInstance(context.Context, *RestartInstanceRequest) (*RestartInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartInstance not implemented")
+}
+func (UnimplementedDeployServiceServer) WatchAppEvents(*WatchAppEventsRequest, grpc.ServerStreamingServer[WatchAppEventsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAppEvents not implemented")
+}
 func (UnimplementedDeployServiceServer) Health(context.Context, *HealthRequest) (*HealthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
 }
@@ -120,6 +255,125 @@ func _DeployService_GetDeployments_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeployService_PromoteDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployServiceServer).PromoteDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeployService_PromoteDeployment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeployServiceServer).PromoteDeployment(ctx, req.(*PromoteDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeployService_AbortDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployServiceServer).AbortDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeployService_AbortDeployment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeployServiceServer).AbortDeployment(ctx, req.(*AbortDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeployService_GetAppRuntimeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppRuntimeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployServiceServer).GetAppRuntimeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeployService_GetAppRuntimeStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeployServiceServer).GetAppRuntimeStatus(ctx, req.(*GetAppRuntimeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeployService_GetAppInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppInstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployServiceServer).GetAppInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeployService_GetAppInstances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeployServiceServer).GetAppInstances(ctx, req.(*GetAppInstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeployService_RestartApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployServiceServer).RestartApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeployService_RestartApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeployServiceServer).RestartApp(ctx, req.(*RestartAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeployService_RestartInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployServiceServer).RestartInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeployService_RestartInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeployServiceServer).RestartInstance(ctx, req.(*RestartInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeployService_WatchAppEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchAppEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeployServiceServer).WatchAppEvents(m, &grpc.GenericServerStream[WatchAppEventsRequest, WatchAppEventsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DeployService_WatchAppEventsServer = grpc.ServerStreamingServer[WatchAppEventsResponse]
+
 func _DeployService_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HealthRequest)
 	if err := dec(in); err != nil {
@@ -149,11 +403,41 @@ var DeployService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDeployments",
 			Handler:    _DeployService_GetDeployments_Handler,
 		},
+		{
+			MethodName: "PromoteDeployment",
+			Handler:    _DeployService_PromoteDeployment_Handler,
+		},
+		{
+			MethodName: "AbortDeployment",
+			Handler:    _DeployService_AbortDeployment_Handler,
+		},
+		{
+			MethodName: "GetAppRuntimeStatus",
+			Handler:    _DeployService_GetAppRuntimeStatus_Handler,
+		},
+		{
+			MethodName: "GetAppInstances",
+			Handler:    _DeployService_GetAppInstances_Handler,
+		},
+		{
+			MethodName: "RestartApp",
+			Handler:    _DeployService_RestartApp_Handler,
+		},
+		{
+			MethodName: "RestartInstance",
+			Handler:    _DeployService_RestartInstance_Handler,
+		},
 		{
 			MethodName: "Health",
 			Handler:    _DeployService_Health_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchAppEvents",
+			Handler:       _DeployService_WatchAppEvents_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "src/protos/deploy_service.proto",
 }
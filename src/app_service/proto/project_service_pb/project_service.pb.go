@@ -89,29 +89,921 @@ func (x *Project) GetCreatedAt() string {
 	return ""
 }
 
+type AddOn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Host          string                 `protobuf:"bytes,6,opt,name=host,proto3" json:"host,omitempty"`
+	Port          int32                  `protobuf:"varint,7,opt,name=port,proto3" json:"port,omitempty"`
+	Database      string                 `protobuf:"bytes,8,opt,name=database,proto3" json:"database,omitempty"`
+	Username      string                 `protobuf:"bytes,9,opt,name=username,proto3" json:"username,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddOn) Reset() {
+	*x = AddOn{}
+	mi := &file_src_protos_project_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOn) ProtoMessage() {}
+
+func (x *AddOn) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOn.ProtoReflect.Descriptor instead.
+func (*AddOn) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddOn) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddOn) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *AddOn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddOn) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AddOn) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AddOn) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *AddOn) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *AddOn) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *AddOn) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AddOn) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AddOnLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AddOnId       string                 `protobuf:"bytes,1,opt,name=add_on_id,json=addOnId,proto3" json:"add_on_id,omitempty"`
+	AppId         string                 `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	EnvVarName    string                 `protobuf:"bytes,3,opt,name=env_var_name,json=envVarName,proto3" json:"env_var_name,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddOnLink) Reset() {
+	*x = AddOnLink{}
+	mi := &file_src_protos_project_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOnLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOnLink) ProtoMessage() {}
+
+func (x *AddOnLink) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOnLink.ProtoReflect.Descriptor instead.
+func (*AddOnLink) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddOnLink) GetAddOnId() string {
+	if x != nil {
+		return x.AddOnId
+	}
+	return ""
+}
+
+func (x *AddOnLink) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *AddOnLink) GetEnvVarName() string {
+	if x != nil {
+		return x.EnvVarName
+	}
+	return ""
+}
+
+func (x *AddOnLink) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
 type CreateProjectRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectRequest) Reset() {
+	*x = CreateProjectRequest{}
+	mi := &file_src_protos_project_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectRequest) ProtoMessage() {}
+
+func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CreateProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectResponse) Reset() {
+	*x = CreateProjectResponse{}
+	mi := &file_src_protos_project_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectResponse) ProtoMessage() {}
+
+func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
+func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type GetUserProjectByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProjectByIdRequest) Reset() {
+	*x = GetUserProjectByIdRequest{}
+	mi := &file_src_protos_project_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProjectByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProjectByIdRequest) ProtoMessage() {}
+
+func (x *GetUserProjectByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProjectByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetUserProjectByIdRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetUserProjectByIdRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GetUserProjectByIdRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserProjectByIdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProjectByIdResponse) Reset() {
+	*x = GetUserProjectByIdResponse{}
+	mi := &file_src_protos_project_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProjectByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProjectByIdResponse) ProtoMessage() {}
+
+func (x *GetUserProjectByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProjectByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetUserProjectByIdResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetUserProjectByIdResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type GetProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectRequest) Reset() {
+	*x = GetProjectRequest{}
+	mi := &file_src_protos_project_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectRequest) ProtoMessage() {}
+
+func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GetProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectResponse) Reset() {
+	*x = GetProjectResponse{}
+	mi := &file_src_protos_project_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectResponse) ProtoMessage() {}
+
+func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type GetUserProjectsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProjectsRequest) Reset() {
+	*x = GetUserProjectsRequest{}
+	mi := &file_src_protos_project_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProjectsRequest) ProtoMessage() {}
+
+func (x *GetUserProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProjectsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetUserProjectsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProjectsResponse) Reset() {
+	*x = GetUserProjectsResponse{}
+	mi := &file_src_protos_project_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProjectsResponse) ProtoMessage() {}
+
+func (x *GetUserProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProjectsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetUserProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+type DeleteUserProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserProjectRequest) Reset() {
+	*x = DeleteUserProjectRequest{}
+	mi := &file_src_protos_project_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserProjectRequest) ProtoMessage() {}
+
+func (x *DeleteUserProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserProjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserProjectRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteUserProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *DeleteUserProjectRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteUserProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserProjectResponse) Reset() {
+	*x = DeleteUserProjectResponse{}
+	mi := &file_src_protos_project_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserProjectResponse) ProtoMessage() {}
+
+func (x *DeleteUserProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserProjectResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserProjectResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{12}
+}
+
+type UpdateProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectRequest) Reset() {
+	*x = UpdateProjectRequest{}
+	mi := &file_src_protos_project_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectRequest) ProtoMessage() {}
+
+func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *UpdateProjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectResponse) Reset() {
+	*x = UpdateProjectResponse{}
+	mi := &file_src_protos_project_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectResponse) ProtoMessage() {}
+
+func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type CreateAddOnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAddOnRequest) Reset() {
+	*x = CreateAddOnRequest{}
+	mi := &file_src_protos_project_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAddOnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAddOnRequest) ProtoMessage() {}
+
+func (x *CreateAddOnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAddOnRequest.ProtoReflect.Descriptor instead.
+func (*CreateAddOnRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateAddOnRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateAddOnRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAddOnRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type CreateAddOnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AddOn         *AddOn                 `protobuf:"bytes,1,opt,name=add_on,json=addOn,proto3" json:"add_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAddOnResponse) Reset() {
+	*x = CreateAddOnResponse{}
+	mi := &file_src_protos_project_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAddOnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAddOnResponse) ProtoMessage() {}
+
+func (x *CreateAddOnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAddOnResponse.ProtoReflect.Descriptor instead.
+func (*CreateAddOnResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateAddOnResponse) GetAddOn() *AddOn {
+	if x != nil {
+		return x.AddOn
+	}
+	return nil
+}
+
+type GetAddOnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AddOnId       string                 `protobuf:"bytes,2,opt,name=add_on_id,json=addOnId,proto3" json:"add_on_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddOnRequest) Reset() {
+	*x = GetAddOnRequest{}
+	mi := &file_src_protos_project_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddOnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddOnRequest) ProtoMessage() {}
+
+func (x *GetAddOnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddOnRequest.ProtoReflect.Descriptor instead.
+func (*GetAddOnRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetAddOnRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GetAddOnRequest) GetAddOnId() string {
+	if x != nil {
+		return x.AddOnId
+	}
+	return ""
+}
+
+type GetAddOnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AddOn         *AddOn                 `protobuf:"bytes,1,opt,name=add_on,json=addOn,proto3" json:"add_on,omitempty"`
+	Links         []*AddOnLink           `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProjectRequest) Reset() {
-	*x = CreateProjectRequest{}
-	mi := &file_src_protos_project_service_proto_msgTypes[1]
+func (x *GetAddOnResponse) Reset() {
+	*x = GetAddOnResponse{}
+	mi := &file_src_protos_project_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProjectRequest) String() string {
+func (x *GetAddOnResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProjectRequest) ProtoMessage() {}
+func (*GetAddOnResponse) ProtoMessage() {}
 
-func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_project_service_proto_msgTypes[1]
+func (x *GetAddOnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -122,47 +1014,91 @@ func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
-func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_project_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use GetAddOnResponse.ProtoReflect.Descriptor instead.
+func (*GetAddOnResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CreateProjectRequest) GetName() string {
+func (x *GetAddOnResponse) GetAddOn() *AddOn {
 	if x != nil {
-		return x.Name
+		return x.AddOn
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateProjectRequest) GetUserId() string {
+func (x *GetAddOnResponse) GetLinks() []*AddOnLink {
 	if x != nil {
-		return x.UserId
+		return x.Links
+	}
+	return nil
+}
+
+type GetAddOnsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddOnsRequest) Reset() {
+	*x = GetAddOnsRequest{}
+	mi := &file_src_protos_project_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddOnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddOnsRequest) ProtoMessage() {}
+
+func (x *GetAddOnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddOnsRequest.ProtoReflect.Descriptor instead.
+func (*GetAddOnsRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetAddOnsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
 	}
 	return ""
 }
 
-type CreateProjectResponse struct {
+type GetAddOnsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	AddOns        []*AddOn               `protobuf:"bytes,1,rep,name=add_ons,json=addOns,proto3" json:"add_ons,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProjectResponse) Reset() {
-	*x = CreateProjectResponse{}
-	mi := &file_src_protos_project_service_proto_msgTypes[2]
+func (x *GetAddOnsResponse) Reset() {
+	*x = GetAddOnsResponse{}
+	mi := &file_src_protos_project_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProjectResponse) String() string {
+func (x *GetAddOnsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProjectResponse) ProtoMessage() {}
+func (*GetAddOnsResponse) ProtoMessage() {}
 
-func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_project_service_proto_msgTypes[2]
+func (x *GetAddOnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -173,41 +1109,42 @@ func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
-func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_project_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GetAddOnsResponse.ProtoReflect.Descriptor instead.
+func (*GetAddOnsResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *CreateProjectResponse) GetProject() *Project {
+func (x *GetAddOnsResponse) GetAddOns() []*AddOn {
 	if x != nil {
-		return x.Project
+		return x.AddOns
 	}
 	return nil
 }
 
-type GetUserProjectByIdRequest struct {
+type DeleteAddOnRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AddOnId       string                 `protobuf:"bytes,2,opt,name=add_on_id,json=addOnId,proto3" json:"add_on_id,omitempty"`
+	SkipRestart   bool                   `protobuf:"varint,3,opt,name=skip_restart,json=skipRestart,proto3" json:"skip_restart,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserProjectByIdRequest) Reset() {
-	*x = GetUserProjectByIdRequest{}
-	mi := &file_src_protos_project_service_proto_msgTypes[3]
+func (x *DeleteAddOnRequest) Reset() {
+	*x = DeleteAddOnRequest{}
+	mi := &file_src_protos_project_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserProjectByIdRequest) String() string {
+func (x *DeleteAddOnRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserProjectByIdRequest) ProtoMessage() {}
+func (*DeleteAddOnRequest) ProtoMessage() {}
 
-func (x *GetUserProjectByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_project_service_proto_msgTypes[3]
+func (x *DeleteAddOnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -218,47 +1155,53 @@ func (x *GetUserProjectByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserProjectByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetUserProjectByIdRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_project_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use DeleteAddOnRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAddOnRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetUserProjectByIdRequest) GetProjectId() string {
+func (x *DeleteAddOnRequest) GetProjectId() string {
 	if x != nil {
 		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *GetUserProjectByIdRequest) GetUserId() string {
+func (x *DeleteAddOnRequest) GetAddOnId() string {
 	if x != nil {
-		return x.UserId
+		return x.AddOnId
 	}
 	return ""
 }
 
-type GetUserProjectByIdResponse struct {
+func (x *DeleteAddOnRequest) GetSkipRestart() bool {
+	if x != nil {
+		return x.SkipRestart
+	}
+	return false
+}
+
+type DeleteAddOnResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserProjectByIdResponse) Reset() {
-	*x = GetUserProjectByIdResponse{}
-	mi := &file_src_protos_project_service_proto_msgTypes[4]
+func (x *DeleteAddOnResponse) Reset() {
+	*x = DeleteAddOnResponse{}
+	mi := &file_src_protos_project_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserProjectByIdResponse) String() string {
+func (x *DeleteAddOnResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserProjectByIdResponse) ProtoMessage() {}
+func (*DeleteAddOnResponse) ProtoMessage() {}
 
-func (x *GetUserProjectByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_project_service_proto_msgTypes[4]
+func (x *DeleteAddOnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,40 +1212,38 @@ func (x *GetUserProjectByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserProjectByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetUserProjectByIdResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_project_service_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *GetUserProjectByIdResponse) GetProject() *Project {
-	if x != nil {
-		return x.Project
-	}
-	return nil
+// Deprecated: Use DeleteAddOnResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAddOnResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{22}
 }
 
-type GetUserProjectsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+type LinkAddOnRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AddOnId   string                 `protobuf:"bytes,2,opt,name=add_on_id,json=addOnId,proto3" json:"add_on_id,omitempty"`
+	AppId     string                 `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// env_var_name defaults to DATABASE_URL for postgres and REDIS_URL for redis.
+	EnvVarName    string `protobuf:"bytes,4,opt,name=env_var_name,json=envVarName,proto3" json:"env_var_name,omitempty"`
+	SkipRestart   bool   `protobuf:"varint,5,opt,name=skip_restart,json=skipRestart,proto3" json:"skip_restart,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserProjectsRequest) Reset() {
-	*x = GetUserProjectsRequest{}
-	mi := &file_src_protos_project_service_proto_msgTypes[5]
+func (x *LinkAddOnRequest) Reset() {
+	*x = LinkAddOnRequest{}
+	mi := &file_src_protos_project_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserProjectsRequest) String() string {
+func (x *LinkAddOnRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserProjectsRequest) ProtoMessage() {}
+func (*LinkAddOnRequest) ProtoMessage() {}
 
-func (x *GetUserProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_project_service_proto_msgTypes[5]
+func (x *LinkAddOnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -313,40 +1254,68 @@ func (x *GetUserProjectsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserProjectsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_project_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use LinkAddOnRequest.ProtoReflect.Descriptor instead.
+func (*LinkAddOnRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetUserProjectsRequest) GetUserId() string {
+func (x *LinkAddOnRequest) GetProjectId() string {
 	if x != nil {
-		return x.UserId
+		return x.ProjectId
 	}
 	return ""
 }
 
-type GetUserProjectsResponse struct {
+func (x *LinkAddOnRequest) GetAddOnId() string {
+	if x != nil {
+		return x.AddOnId
+	}
+	return ""
+}
+
+func (x *LinkAddOnRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *LinkAddOnRequest) GetEnvVarName() string {
+	if x != nil {
+		return x.EnvVarName
+	}
+	return ""
+}
+
+func (x *LinkAddOnRequest) GetSkipRestart() bool {
+	if x != nil {
+		return x.SkipRestart
+	}
+	return false
+}
+
+type LinkAddOnResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	Link          *AddOnLink             `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserProjectsResponse) Reset() {
-	*x = GetUserProjectsResponse{}
-	mi := &file_src_protos_project_service_proto_msgTypes[6]
+func (x *LinkAddOnResponse) Reset() {
+	*x = LinkAddOnResponse{}
+	mi := &file_src_protos_project_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserProjectsResponse) String() string {
+func (x *LinkAddOnResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserProjectsResponse) ProtoMessage() {}
+func (*LinkAddOnResponse) ProtoMessage() {}
 
-func (x *GetUserProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_project_service_proto_msgTypes[6]
+func (x *LinkAddOnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -357,41 +1326,43 @@ func (x *GetUserProjectsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserProjectsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_project_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use LinkAddOnResponse.ProtoReflect.Descriptor instead.
+func (*LinkAddOnResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetUserProjectsResponse) GetProjects() []*Project {
+func (x *LinkAddOnResponse) GetLink() *AddOnLink {
 	if x != nil {
-		return x.Projects
+		return x.Link
 	}
 	return nil
 }
 
-type DeleteUserProjectRequest struct {
+type UnlinkAddOnRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AddOnId       string                 `protobuf:"bytes,2,opt,name=add_on_id,json=addOnId,proto3" json:"add_on_id,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	SkipRestart   bool                   `protobuf:"varint,4,opt,name=skip_restart,json=skipRestart,proto3" json:"skip_restart,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserProjectRequest) Reset() {
-	*x = DeleteUserProjectRequest{}
-	mi := &file_src_protos_project_service_proto_msgTypes[7]
+func (x *UnlinkAddOnRequest) Reset() {
+	*x = UnlinkAddOnRequest{}
+	mi := &file_src_protos_project_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserProjectRequest) String() string {
+func (x *UnlinkAddOnRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserProjectRequest) ProtoMessage() {}
+func (*UnlinkAddOnRequest) ProtoMessage() {}
 
-func (x *DeleteUserProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_project_service_proto_msgTypes[7]
+func (x *UnlinkAddOnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,46 +1373,60 @@ func (x *DeleteUserProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserProjectRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserProjectRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_project_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use UnlinkAddOnRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkAddOnRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *DeleteUserProjectRequest) GetProjectId() string {
+func (x *UnlinkAddOnRequest) GetProjectId() string {
 	if x != nil {
 		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *DeleteUserProjectRequest) GetUserId() string {
+func (x *UnlinkAddOnRequest) GetAddOnId() string {
 	if x != nil {
-		return x.UserId
+		return x.AddOnId
 	}
 	return ""
 }
 
-type DeleteUserProjectResponse struct {
+func (x *UnlinkAddOnRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *UnlinkAddOnRequest) GetSkipRestart() bool {
+	if x != nil {
+		return x.SkipRestart
+	}
+	return false
+}
+
+type UnlinkAddOnResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserProjectResponse) Reset() {
-	*x = DeleteUserProjectResponse{}
-	mi := &file_src_protos_project_service_proto_msgTypes[8]
+func (x *UnlinkAddOnResponse) Reset() {
+	*x = UnlinkAddOnResponse{}
+	mi := &file_src_protos_project_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserProjectResponse) String() string {
+func (x *UnlinkAddOnResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserProjectResponse) ProtoMessage() {}
+func (*UnlinkAddOnResponse) ProtoMessage() {}
 
-func (x *DeleteUserProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_project_service_proto_msgTypes[8]
+func (x *UnlinkAddOnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,34 +1437,33 @@ func (x *DeleteUserProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserProjectResponse.ProtoReflect.Descriptor instead.
-func (*DeleteUserProjectResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_project_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use UnlinkAddOnResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkAddOnResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{26}
 }
 
-type UpdateProjectRequest struct {
+type GetAppAddOnsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProjectRequest) Reset() {
-	*x = UpdateProjectRequest{}
-	mi := &file_src_protos_project_service_proto_msgTypes[9]
+func (x *GetAppAddOnsRequest) Reset() {
+	*x = GetAppAddOnsRequest{}
+	mi := &file_src_protos_project_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProjectRequest) String() string {
+func (x *GetAppAddOnsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProjectRequest) ProtoMessage() {}
+func (*GetAppAddOnsRequest) ProtoMessage() {}
 
-func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_project_service_proto_msgTypes[9]
+func (x *GetAppAddOnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -490,47 +1474,40 @@ func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_project_service_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *UpdateProjectRequest) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
+// Deprecated: Use GetAppAddOnsRequest.ProtoReflect.Descriptor instead.
+func (*GetAppAddOnsRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *UpdateProjectRequest) GetName() string {
+func (x *GetAppAddOnsRequest) GetAppId() string {
 	if x != nil {
-		return x.Name
+		return x.AppId
 	}
 	return ""
 }
 
-type UpdateProjectResponse struct {
+type GetAppAddOnsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Links         []*AddOnLink           `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProjectResponse) Reset() {
-	*x = UpdateProjectResponse{}
-	mi := &file_src_protos_project_service_proto_msgTypes[10]
+func (x *GetAppAddOnsResponse) Reset() {
+	*x = GetAppAddOnsResponse{}
+	mi := &file_src_protos_project_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProjectResponse) String() string {
+func (x *GetAppAddOnsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProjectResponse) ProtoMessage() {}
+func (*GetAppAddOnsResponse) ProtoMessage() {}
 
-func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_project_service_proto_msgTypes[10]
+func (x *GetAppAddOnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_project_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -541,14 +1518,14 @@ func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProjectResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProjectResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_project_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetAppAddOnsResponse.ProtoReflect.Descriptor instead.
+func (*GetAppAddOnsResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *UpdateProjectResponse) GetProject() *Project {
+func (x *GetAppAddOnsResponse) GetLinks() []*AddOnLink {
 	if x != nil {
-		return x.Project
+		return x.Links
 	}
 	return nil
 }
@@ -561,7 +1538,7 @@ type HealthRequest struct {
 
 func (x *HealthRequest) Reset() {
 	*x = HealthRequest{}
-	mi := &file_src_protos_project_service_proto_msgTypes[11]
+	mi := &file_src_protos_project_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -573,7 +1550,7 @@ func (x *HealthRequest) String() string {
 func (*HealthRequest) ProtoMessage() {}
 
 func (x *HealthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_project_service_proto_msgTypes[11]
+	mi := &file_src_protos_project_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +1563,7 @@ func (x *HealthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthRequest.ProtoReflect.Descriptor instead.
 func (*HealthRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_project_service_proto_rawDescGZIP(), []int{11}
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{29}
 }
 
 type HealthResponse struct {
@@ -599,7 +1576,7 @@ type HealthResponse struct {
 
 func (x *HealthResponse) Reset() {
 	*x = HealthResponse{}
-	mi := &file_src_protos_project_service_proto_msgTypes[12]
+	mi := &file_src_protos_project_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -611,7 +1588,7 @@ func (x *HealthResponse) String() string {
 func (*HealthResponse) ProtoMessage() {}
 
 func (x *HealthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_project_service_proto_msgTypes[12]
+	mi := &file_src_protos_project_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,7 +1601,7 @@ func (x *HealthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
 func (*HealthResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_project_service_proto_rawDescGZIP(), []int{12}
+	return file_src_protos_project_service_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *HealthResponse) GetStatus() string {
@@ -651,6 +1628,27 @@ const file_src_protos_project_service_proto_rawDesc = "" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"\xf5\x01\n" +
+	"\x05AddOn\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x12\n" +
+	"\x04host\x18\x06 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\a \x01(\x05R\x04port\x12\x1a\n" +
+	"\bdatabase\x18\b \x01(\tR\bdatabase\x12\x1a\n" +
+	"\busername\x18\t \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\"\x7f\n" +
+	"\tAddOnLink\x12\x1a\n" +
+	"\tadd_on_id\x18\x01 \x01(\tR\aaddOnId\x12\x15\n" +
+	"\x06app_id\x18\x02 \x01(\tR\x05appId\x12 \n" +
+	"\fenv_var_name\x18\x03 \x01(\tR\n" +
+	"envVarName\x12\x1d\n" +
+	"\n" +
 	"created_at\x18\x04 \x01(\tR\tcreatedAt\"C\n" +
 	"\x14CreateProjectRequest\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
@@ -662,6 +1660,11 @@ const file_src_protos_project_service_proto_rawDesc = "" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"P\n" +
 	"\x1aGetUserProjectByIdResponse\x122\n" +
+	"\aproject\x18\x01 \x01(\v2\x18.project_service.ProjectR\aproject\"2\n" +
+	"\x11GetProjectRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"H\n" +
+	"\x12GetProjectResponse\x122\n" +
 	"\aproject\x18\x01 \x01(\v2\x18.project_service.ProjectR\aproject\"1\n" +
 	"\x16GetUserProjectsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"O\n" +
@@ -677,18 +1680,74 @@ const file_src_protos_project_service_proto_rawDesc = "" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"K\n" +
 	"\x15UpdateProjectResponse\x122\n" +
-	"\aproject\x18\x01 \x01(\v2\x18.project_service.ProjectR\aproject\"\x0f\n" +
+	"\aproject\x18\x01 \x01(\v2\x18.project_service.ProjectR\aproject\"[\n" +
+	"\x12CreateAddOnRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\"D\n" +
+	"\x13CreateAddOnResponse\x12-\n" +
+	"\x06add_on\x18\x01 \x01(\v2\x16.project_service.AddOnR\x05addOn\"L\n" +
+	"\x0fGetAddOnRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1a\n" +
+	"\tadd_on_id\x18\x02 \x01(\tR\aaddOnId\"s\n" +
+	"\x10GetAddOnResponse\x12-\n" +
+	"\x06add_on\x18\x01 \x01(\v2\x16.project_service.AddOnR\x05addOn\x120\n" +
+	"\x05links\x18\x02 \x03(\v2\x1a.project_service.AddOnLinkR\x05links\"1\n" +
+	"\x10GetAddOnsRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"D\n" +
+	"\x11GetAddOnsResponse\x12/\n" +
+	"\aadd_ons\x18\x01 \x03(\v2\x16.project_service.AddOnR\x06addOns\"r\n" +
+	"\x12DeleteAddOnRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1a\n" +
+	"\tadd_on_id\x18\x02 \x01(\tR\aaddOnId\x12!\n" +
+	"\fskip_restart\x18\x03 \x01(\bR\vskipRestart\"\x15\n" +
+	"\x13DeleteAddOnResponse\"\xa9\x01\n" +
+	"\x10LinkAddOnRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1a\n" +
+	"\tadd_on_id\x18\x02 \x01(\tR\aaddOnId\x12\x15\n" +
+	"\x06app_id\x18\x03 \x01(\tR\x05appId\x12 \n" +
+	"\fenv_var_name\x18\x04 \x01(\tR\n" +
+	"envVarName\x12!\n" +
+	"\fskip_restart\x18\x05 \x01(\bR\vskipRestart\"C\n" +
+	"\x11LinkAddOnResponse\x12.\n" +
+	"\x04link\x18\x01 \x01(\v2\x1a.project_service.AddOnLinkR\x04link\"\x89\x01\n" +
+	"\x12UnlinkAddOnRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1a\n" +
+	"\tadd_on_id\x18\x02 \x01(\tR\aaddOnId\x12\x15\n" +
+	"\x06app_id\x18\x03 \x01(\tR\x05appId\x12!\n" +
+	"\fskip_restart\x18\x04 \x01(\bR\vskipRestart\"\x15\n" +
+	"\x13UnlinkAddOnResponse\",\n" +
+	"\x13GetAppAddOnsRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\"H\n" +
+	"\x14GetAppAddOnsResponse\x120\n" +
+	"\x05links\x18\x01 \x03(\v2\x1a.project_service.AddOnLinkR\x05links\"\x0f\n" +
 	"\rHealthRequest\"B\n" +
 	"\x0eHealthResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\xdc\x04\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\x97\n" +
+	"\n" +
 	"\x0eProjectService\x12I\n" +
 	"\x06Health\x12\x1e.project_service.HealthRequest\x1a\x1f.project_service.HealthResponse\x12^\n" +
 	"\rCreateProject\x12%.project_service.CreateProjectRequest\x1a&.project_service.CreateProjectResponse\x12^\n" +
 	"\rUpdateProject\x12%.project_service.UpdateProjectRequest\x1a&.project_service.UpdateProjectResponse\x12m\n" +
-	"\x12GetUserProjectById\x12*.project_service.GetUserProjectByIdRequest\x1a+.project_service.GetUserProjectByIdResponse\x12d\n" +
+	"\x12GetUserProjectById\x12*.project_service.GetUserProjectByIdRequest\x1a+.project_service.GetUserProjectByIdResponse\x12U\n" +
+	"\n" +
+	"GetProject\x12\".project_service.GetProjectRequest\x1a#.project_service.GetProjectResponse\x12d\n" +
 	"\x0fGetUserProjects\x12'.project_service.GetUserProjectsRequest\x1a(.project_service.GetUserProjectsResponse\x12j\n" +
-	"\x11DeleteUserProject\x12).project_service.DeleteUserProjectRequest\x1a*.project_service.DeleteUserProjectResponseB-Z+proto/project_service_pb;project_service_pbb\x06proto3"
+	"\x11DeleteUserProject\x12).project_service.DeleteUserProjectRequest\x1a*.project_service.DeleteUserProjectResponse\x12X\n" +
+	"\vCreateAddOn\x12#.project_service.CreateAddOnRequest\x1a$.project_service.CreateAddOnResponse\x12O\n" +
+	"\bGetAddOn\x12 .project_service.GetAddOnRequest\x1a!.project_service.GetAddOnResponse\x12R\n" +
+	"\tGetAddOns\x12!.project_service.GetAddOnsRequest\x1a\".project_service.GetAddOnsResponse\x12X\n" +
+	"\vDeleteAddOn\x12#.project_service.DeleteAddOnRequest\x1a$.project_service.DeleteAddOnResponse\x12R\n" +
+	"\tLinkAddOn\x12!.project_service.LinkAddOnRequest\x1a\".project_service.LinkAddOnResponse\x12X\n" +
+	"\vUnlinkAddOn\x12#.project_service.UnlinkAddOnRequest\x1a$.project_service.UnlinkAddOnResponse\x12[\n" +
+	"\fGetAppAddOns\x12$.project_service.GetAppAddOnsRequest\x1a%.project_service.GetAppAddOnsResponseB-Z+proto/project_service_pb;project_service_pbb\x06proto3"
 
 var (
 	file_src_protos_project_service_proto_rawDescOnce sync.Once
@@ -702,44 +1761,85 @@ func file_src_protos_project_service_proto_rawDescGZIP() []byte {
 	return file_src_protos_project_service_proto_rawDescData
 }
 
-var file_src_protos_project_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_src_protos_project_service_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_src_protos_project_service_proto_goTypes = []any{
 	(*Project)(nil),                    // 0: project_service.Project
-	(*CreateProjectRequest)(nil),       // 1: project_service.CreateProjectRequest
-	(*CreateProjectResponse)(nil),      // 2: project_service.CreateProjectResponse
-	(*GetUserProjectByIdRequest)(nil),  // 3: project_service.GetUserProjectByIdRequest
-	(*GetUserProjectByIdResponse)(nil), // 4: project_service.GetUserProjectByIdResponse
-	(*GetUserProjectsRequest)(nil),     // 5: project_service.GetUserProjectsRequest
-	(*GetUserProjectsResponse)(nil),    // 6: project_service.GetUserProjectsResponse
-	(*DeleteUserProjectRequest)(nil),   // 7: project_service.DeleteUserProjectRequest
-	(*DeleteUserProjectResponse)(nil),  // 8: project_service.DeleteUserProjectResponse
-	(*UpdateProjectRequest)(nil),       // 9: project_service.UpdateProjectRequest
-	(*UpdateProjectResponse)(nil),      // 10: project_service.UpdateProjectResponse
-	(*HealthRequest)(nil),              // 11: project_service.HealthRequest
-	(*HealthResponse)(nil),             // 12: project_service.HealthResponse
+	(*AddOn)(nil),                      // 1: project_service.AddOn
+	(*AddOnLink)(nil),                  // 2: project_service.AddOnLink
+	(*CreateProjectRequest)(nil),       // 3: project_service.CreateProjectRequest
+	(*CreateProjectResponse)(nil),      // 4: project_service.CreateProjectResponse
+	(*GetUserProjectByIdRequest)(nil),  // 5: project_service.GetUserProjectByIdRequest
+	(*GetUserProjectByIdResponse)(nil), // 6: project_service.GetUserProjectByIdResponse
+	(*GetProjectRequest)(nil),          // 7: project_service.GetProjectRequest
+	(*GetProjectResponse)(nil),         // 8: project_service.GetProjectResponse
+	(*GetUserProjectsRequest)(nil),     // 9: project_service.GetUserProjectsRequest
+	(*GetUserProjectsResponse)(nil),    // 10: project_service.GetUserProjectsResponse
+	(*DeleteUserProjectRequest)(nil),   // 11: project_service.DeleteUserProjectRequest
+	(*DeleteUserProjectResponse)(nil),  // 12: project_service.DeleteUserProjectResponse
+	(*UpdateProjectRequest)(nil),       // 13: project_service.UpdateProjectRequest
+	(*UpdateProjectResponse)(nil),      // 14: project_service.UpdateProjectResponse
+	(*CreateAddOnRequest)(nil),         // 15: project_service.CreateAddOnRequest
+	(*CreateAddOnResponse)(nil),        // 16: project_service.CreateAddOnResponse
+	(*GetAddOnRequest)(nil),            // 17: project_service.GetAddOnRequest
+	(*GetAddOnResponse)(nil),           // 18: project_service.GetAddOnResponse
+	(*GetAddOnsRequest)(nil),           // 19: project_service.GetAddOnsRequest
+	(*GetAddOnsResponse)(nil),          // 20: project_service.GetAddOnsResponse
+	(*DeleteAddOnRequest)(nil),         // 21: project_service.DeleteAddOnRequest
+	(*DeleteAddOnResponse)(nil),        // 22: project_service.DeleteAddOnResponse
+	(*LinkAddOnRequest)(nil),           // 23: project_service.LinkAddOnRequest
+	(*LinkAddOnResponse)(nil),          // 24: project_service.LinkAddOnResponse
+	(*UnlinkAddOnRequest)(nil),         // 25: project_service.UnlinkAddOnRequest
+	(*UnlinkAddOnResponse)(nil),        // 26: project_service.UnlinkAddOnResponse
+	(*GetAppAddOnsRequest)(nil),        // 27: project_service.GetAppAddOnsRequest
+	(*GetAppAddOnsResponse)(nil),       // 28: project_service.GetAppAddOnsResponse
+	(*HealthRequest)(nil),              // 29: project_service.HealthRequest
+	(*HealthResponse)(nil),             // 30: project_service.HealthResponse
 }
 var file_src_protos_project_service_proto_depIdxs = []int32{
 	0,  // 0: project_service.CreateProjectResponse.project:type_name -> project_service.Project
 	0,  // 1: project_service.GetUserProjectByIdResponse.project:type_name -> project_service.Project
-	0,  // 2: project_service.GetUserProjectsResponse.projects:type_name -> project_service.Project
-	0,  // 3: project_service.UpdateProjectResponse.project:type_name -> project_service.Project
-	11, // 4: project_service.ProjectService.Health:input_type -> project_service.HealthRequest
-	1,  // 5: project_service.ProjectService.CreateProject:input_type -> project_service.CreateProjectRequest
-	9,  // 6: project_service.ProjectService.UpdateProject:input_type -> project_service.UpdateProjectRequest
-	3,  // 7: project_service.ProjectService.GetUserProjectById:input_type -> project_service.GetUserProjectByIdRequest
-	5,  // 8: project_service.ProjectService.GetUserProjects:input_type -> project_service.GetUserProjectsRequest
-	7,  // 9: project_service.ProjectService.DeleteUserProject:input_type -> project_service.DeleteUserProjectRequest
-	12, // 10: project_service.ProjectService.Health:output_type -> project_service.HealthResponse
-	2,  // 11: project_service.ProjectService.CreateProject:output_type -> project_service.CreateProjectResponse
-	10, // 12: project_service.ProjectService.UpdateProject:output_type -> project_service.UpdateProjectResponse
-	4,  // 13: project_service.ProjectService.GetUserProjectById:output_type -> project_service.GetUserProjectByIdResponse
-	6,  // 14: project_service.ProjectService.GetUserProjects:output_type -> project_service.GetUserProjectsResponse
-	8,  // 15: project_service.ProjectService.DeleteUserProject:output_type -> project_service.DeleteUserProjectResponse
-	10, // [10:16] is the sub-list for method output_type
-	4,  // [4:10] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	0,  // 2: project_service.GetProjectResponse.project:type_name -> project_service.Project
+	0,  // 3: project_service.GetUserProjectsResponse.projects:type_name -> project_service.Project
+	0,  // 4: project_service.UpdateProjectResponse.project:type_name -> project_service.Project
+	1,  // 5: project_service.CreateAddOnResponse.add_on:type_name -> project_service.AddOn
+	1,  // 6: project_service.GetAddOnResponse.add_on:type_name -> project_service.AddOn
+	2,  // 7: project_service.GetAddOnResponse.links:type_name -> project_service.AddOnLink
+	1,  // 8: project_service.GetAddOnsResponse.add_ons:type_name -> project_service.AddOn
+	2,  // 9: project_service.LinkAddOnResponse.link:type_name -> project_service.AddOnLink
+	2,  // 10: project_service.GetAppAddOnsResponse.links:type_name -> project_service.AddOnLink
+	29, // 11: project_service.ProjectService.Health:input_type -> project_service.HealthRequest
+	3,  // 12: project_service.ProjectService.CreateProject:input_type -> project_service.CreateProjectRequest
+	13, // 13: project_service.ProjectService.UpdateProject:input_type -> project_service.UpdateProjectRequest
+	5,  // 14: project_service.ProjectService.GetUserProjectById:input_type -> project_service.GetUserProjectByIdRequest
+	7,  // 15: project_service.ProjectService.GetProject:input_type -> project_service.GetProjectRequest
+	9,  // 16: project_service.ProjectService.GetUserProjects:input_type -> project_service.GetUserProjectsRequest
+	11, // 17: project_service.ProjectService.DeleteUserProject:input_type -> project_service.DeleteUserProjectRequest
+	15, // 18: project_service.ProjectService.CreateAddOn:input_type -> project_service.CreateAddOnRequest
+	17, // 19: project_service.ProjectService.GetAddOn:input_type -> project_service.GetAddOnRequest
+	19, // 20: project_service.ProjectService.GetAddOns:input_type -> project_service.GetAddOnsRequest
+	21, // 21: project_service.ProjectService.DeleteAddOn:input_type -> project_service.DeleteAddOnRequest
+	23, // 22: project_service.ProjectService.LinkAddOn:input_type -> project_service.LinkAddOnRequest
+	25, // 23: project_service.ProjectService.UnlinkAddOn:input_type -> project_service.UnlinkAddOnRequest
+	27, // 24: project_service.ProjectService.GetAppAddOns:input_type -> project_service.GetAppAddOnsRequest
+	30, // 25: project_service.ProjectService.Health:output_type -> project_service.HealthResponse
+	4,  // 26: project_service.ProjectService.CreateProject:output_type -> project_service.CreateProjectResponse
+	14, // 27: project_service.ProjectService.UpdateProject:output_type -> project_service.UpdateProjectResponse
+	6,  // 28: project_service.ProjectService.GetUserProjectById:output_type -> project_service.GetUserProjectByIdResponse
+	8,  // 29: project_service.ProjectService.GetProject:output_type -> project_service.GetProjectResponse
+	10, // 30: project_service.ProjectService.GetUserProjects:output_type -> project_service.GetUserProjectsResponse
+	12, // 31: project_service.ProjectService.DeleteUserProject:output_type -> project_service.DeleteUserProjectResponse
+	16, // 32: project_service.ProjectService.CreateAddOn:output_type -> project_service.CreateAddOnResponse
+	18, // 33: project_service.ProjectService.GetAddOn:output_type -> project_service.GetAddOnResponse
+	20, // 34: project_service.ProjectService.GetAddOns:output_type -> project_service.GetAddOnsResponse
+	22, // 35: project_service.ProjectService.DeleteAddOn:output_type -> project_service.DeleteAddOnResponse
+	24, // 36: project_service.ProjectService.LinkAddOn:output_type -> project_service.LinkAddOnResponse
+	26, // 37: project_service.ProjectService.UnlinkAddOn:output_type -> project_service.UnlinkAddOnResponse
+	28, // 38: project_service.ProjectService.GetAppAddOns:output_type -> project_service.GetAppAddOnsResponse
+	25, // [25:39] is the sub-list for method output_type
+	11, // [11:25] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_src_protos_project_service_proto_init() }
@@ -753,7 +1853,7 @@ func file_src_protos_project_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_src_protos_project_service_proto_rawDesc), len(file_src_protos_project_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
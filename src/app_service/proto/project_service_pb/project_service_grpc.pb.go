@@ -23,8 +23,16 @@ const (
 	ProjectService_CreateProject_FullMethodName      = "/project_service.ProjectService/CreateProject"
 	ProjectService_UpdateProject_FullMethodName      = "/project_service.ProjectService/UpdateProject"
 	ProjectService_GetUserProjectById_FullMethodName = "/project_service.ProjectService/GetUserProjectById"
+	ProjectService_GetProject_FullMethodName         = "/project_service.ProjectService/GetProject"
 	ProjectService_GetUserProjects_FullMethodName    = "/project_service.ProjectService/GetUserProjects"
 	ProjectService_DeleteUserProject_FullMethodName  = "/project_service.ProjectService/DeleteUserProject"
+	ProjectService_CreateAddOn_FullMethodName        = "/project_service.ProjectService/CreateAddOn"
+	ProjectService_GetAddOn_FullMethodName           = "/project_service.ProjectService/GetAddOn"
+	ProjectService_GetAddOns_FullMethodName          = "/project_service.ProjectService/GetAddOns"
+	ProjectService_DeleteAddOn_FullMethodName        = "/project_service.ProjectService/DeleteAddOn"
+	ProjectService_LinkAddOn_FullMethodName          = "/project_service.ProjectService/LinkAddOn"
+	ProjectService_UnlinkAddOn_FullMethodName        = "/project_service.ProjectService/UnlinkAddOn"
+	ProjectService_GetAppAddOns_FullMethodName       = "/project_service.ProjectService/GetAppAddOns"
 )
 
 // ProjectServiceClient is the client API for ProjectService service.
@@ -35,8 +43,16 @@ type ProjectServiceClient interface {
 	CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error)
 	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
 	GetUserProjectById(ctx context.Context, in *GetUserProjectByIdRequest, opts ...grpc.CallOption) (*GetUserProjectByIdResponse, error)
+	GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error)
 	GetUserProjects(ctx context.Context, in *GetUserProjectsRequest, opts ...grpc.CallOption) (*GetUserProjectsResponse, error)
 	DeleteUserProject(ctx context.Context, in *DeleteUserProjectRequest, opts ...grpc.CallOption) (*DeleteUserProjectResponse, error)
+	CreateAddOn(ctx context.Context, in *CreateAddOnRequest, opts ...grpc.CallOption) (*CreateAddOnResponse, error)
+	GetAddOn(ctx context.Context, in *GetAddOnRequest, opts ...grpc.CallOption) (*GetAddOnResponse, error)
+	GetAddOns(ctx context.Context, in *GetAddOnsRequest, opts ...grpc.CallOption) (*GetAddOnsResponse, error)
+	DeleteAddOn(ctx context.Context, in *DeleteAddOnRequest, opts ...grpc.CallOption) (*DeleteAddOnResponse, error)
+	LinkAddOn(ctx context.Context, in *LinkAddOnRequest, opts ...grpc.CallOption) (*LinkAddOnResponse, error)
+	UnlinkAddOn(ctx context.Context, in *UnlinkAddOnRequest, opts ...grpc.CallOption) (*UnlinkAddOnResponse, error)
+	GetAppAddOns(ctx context.Context, in *GetAppAddOnsRequest, opts ...grpc.CallOption) (*GetAppAddOnsResponse, error)
 }
 
 type projectServiceClient struct {
@@ -87,6 +103,16 @@ func (c *projectServiceClient) GetUserProjectById(ctx context.Context, in *GetUs
 	return out, nil
 }
 
+func (c *projectServiceClient) GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProjectResponse)
+	err := c.cc.Invoke(ctx, ProjectService_GetProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) GetUserProjects(ctx context.Context, in *GetUserProjectsRequest, opts ...grpc.CallOption) (*GetUserProjectsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserProjectsResponse)
@@ -107,6 +133,76 @@ func (c *projectServiceClient) DeleteUserProject(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *projectServiceClient) CreateAddOn(ctx context.Context, in *CreateAddOnRequest, opts ...grpc.CallOption) (*CreateAddOnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAddOnResponse)
+	err := c.cc.Invoke(ctx, ProjectService_CreateAddOn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) GetAddOn(ctx context.Context, in *GetAddOnRequest, opts ...grpc.CallOption) (*GetAddOnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAddOnResponse)
+	err := c.cc.Invoke(ctx, ProjectService_GetAddOn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) GetAddOns(ctx context.Context, in *GetAddOnsRequest, opts ...grpc.CallOption) (*GetAddOnsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAddOnsResponse)
+	err := c.cc.Invoke(ctx, ProjectService_GetAddOns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) DeleteAddOn(ctx context.Context, in *DeleteAddOnRequest, opts ...grpc.CallOption) (*DeleteAddOnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAddOnResponse)
+	err := c.cc.Invoke(ctx, ProjectService_DeleteAddOn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) LinkAddOn(ctx context.Context, in *LinkAddOnRequest, opts ...grpc.CallOption) (*LinkAddOnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkAddOnResponse)
+	err := c.cc.Invoke(ctx, ProjectService_LinkAddOn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) UnlinkAddOn(ctx context.Context, in *UnlinkAddOnRequest, opts ...grpc.CallOption) (*UnlinkAddOnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkAddOnResponse)
+	err := c.cc.Invoke(ctx, ProjectService_UnlinkAddOn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) GetAppAddOns(ctx context.Context, in *GetAppAddOnsRequest, opts ...grpc.CallOption) (*GetAppAddOnsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAppAddOnsResponse)
+	err := c.cc.Invoke(ctx, ProjectService_GetAppAddOns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProjectServiceServer is the server API for ProjectService service.
 // All implementations must embed UnimplementedProjectServiceServer
 // for forward compatibility.
@@ -115,8 +211,16 @@ type ProjectServiceServer interface {
 	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
 	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
 	GetUserProjectById(context.Context, *GetUserProjectByIdRequest) (*GetUserProjectByIdResponse, error)
+	GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error)
 	GetUserProjects(context.Context, *GetUserProjectsRequest) (*GetUserProjectsResponse, error)
 	DeleteUserProject(context.Context, *DeleteUserProjectRequest) (*DeleteUserProjectResponse, error)
+	CreateAddOn(context.Context, *CreateAddOnRequest) (*CreateAddOnResponse, error)
+	GetAddOn(context.Context, *GetAddOnRequest) (*GetAddOnResponse, error)
+	GetAddOns(context.Context, *GetAddOnsRequest) (*GetAddOnsResponse, error)
+	DeleteAddOn(context.Context, *DeleteAddOnRequest) (*DeleteAddOnResponse, error)
+	LinkAddOn(context.Context, *LinkAddOnRequest) (*LinkAddOnResponse, error)
+	UnlinkAddOn(context.Context, *UnlinkAddOnRequest) (*UnlinkAddOnResponse, error)
+	GetAppAddOns(context.Context, *GetAppAddOnsRequest) (*GetAppAddOnsResponse, error)
 	mustEmbedUnimplementedProjectServiceServer()
 }
 
@@ -139,12 +243,36 @@ func (UnimplementedProjectServiceServer) UpdateProject(context.Context, *UpdateP
 func (UnimplementedProjectServiceServer) GetUserProjectById(context.Context, *GetUserProjectByIdRequest) (*GetUserProjectByIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserProjectById not implemented")
 }
+func (UnimplementedProjectServiceServer) GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProject not implemented")
+}
 func (UnimplementedProjectServiceServer) GetUserProjects(context.Context, *GetUserProjectsRequest) (*GetUserProjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserProjects not implemented")
 }
 func (UnimplementedProjectServiceServer) DeleteUserProject(context.Context, *DeleteUserProjectRequest) (*DeleteUserProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserProject not implemented")
 }
+func (UnimplementedProjectServiceServer) CreateAddOn(context.Context, *CreateAddOnRequest) (*CreateAddOnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAddOn not implemented")
+}
+func (UnimplementedProjectServiceServer) GetAddOn(context.Context, *GetAddOnRequest) (*GetAddOnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddOn not implemented")
+}
+func (UnimplementedProjectServiceServer) GetAddOns(context.Context, *GetAddOnsRequest) (*GetAddOnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddOns not implemented")
+}
+func (UnimplementedProjectServiceServer) DeleteAddOn(context.Context, *DeleteAddOnRequest) (*DeleteAddOnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAddOn not implemented")
+}
+func (UnimplementedProjectServiceServer) LinkAddOn(context.Context, *LinkAddOnRequest) (*LinkAddOnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkAddOn not implemented")
+}
+func (UnimplementedProjectServiceServer) UnlinkAddOn(context.Context, *UnlinkAddOnRequest) (*UnlinkAddOnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkAddOn not implemented")
+}
+func (UnimplementedProjectServiceServer) GetAppAddOns(context.Context, *GetAppAddOnsRequest) (*GetAppAddOnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppAddOns not implemented")
+}
 func (UnimplementedProjectServiceServer) mustEmbedUnimplementedProjectServiceServer() {}
 func (UnimplementedProjectServiceServer) testEmbeddedByValue()                        {}
 
@@ -238,6 +366,24 @@ func _ProjectService_GetUserProjectById_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_GetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_GetProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetProject(ctx, req.(*GetProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_GetUserProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserProjectsRequest)
 	if err := dec(in); err != nil {
@@ -274,6 +420,132 @@ func _ProjectService_DeleteUserProject_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_CreateAddOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAddOnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).CreateAddOn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_CreateAddOn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).CreateAddOn(ctx, req.(*CreateAddOnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetAddOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddOnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetAddOn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_GetAddOn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetAddOn(ctx, req.(*GetAddOnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetAddOns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddOnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetAddOns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_GetAddOns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetAddOns(ctx, req.(*GetAddOnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_DeleteAddOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAddOnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).DeleteAddOn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_DeleteAddOn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).DeleteAddOn(ctx, req.(*DeleteAddOnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_LinkAddOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkAddOnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).LinkAddOn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_LinkAddOn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).LinkAddOn(ctx, req.(*LinkAddOnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_UnlinkAddOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkAddOnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).UnlinkAddOn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_UnlinkAddOn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).UnlinkAddOn(ctx, req.(*UnlinkAddOnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetAppAddOns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppAddOnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetAppAddOns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_GetAppAddOns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetAppAddOns(ctx, req.(*GetAppAddOnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProjectService_ServiceDesc is the grpc.ServiceDesc for ProjectService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -297,6 +569,10 @@ var ProjectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserProjectById",
 			Handler:    _ProjectService_GetUserProjectById_Handler,
 		},
+		{
+			MethodName: "GetProject",
+			Handler:    _ProjectService_GetProject_Handler,
+		},
 		{
 			MethodName: "GetUserProjects",
 			Handler:    _ProjectService_GetUserProjects_Handler,
@@ -305,6 +581,34 @@ var ProjectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUserProject",
 			Handler:    _ProjectService_DeleteUserProject_Handler,
 		},
+		{
+			MethodName: "CreateAddOn",
+			Handler:    _ProjectService_CreateAddOn_Handler,
+		},
+		{
+			MethodName: "GetAddOn",
+			Handler:    _ProjectService_GetAddOn_Handler,
+		},
+		{
+			MethodName: "GetAddOns",
+			Handler:    _ProjectService_GetAddOns_Handler,
+		},
+		{
+			MethodName: "DeleteAddOn",
+			Handler:    _ProjectService_DeleteAddOn_Handler,
+		},
+		{
+			MethodName: "LinkAddOn",
+			Handler:    _ProjectService_LinkAddOn_Handler,
+		},
+		{
+			MethodName: "UnlinkAddOn",
+			Handler:    _ProjectService_UnlinkAddOn_Handler,
+		},
+		{
+			MethodName: "GetAppAddOns",
+			Handler:    _ProjectService_GetAppAddOns_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "src/protos/project_service.proto",
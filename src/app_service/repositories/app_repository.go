@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"slices"
 	"time"
 
 	"apps-hosting.com/logging"
@@ -22,6 +23,56 @@ const (
 	AppStatusDeployFailed AppStatus = "deploy_failed"
 )
 
+// AppStatusTransitions lists the statuses an app may move to from each status. A new build may
+// start from any status, the other events are only applied in order, so that the late events of
+// an earlier build do not move the app back. The apps created before the status was tracked have
+// no status, they take the first one they get.
+var AppStatusTransitions = map[AppStatus][]AppStatus{
+	"":                    {AppStatusBuilding, AppStatusDeploying, AppStatusDeployed, AppStatusBuildFailed, AppStatusDeployFailed},
+	AppStatusBuilding:     {AppStatusBuilding, AppStatusDeploying, AppStatusBuildFailed},
+	AppStatusDeploying:    {AppStatusBuilding, AppStatusDeployed, AppStatusDeployFailed},
+	AppStatusDeployed:     {AppStatusBuilding, AppStatusDeployed, AppStatusDeployFailed},
+	AppStatusBuildFailed:  {AppStatusBuilding},
+	AppStatusDeployFailed: {AppStatusBuilding, AppStatusDeployed, AppStatusDeployFailed},
+}
+
+// appStatusSources returns the statuses an app may move to status from.
+func appStatusSources(status AppStatus) []AppStatus {
+	sources := []AppStatus{}
+	for source, targets := range AppStatusTransitions {
+		if slices.Contains(targets, status) {
+			sources = append(sources, source)
+		}
+	}
+
+	return sources
+}
+
+type AppType string
+
+const (
+	AppTypeWebService AppType = "web_service"
+	AppTypeWorker     AppType = "worker"
+	AppTypeCronJob    AppType = "cron_job"
+	AppTypeJob        AppType = "job"
+	AppTypeStaticSite AppType = "static_site"
+)
+
+var AppTypes = []AppType{AppTypeWebService, AppTypeWorker, AppTypeCronJob, AppTypeJob, AppTypeStaticSite}
+
+type DeploymentStrategy string
+
+const (
+	DeploymentStrategyRolling   DeploymentStrategy = "rolling"
+	DeploymentStrategyBlueGreen DeploymentStrategy = "blue_green"
+	DeploymentStrategyCanary    DeploymentStrategy = "canary"
+)
+
+var DeploymentStrategies = []DeploymentStrategy{DeploymentStrategyRolling, DeploymentStrategyBlueGreen, DeploymentStrategyCanary}
+
+// DefaultPublishDir is where static sites are expected to be built when no directory is set.
+const DefaultPublishDir = "dist"
+
 type App struct {
 	Id         string    `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
 	ProjectId  string    `bun:"project_id" json:"project_id"`
@@ -31,7 +82,44 @@ type App struct {
 	RepoURL    string    `bun:"repo_url" json:"repo_url"`
 	BuildCMD   string    `bun:"build_cmd" json:"build_cmd"`
 	StartCMD   string    `bun:"start_cmd" json:"start_cmd"`
+	Type       AppType   `bun:"type,notnull,default:'web_service'" json:"type"`
+	Schedule   string    `bun:"schedule,notnull,default:''" json:"schedule"`
+	PublishDir string    `bun:"publish_dir,notnull,default:''" json:"publish_dir"`
 	CreatedAt  time.Time `bun:"created_at,default:now()" json:"created_at"`
+
+	PreviewsEnabled bool `bun:"previews_enabled,notnull,default:false" json:"previews_enabled"`
+	// A pull request preview is a copy of its parent app built from the head of the pull request.
+	ParentAppId       string `bun:"parent_app_id,notnull,default:''" json:"parent_app_id"`
+	PullRequestNumber int32  `bun:"pull_request_number,notnull,default:0" json:"pull_request_number"`
+	GitRef            string `bun:"git_ref,notnull,default:''" json:"git_ref"`
+
+	Suspended bool `bun:"suspended,notnull,default:false" json:"suspended"`
+	// ScaleToZeroIdleMinutes is how long the app runs without requests before it is stopped, 0 disables it.
+	ScaleToZeroIdleMinutes int32 `bun:"scale_to_zero_idle_minutes,notnull,default:0" json:"scale_to_zero_idle_minutes"`
+
+	DeploymentStrategy DeploymentStrategy `bun:"deployment_strategy,notnull,default:'rolling'" json:"deployment_strategy"`
+	// The rolling update settings are a number of instances or a percentage, empty uses the Kubernetes defaults.
+	RollingMaxSurge       string `bun:"rolling_max_surge,notnull,default:''" json:"rolling_max_surge"`
+	RollingMaxUnavailable string `bun:"rolling_max_unavailable,notnull,default:''" json:"rolling_max_unavailable"`
+	// CanaryWeight is the percentage of the requests sent to a canary deployment.
+	CanaryWeight int32 `bun:"canary_weight,notnull,default:0" json:"canary_weight"`
+
+	// PreDeployCMD runs with the new image before it takes traffic, PostDeployCMD once it does.
+	PreDeployCMD  string `bun:"pre_deploy_cmd,notnull,default:''" json:"pre_deploy_cmd"`
+	PostDeployCMD string `bun:"post_deploy_cmd,notnull,default:''" json:"post_deploy_cmd"`
+
+	// Status follows the build and deploy events of the app, LastBuildId is the latest build and
+	// LastDeployedBuildId the one the app runs.
+	Status                 AppStatus `bun:"status,notnull,default:''" json:"status"`
+	StatusUpdatedAt        time.Time `bun:"status_updated_at,default:now()" json:"status_updated_at"`
+	LastBuildId            string    `bun:"last_build_id,notnull,default:''" json:"last_build_id"`
+	LastCommitHash         string    `bun:"last_commit_hash,notnull,default:''" json:"last_commit_hash"`
+	LastDeployedBuildId    string    `bun:"last_deployed_build_id,notnull,default:''" json:"last_deployed_build_id"`
+	LastDeployedCommitHash string    `bun:"last_deployed_commit_hash,notnull,default:''" json:"last_deployed_commit_hash"`
+}
+
+func (app *App) IsPreview() bool {
+	return len(app.ParentAppId) != 0
 }
 
 type CreateAppParams struct {
@@ -41,18 +129,47 @@ type CreateAppParams struct {
 	StartCMD   string
 	BuildCMD   string
 	DomainName string
+	Type       AppType
+	Schedule   string
+	PublishDir string
+
+	PreviewsEnabled   bool
+	ParentAppId       string
+	PullRequestNumber int32
+	GitRef            string
+
+	ScaleToZeroIdleMinutes int32
+
+	DeploymentStrategy    DeploymentStrategy
+	RollingMaxSurge       string
+	RollingMaxUnavailable string
+	CanaryWeight          int32
+
+	PreDeployCMD  string
+	PostDeployCMD string
 }
 
 type UpdateAppParams struct {
-	Name     string
-	StartCMD string
-	BuildCMD string
+	Name                   string
+	StartCMD               string
+	BuildCMD               string
+	Schedule               string
+	PreviewsEnabled        bool
+	ScaleToZeroIdleMinutes int32
+
+	DeploymentStrategy    DeploymentStrategy
+	RollingMaxSurge       string
+	RollingMaxUnavailable string
+	CanaryWeight          int32
+
+	PreDeployCMD  string
+	PostDeployCMD string
 }
 
 var Runtimes = []string{"NodeJS"}
 
 type AppRepository struct {
-	Database *bun.DB
+	Database bun.IDB
 	Logger   logging.ServiceLogger
 }
 
@@ -63,9 +180,50 @@ func NewAppRepository(database *bun.DB, logger logging.ServiceLogger) AppReposit
 	}
 }
 
+// WithTx returns the repository running its queries in tx, e.g. to save an event in the outbox
+// with the changes it is about.
+func (repository *AppRepository) WithTx(tx bun.Tx) AppRepository {
+	txRepository := *repository
+	txRepository.Database = tx
+	return txRepository
+}
+
 func (repository *AppRepository) CreateAppsTable() (sql.Result, error) {
 	repository.Logger.LogInfo("Creating apps table.")
-	return repository.Database.NewCreateTable().Model((*App)(nil)).IfNotExists().Exec(context.Background())
+	result, err := repository.Database.NewCreateTable().Model((*App)(nil)).IfNotExists().Exec(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	// Columns added after the table was first released.
+	err = addColumnsIfNotExists(repository.Database, (*App)(nil),
+		"type VARCHAR NOT NULL DEFAULT 'web_service'",
+		"schedule VARCHAR NOT NULL DEFAULT ''",
+		"publish_dir VARCHAR NOT NULL DEFAULT ''",
+		"previews_enabled BOOLEAN NOT NULL DEFAULT false",
+		"parent_app_id VARCHAR NOT NULL DEFAULT ''",
+		"pull_request_number INTEGER NOT NULL DEFAULT 0",
+		"git_ref VARCHAR NOT NULL DEFAULT ''",
+		"suspended BOOLEAN NOT NULL DEFAULT false",
+		"scale_to_zero_idle_minutes INTEGER NOT NULL DEFAULT 0",
+		"deployment_strategy VARCHAR NOT NULL DEFAULT 'rolling'",
+		"rolling_max_surge VARCHAR NOT NULL DEFAULT ''",
+		"rolling_max_unavailable VARCHAR NOT NULL DEFAULT ''",
+		"canary_weight INTEGER NOT NULL DEFAULT 0",
+		"pre_deploy_cmd VARCHAR NOT NULL DEFAULT ''",
+		"post_deploy_cmd VARCHAR NOT NULL DEFAULT ''",
+		"status VARCHAR NOT NULL DEFAULT ''",
+		"status_updated_at TIMESTAMPTZ DEFAULT now()",
+		"last_build_id VARCHAR NOT NULL DEFAULT ''",
+		"last_commit_hash VARCHAR NOT NULL DEFAULT ''",
+		"last_deployed_build_id VARCHAR NOT NULL DEFAULT ''",
+		"last_deployed_commit_hash VARCHAR NOT NULL DEFAULT ''",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
 
 func (repository *AppRepository) CreateApp(ctx context.Context, projectId string, createAppParams CreateAppParams) (*App, error) {
@@ -77,6 +235,27 @@ func (repository *AppRepository) CreateApp(ctx context.Context, projectId string
 		StartCMD:   createAppParams.StartCMD,
 		BuildCMD:   createAppParams.BuildCMD,
 		DomainName: createAppParams.DomainName,
+		Type:       createAppParams.Type,
+		Schedule:   createAppParams.Schedule,
+		PublishDir: createAppParams.PublishDir,
+
+		PreviewsEnabled:   createAppParams.PreviewsEnabled,
+		ParentAppId:       createAppParams.ParentAppId,
+		PullRequestNumber: createAppParams.PullRequestNumber,
+		GitRef:            createAppParams.GitRef,
+
+		ScaleToZeroIdleMinutes: createAppParams.ScaleToZeroIdleMinutes,
+
+		DeploymentStrategy:    createAppParams.DeploymentStrategy,
+		RollingMaxSurge:       createAppParams.RollingMaxSurge,
+		RollingMaxUnavailable: createAppParams.RollingMaxUnavailable,
+		CanaryWeight:          createAppParams.CanaryWeight,
+
+		PreDeployCMD:  createAppParams.PreDeployCMD,
+		PostDeployCMD: createAppParams.PostDeployCMD,
+
+		// the app.created event builds it right away
+		Status: AppStatusBuilding,
 	}
 	_, err := repository.Database.NewInsert().Model(&app).Exec(ctx)
 	if err != nil {
@@ -103,12 +282,26 @@ func (repository *AppRepository) UpdateApp(ctx context.Context, projectId, appId
 		Name:     updateAppParams.Name,
 		StartCMD: updateAppParams.StartCMD,
 		BuildCMD: updateAppParams.BuildCMD,
+		Schedule: updateAppParams.Schedule,
+
+		PreviewsEnabled:        updateAppParams.PreviewsEnabled,
+		ScaleToZeroIdleMinutes: updateAppParams.ScaleToZeroIdleMinutes,
+
+		DeploymentStrategy:    updateAppParams.DeploymentStrategy,
+		RollingMaxSurge:       updateAppParams.RollingMaxSurge,
+		RollingMaxUnavailable: updateAppParams.RollingMaxUnavailable,
+		CanaryWeight:          updateAppParams.CanaryWeight,
+
+		PreDeployCMD:  updateAppParams.PreDeployCMD,
+		PostDeployCMD: updateAppParams.PostDeployCMD,
 	}
 
 	result, err := repository.Database.
 		NewUpdate().
 		Model(&app).
-		Column("name", "build_cmd", "start_cmd").
+		Column("name", "build_cmd", "start_cmd", "schedule", "previews_enabled", "scale_to_zero_idle_minutes",
+			"deployment_strategy", "rolling_max_surge", "rolling_max_unavailable", "canary_weight",
+			"pre_deploy_cmd", "post_deploy_cmd").
 		Where("id = ? and project_id = ?", appId, projectId).
 		Returning("*").
 		Exec(ctx)
@@ -138,6 +331,82 @@ func (repository *AppRepository) UpdateApp(ctx context.Context, projectId, appId
 	return &app, nil
 }
 
+func (repository *AppRepository) SetAppSuspended(ctx context.Context, projectId, appId string, suspended bool) (*App, error) {
+	app := App{Suspended: suspended}
+
+	result, err := repository.Database.
+		NewUpdate().
+		Model(&app).
+		Column("suspended").
+		Where("id = ? and project_id = ?", appId, projectId).
+		Returning("*").
+		Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return nil, ErrAppNotFound
+	}
+
+	return &app, nil
+}
+
+type UpdateAppStatusParams struct {
+	BuildId    string
+	CommitHash string
+}
+
+// UpdateAppStatus moves the app to status, or returns ErrInvalidAppStatusTransition when
+// AppStatusTransitions does not allow it from its current status.
+func (repository *AppRepository) UpdateAppStatus(ctx context.Context, appId string, status AppStatus, updateAppStatusParams UpdateAppStatusParams) (*App, error) {
+	app := App{}
+
+	query := repository.Database.
+		NewUpdate().
+		Model(&app).
+		Set("status = ?", status).
+		Set("status_updated_at = now()").
+		Where("id = ?", appId).
+		Where("status IN (?)", bun.In(appStatusSources(status))).
+		Returning("*")
+
+	switch status {
+	case AppStatusBuilding:
+		query.Set("last_build_id = ''").Set("last_commit_hash = ''")
+	case AppStatusDeploying, AppStatusBuildFailed:
+		query.Set("last_build_id = ?", updateAppStatusParams.BuildId).Set("last_commit_hash = ?", updateAppStatusParams.CommitHash)
+	case AppStatusDeployed:
+		// the commit is known for the latest build, a deployment of the running build keeps it
+		query.
+			Set("last_deployed_commit_hash = CASE WHEN last_build_id = ? THEN last_commit_hash WHEN last_deployed_build_id = ? THEN last_deployed_commit_hash ELSE '' END",
+				updateAppStatusParams.BuildId, updateAppStatusParams.BuildId).
+			Set("last_deployed_build_id = ?", updateAppStatusParams.BuildId)
+	}
+
+	result, err := query.Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		exists, err := repository.Database.NewSelect().Model((*App)(nil)).Where("id = ?", appId).Exists(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, ErrAppNotFound
+		}
+
+		return nil, ErrInvalidAppStatusTransition
+	}
+
+	return &app, nil
+}
+
 func (repository *AppRepository) GetAppById(ctx context.Context, projectId, appId string) (*App, error) {
 	app := App{}
 	err := repository.Database.
@@ -156,6 +425,25 @@ func (repository *AppRepository) GetAppById(ctx context.Context, projectId, appI
 	return &app, nil
 }
 
+// GetAppByIdInternal looks the app up without the project scope, for internal callers only.
+func (repository *AppRepository) GetAppByIdInternal(ctx context.Context, appId string) (*App, error) {
+	app := App{}
+	err := repository.Database.
+		NewSelect().
+		Model(&app).
+		Where("id = ?", appId).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAppNotFound
+		}
+		return nil, err
+	}
+
+	return &app, nil
+}
+
 func (repository *AppRepository) GetApps(ctx context.Context, projectId string) ([]App, error) {
 	var apps []App
 	err := repository.Database.
@@ -176,6 +464,66 @@ func (repository *AppRepository) GetApps(ctx context.Context, projectId string)
 	return apps, nil
 }
 
+// GetPreviewSourceApps returns the apps with previews enabled that are built from one of the clone urls.
+func (repository *AppRepository) GetPreviewSourceApps(ctx context.Context, cloneURLs []string) ([]App, error) {
+	var apps []App
+	err := repository.Database.
+		NewSelect().
+		Model(&apps).
+		Join("JOIN git_repositories AS git_repository ON git_repository.app_id = app.id").
+		Where("app.previews_enabled AND app.parent_app_id = ''").
+		Where("git_repository.clone_url IN (?)", bun.In(cloneURLs)).
+		Scan(ctx)
+
+	if err != nil {
+		return []App{}, err
+	}
+
+	if apps == nil {
+		return []App{}, err
+	}
+
+	return apps, nil
+}
+
+func (repository *AppRepository) GetPreviews(ctx context.Context, parentAppId string) ([]App, error) {
+	var apps []App
+	err := repository.Database.
+		NewSelect().
+		Model(&apps).
+		Where("parent_app_id = ?", parentAppId).
+		Order("pull_request_number DESC").
+		Scan(ctx)
+
+	if err != nil {
+		return []App{}, err
+	}
+
+	if apps == nil {
+		return []App{}, err
+	}
+
+	return apps, nil
+}
+
+func (repository *AppRepository) GetPreview(ctx context.Context, parentAppId string, pullRequestNumber int32) (*App, error) {
+	app := App{}
+	err := repository.Database.
+		NewSelect().
+		Model(&app).
+		Where("parent_app_id = ? AND pull_request_number = ?", parentAppId, pullRequestNumber).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAppNotFound
+		}
+		return nil, err
+	}
+
+	return &app, nil
+}
+
 func (repository *AppRepository) DeleteAppById(ctx context.Context, projectId string, appId string) error {
 	result, err := repository.Database.
 		NewDelete().
@@ -200,6 +548,27 @@ func (repository *AppRepository) DeleteAppsByProjectId(ctx context.Context, proj
 	return err
 }
 
+// GetExistingAppIds returns the ids among appIds that belong to an app.
+func (repository *AppRepository) GetExistingAppIds(ctx context.Context, appIds []string) ([]string, error) {
+	if len(appIds) == 0 {
+		return []string{}, nil
+	}
+
+	var existingAppIds []string
+	err := repository.Database.
+		NewSelect().
+		Model((*App)(nil)).
+		Column("id").
+		Where("id IN (?)", bun.In(appIds)).
+		Scan(ctx, &existingAppIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return existingAppIds, nil
+}
+
 func (repository *AppRepository) GetProjectsAppsCounts(
 	ctx context.Context,
 	projectIds []string,
@@ -0,0 +1,121 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"apps-hosting.com/logging"
+
+	"github.com/uptrace/bun"
+)
+
+// Disk is the persistent volume of an app, an app has at most one.
+type Disk struct {
+	bun.BaseModel `bun:"table:disks,alias:disk"`
+
+	Id        string    `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	AppId     string    `bun:"app_id,type:uuid,notnull,unique" json:"app_id"`
+	MountPath string    `bun:"mount_path,notnull" json:"mount_path"`
+	SizeGB    int32     `bun:"size_gb,notnull" json:"size_gb"`
+	CreatedAt time.Time `bun:"created_at,default:now()" json:"created_at"`
+	UpdatedAt time.Time `bun:"updated_at,default:now()" json:"updated_at"`
+}
+
+type SetDiskParams struct {
+	MountPath string
+	SizeGB    int32
+}
+
+type DiskRepository struct {
+	Database bun.IDB
+	Logger   logging.ServiceLogger
+}
+
+func NewDiskRepository(database *bun.DB, logger logging.ServiceLogger) DiskRepository {
+	return DiskRepository{
+		Database: database,
+		Logger:   logger,
+	}
+}
+
+func (repository *DiskRepository) WithTx(tx bun.Tx) DiskRepository {
+	txRepository := *repository
+	txRepository.Database = tx
+	return txRepository
+}
+
+func (repository *DiskRepository) CreateDisksTable() (sql.Result, error) {
+	repository.Logger.LogInfo("Creating disks table.")
+	return repository.Database.NewCreateTable().Model((*Disk)(nil)).IfNotExists().Exec(context.Background())
+}
+
+func (repository *DiskRepository) GetDiskByAppId(ctx context.Context, appId string) (*Disk, error) {
+	disk := Disk{}
+	err := repository.Database.
+		NewSelect().
+		Model(&disk).
+		Where("app_id = ?", appId).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrDiskNotFound
+		}
+		return nil, err
+	}
+
+	return &disk, nil
+}
+
+// SetDisk attaches a disk to the app or updates the one already attached.
+func (repository *DiskRepository) SetDisk(ctx context.Context, appId string, setDiskParams SetDiskParams) (*Disk, error) {
+	disk := Disk{
+		AppId:     appId,
+		MountPath: setDiskParams.MountPath,
+		SizeGB:    setDiskParams.SizeGB,
+	}
+
+	_, err := repository.Database.
+		NewInsert().
+		Model(&disk).
+		On("CONFLICT (app_id) DO UPDATE").
+		Set("mount_path = EXCLUDED.mount_path").
+		Set("size_gb = EXCLUDED.size_gb").
+		Set("updated_at = now()").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &disk, nil
+}
+
+func (repository *DiskRepository) DeleteDisk(ctx context.Context, appId string) error {
+	result, err := repository.Database.
+		NewDelete().
+		Model((*Disk)(nil)).
+		Where("app_id = ?", appId).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return ErrDiskNotFound
+	}
+
+	return nil
+}
+
+func (repository *DiskRepository) DeleteDisksByAppIds(ctx context.Context, appIds []string) error {
+	_, err := repository.Database.
+		NewDelete().
+		Model((*Disk)(nil)).
+		Where("app_id IN (?)", bun.In(appIds)).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,390 @@
+package repositories
+
+import (
+	"app/encryption"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"apps-hosting.com/logging"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/uptrace/bun"
+)
+
+type EnvironmentGroup struct {
+	bun.BaseModel `bun:"table:environment_groups,alias:environment_group"`
+
+	Id        string    `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	ProjectId string    `bun:"project_id,type:uuid,notnull,unique:environment_groups_project_id_name_key" json:"project_id"`
+	Name      string    `bun:"name,notnull,unique:environment_groups_project_id_name_key" json:"name"`
+	CreatedAt time.Time `bun:"created_at,default:now()" json:"created_at"`
+}
+
+type EnvironmentGroupVariable struct {
+	bun.BaseModel `bun:"table:environment_group_variables"`
+
+	Id         string    `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	GroupId    string    `bun:"group_id,type:uuid,notnull,unique:environment_group_variables_group_id_key_key" json:"group_id"`
+	Key        string    `bun:"key,notnull,unique:environment_group_variables_group_id_key_key" json:"key"`
+	Ciphertext []byte    `bun:"ciphertext,type:bytea,notnull" json:"-"`
+	WrappedKey []byte    `bun:"wrapped_key,type:bytea,notnull" json:"-"`
+	KeyId      string    `bun:"key_id,notnull" json:"-"`
+	IsSecret   bool      `bun:"is_secret,notnull,default:false" json:"is_secret"`
+	CreatedAt  time.Time `bun:"created_at,default:now()" json:"created_at"`
+	UpdatedAt  time.Time `bun:"updated_at,default:now()" json:"updated_at"`
+
+	// Value holds the decrypted value, it is never persisted.
+	Value string `bun:"-" json:"value"`
+}
+
+// AppEnvironmentGroup links an app to a group. Links created later take precedence
+// over older ones when two groups define the same key.
+type AppEnvironmentGroup struct {
+	bun.BaseModel `bun:"table:app_environment_groups"`
+
+	AppId     string    `bun:"app_id,pk,type:uuid" json:"app_id"`
+	GroupId   string    `bun:"group_id,pk,type:uuid" json:"group_id"`
+	CreatedAt time.Time `bun:"created_at,default:now()" json:"created_at"`
+}
+
+type EnvironmentGroupsRepository struct {
+	Database  bun.IDB
+	Encryptor encryption.EnvelopeEncryptor
+	Logger    logging.ServiceLogger
+}
+
+func NewEnvironmentGroupsRepository(database *bun.DB, encryptor encryption.EnvelopeEncryptor, logger logging.ServiceLogger) EnvironmentGroupsRepository {
+	return EnvironmentGroupsRepository{
+		Database:  database,
+		Encryptor: encryptor,
+		Logger:    logger,
+	}
+}
+
+func (repository *EnvironmentGroupsRepository) WithTx(tx bun.Tx) EnvironmentGroupsRepository {
+	txRepository := *repository
+	txRepository.Database = tx
+	return txRepository
+}
+
+func (repository *EnvironmentGroupsRepository) CreateEnvironmentGroupsTables() error {
+	repository.Logger.LogInfo("Creating environment_groups tables.")
+
+	models := []interface{}{
+		(*EnvironmentGroup)(nil),
+		(*EnvironmentGroupVariable)(nil),
+		(*AppEnvironmentGroup)(nil),
+	}
+
+	for _, model := range models {
+		_, err := repository.Database.NewCreateTable().Model(model).IfNotExists().Exec(context.Background())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (repository *EnvironmentGroupsRepository) CreateEnvironmentGroup(ctx context.Context, projectId, name string) (*EnvironmentGroup, error) {
+	environmentGroup := EnvironmentGroup{
+		ProjectId: projectId,
+		Name:      name,
+	}
+
+	_, err := repository.Database.NewInsert().Model(&environmentGroup).Returning("*").Exec(ctx)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" && pgErr.ConstraintName == "environment_groups_project_id_name_key" {
+				return nil, ErrEnvironmentGroupNameInUse
+			}
+		}
+
+		return nil, err
+	}
+
+	return &environmentGroup, nil
+}
+
+func (repository *EnvironmentGroupsRepository) GetEnvironmentGroups(ctx context.Context, projectId string) ([]EnvironmentGroup, error) {
+	environmentGroups := []EnvironmentGroup{}
+	err := repository.Database.
+		NewSelect().
+		Model(&environmentGroups).
+		Where("project_id = ?", projectId).
+		Order("name ASC").
+		Scan(ctx)
+
+	if err != nil {
+		return []EnvironmentGroup{}, err
+	}
+
+	return environmentGroups, nil
+}
+
+func (repository *EnvironmentGroupsRepository) GetEnvironmentGroupById(ctx context.Context, projectId, groupId string) (*EnvironmentGroup, error) {
+	environmentGroup := EnvironmentGroup{}
+	err := repository.Database.
+		NewSelect().
+		Model(&environmentGroup).
+		Where("id = ? AND project_id = ?", groupId, projectId).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrEnvironmentGroupNotFound
+		}
+		return nil, err
+	}
+
+	return &environmentGroup, nil
+}
+
+// DeleteEnvironmentGroup removes the group with its variables and app links.
+func (repository *EnvironmentGroupsRepository) DeleteEnvironmentGroup(ctx context.Context, projectId, groupId string) error {
+	return repository.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		result, err := tx.NewDelete().
+			Model((*EnvironmentGroup)(nil)).
+			Where("id = ? AND project_id = ?", groupId, projectId).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, _ := result.RowsAffected()
+		if rowsAffected == 0 {
+			return ErrEnvironmentGroupNotFound
+		}
+
+		return repository.deleteGroupsChildren(ctx, tx, []string{groupId})
+	})
+}
+
+func (repository *EnvironmentGroupsRepository) DeleteEnvironmentGroupsByProjectId(ctx context.Context, projectId string) error {
+	return repository.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		groupIds := []string{}
+		err := tx.NewDelete().
+			Model((*EnvironmentGroup)(nil)).
+			Where("project_id = ?", projectId).
+			Returning("id").
+			Scan(ctx, &groupIds)
+		if err != nil {
+			return err
+		}
+
+		if len(groupIds) == 0 {
+			return nil
+		}
+
+		return repository.deleteGroupsChildren(ctx, tx, groupIds)
+	})
+}
+
+func (repository *EnvironmentGroupsRepository) GetEnvironmentGroupVariables(ctx context.Context, groupId string) ([]EnvironmentGroupVariable, error) {
+	environmentGroupVariables := []EnvironmentGroupVariable{}
+	err := repository.Database.
+		NewSelect().
+		Model(&environmentGroupVariables).
+		Where("group_id = ?", groupId).
+		Order("key ASC").
+		Scan(ctx)
+
+	if err != nil {
+		return []EnvironmentGroupVariable{}, err
+	}
+
+	for i := range environmentGroupVariables {
+		if err := repository.decrypt(ctx, &environmentGroupVariables[i]); err != nil {
+			return []EnvironmentGroupVariable{}, err
+		}
+	}
+
+	return environmentGroupVariables, nil
+}
+
+// SetEnvironmentGroupVariable creates the variable or overwrites the value of an existing key.
+func (repository *EnvironmentGroupsRepository) SetEnvironmentGroupVariable(ctx context.Context, groupId string, setEnvironmentVariableParams SetEnvironmentVariableParams) (*EnvironmentGroupVariable, error) {
+	encryptedValue, err := repository.Encryptor.Encrypt(ctx, []byte(setEnvironmentVariableParams.Value))
+	if err != nil {
+		return nil, err
+	}
+
+	environmentGroupVariable := EnvironmentGroupVariable{
+		GroupId:    groupId,
+		Key:        setEnvironmentVariableParams.Key,
+		Ciphertext: encryptedValue.Ciphertext,
+		WrappedKey: encryptedValue.WrappedKey,
+		KeyId:      encryptedValue.KeyId,
+		IsSecret:   setEnvironmentVariableParams.IsSecret,
+	}
+
+	_, err = repository.Database.
+		NewInsert().
+		Model(&environmentGroupVariable).
+		On("CONFLICT (group_id, key) DO UPDATE").
+		Set("ciphertext = EXCLUDED.ciphertext").
+		Set("wrapped_key = EXCLUDED.wrapped_key").
+		Set("key_id = EXCLUDED.key_id").
+		Set("is_secret = EXCLUDED.is_secret").
+		Set("updated_at = now()").
+		Returning("*").
+		Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	environmentGroupVariable.Value = setEnvironmentVariableParams.Value
+
+	return &environmentGroupVariable, nil
+}
+
+func (repository *EnvironmentGroupsRepository) DeleteEnvironmentGroupVariable(ctx context.Context, groupId, key string) error {
+	result, err := repository.Database.
+		NewDelete().
+		Model((*EnvironmentGroupVariable)(nil)).
+		Where("group_id = ? AND key = ?", groupId, key).
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return ErrEnvVarNotFound
+	}
+
+	return nil
+}
+
+func (repository *EnvironmentGroupsRepository) LinkApp(ctx context.Context, groupId, appId string) error {
+	_, err := repository.Database.
+		NewInsert().
+		Model(&AppEnvironmentGroup{AppId: appId, GroupId: groupId}).
+		On("CONFLICT (app_id, group_id) DO NOTHING").
+		Exec(ctx)
+	return err
+}
+
+func (repository *EnvironmentGroupsRepository) UnlinkApp(ctx context.Context, groupId, appId string) error {
+	result, err := repository.Database.
+		NewDelete().
+		Model((*AppEnvironmentGroup)(nil)).
+		Where("group_id = ? AND app_id = ?", groupId, appId).
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return ErrEnvironmentGroupLinkNotFound
+	}
+
+	return nil
+}
+
+func (repository *EnvironmentGroupsRepository) GetLinkedAppIds(ctx context.Context, groupId string) ([]string, error) {
+	appIds := []string{}
+	err := repository.Database.
+		NewSelect().
+		Model((*AppEnvironmentGroup)(nil)).
+		Column("app_id").
+		Where("group_id = ?", groupId).
+		Order("created_at ASC").
+		Scan(ctx, &appIds)
+
+	if err != nil {
+		return []string{}, err
+	}
+
+	return appIds, nil
+}
+
+// GetAppEnvironmentGroups returns the groups linked to the app, from the lowest to the highest precedence.
+func (repository *EnvironmentGroupsRepository) GetAppEnvironmentGroups(ctx context.Context, appId string) ([]EnvironmentGroup, error) {
+	environmentGroups := []EnvironmentGroup{}
+	err := repository.Database.
+		NewSelect().
+		Model(&environmentGroups).
+		Join("JOIN app_environment_groups AS aeg ON aeg.group_id = environment_group.id").
+		Where("aeg.app_id = ?", appId).
+		Order("aeg.created_at ASC").
+		Scan(ctx)
+
+	if err != nil {
+		return []EnvironmentGroup{}, err
+	}
+
+	return environmentGroups, nil
+}
+
+// ResolveAppEnvironmentGroupsVariables merges the variables of every group linked to the app.
+func (repository *EnvironmentGroupsRepository) ResolveAppEnvironmentGroupsVariables(ctx context.Context, appId string) (map[string]string, error) {
+	environmentGroups, err := repository.GetAppEnvironmentGroups(ctx, appId)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]string{}
+	for _, environmentGroup := range environmentGroups {
+		environmentGroupVariables, err := repository.GetEnvironmentGroupVariables(ctx, environmentGroup.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, environmentGroupVariable := range environmentGroupVariables {
+			values[environmentGroupVariable.Key] = environmentGroupVariable.Value
+		}
+	}
+
+	return values, nil
+}
+
+func (repository *EnvironmentGroupsRepository) DeleteAppLinksByAppIds(ctx context.Context, appIds []string) error {
+	if len(appIds) == 0 {
+		return nil
+	}
+
+	_, err := repository.Database.
+		NewDelete().
+		Model((*AppEnvironmentGroup)(nil)).
+		Where("app_id IN (?)", bun.In(appIds)).
+		Exec(ctx)
+	return err
+}
+
+func (repository *EnvironmentGroupsRepository) deleteGroupsChildren(ctx context.Context, tx bun.Tx, groupIds []string) error {
+	_, err := tx.NewDelete().
+		Model((*EnvironmentGroupVariable)(nil)).
+		Where("group_id IN (?)", bun.In(groupIds)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.NewDelete().
+		Model((*AppEnvironmentGroup)(nil)).
+		Where("group_id IN (?)", bun.In(groupIds)).
+		Exec(ctx)
+	return err
+}
+
+func (repository *EnvironmentGroupsRepository) decrypt(ctx context.Context, environmentGroupVariable *EnvironmentGroupVariable) error {
+	value, err := repository.Encryptor.Decrypt(ctx, &encryption.EncryptedValue{
+		Ciphertext: environmentGroupVariable.Ciphertext,
+		WrappedKey: environmentGroupVariable.WrappedKey,
+		KeyId:      environmentGroupVariable.KeyId,
+	})
+	if err != nil {
+		return err
+	}
+
+	environmentGroupVariable.Value = string(value)
+	return nil
+}
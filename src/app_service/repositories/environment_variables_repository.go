@@ -1,8 +1,10 @@
 package repositories
 
 import (
+	"app/encryption"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -12,45 +14,188 @@ import (
 )
 
 type EnvironmentVariable struct {
-	Id        string    `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
-	AppId     string    `bun:"app_id,type:uuid,notnull" json:"app_id"`
-	Value     string    `bun:"value" json:"value"` // Store json object of env vars
-	CreatedAt time.Time `bun:"created_at,default:now()" json:"created_at"`
+	bun.BaseModel `bun:"table:app_environment_variables"`
 
-	App *App `bun:"rel:belongs-to,join:app_id=id"`
-}
+	Id         string    `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	AppId      string    `bun:"app_id,type:uuid,notnull,unique:app_environment_variables_app_id_key_key" json:"app_id"`
+	Key        string    `bun:"key,notnull,unique:app_environment_variables_app_id_key_key" json:"key"`
+	Ciphertext []byte    `bun:"ciphertext,type:bytea,notnull" json:"-"`
+	WrappedKey []byte    `bun:"wrapped_key,type:bytea,notnull" json:"-"`
+	KeyId      string    `bun:"key_id,notnull" json:"-"`
+	IsSecret   bool      `bun:"is_secret,notnull,default:false" json:"is_secret"`
+	CreatedAt  time.Time `bun:"created_at,default:now()" json:"created_at"`
+	UpdatedAt  time.Time `bun:"updated_at,default:now()" json:"updated_at"`
 
-type CreateEnvironmentVariableParams struct {
-	Value string
+	// Value holds the decrypted value, it is never persisted.
+	Value string `bun:"-" json:"value"`
+
+	App *App `bun:"rel:belongs-to,join:app_id=id"`
 }
 
-type UpdateEnvironmentVariableParams struct {
-	Value string
+type SetEnvironmentVariableParams struct {
+	Key      string
+	Value    string
+	IsSecret bool
 }
 
 type EnvironmentVariablesRepository struct {
-	Database *bun.DB
-	Logger   logging.ServiceLogger
+	Database  bun.IDB
+	Encryptor encryption.EnvelopeEncryptor
+	Logger    logging.ServiceLogger
 }
 
-func NewEnvironmentVariablesRepository(database *bun.DB, logger logging.ServiceLogger) EnvironmentVariablesRepository {
+func NewEnvironmentVariablesRepository(database *bun.DB, encryptor encryption.EnvelopeEncryptor, logger logging.ServiceLogger) EnvironmentVariablesRepository {
 	return EnvironmentVariablesRepository{
-		Database: database,
-		Logger:   logger,
+		Database:  database,
+		Encryptor: encryptor,
+		Logger:    logger,
 	}
 }
 
+func (repository *EnvironmentVariablesRepository) WithTx(tx bun.Tx) EnvironmentVariablesRepository {
+	txRepository := *repository
+	txRepository.Database = tx
+	return txRepository
+}
+
 func (repository *EnvironmentVariablesRepository) CreateEnvironmentVariablesTable() (sql.Result, error) {
-	repository.Logger.LogInfo("Creating environment_variables table.")
+	repository.Logger.LogInfo("Creating app_environment_variables table.")
 	return repository.Database.NewCreateTable().Model((*EnvironmentVariable)(nil)).IfNotExists().Exec(context.Background())
 }
 
-func (repository *EnvironmentVariablesRepository) GetEnvironmentVariable(ctx context.Context, appId string) (*EnvironmentVariable, error) {
+// legacyEnvironmentVariables is a row of the table of the previous versions, which kept the
+// variables of an app as one JSON object in plain text.
+type legacyEnvironmentVariables struct {
+	bun.BaseModel `bun:"table:environment_variables"`
+
+	Id    string `bun:"id,pk"`
+	AppId string `bun:"app_id"`
+	Value string `bun:"value"`
+}
+
+// MigrateLegacyEnvironmentVariables encrypts the variables of the environment_variables table into
+// app_environment_variables, then drops it so that no value stays in plain text. A key already set
+// in app_environment_variables is kept. The rows which are not a JSON object are left in the table.
+func (repository *EnvironmentVariablesRepository) MigrateLegacyEnvironmentVariables() error {
+	return repository.Database.RunInTx(context.Background(), nil, func(ctx context.Context, tx bun.Tx) error {
+		// the replicas starting together migrate one after the other, the next ones find no table
+		_, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext('environment_variables'))")
+		if err != nil {
+			return err
+		}
+
+		exists := false
+		err = tx.NewRaw("SELECT to_regclass('environment_variables') IS NOT NULL").Scan(ctx, &exists)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return nil
+		}
+
+		repository.Logger.LogInfo("Migrating environment_variables to app_environment_variables.")
+
+		legacyRows := []legacyEnvironmentVariables{}
+		// the most recent row of an app wins when it has several
+		err = tx.NewSelect().Model(&legacyRows).Order("created_at DESC").Scan(ctx)
+		if err != nil {
+			return err
+		}
+
+		migratedIds := []string{}
+		for _, legacyRow := range legacyRows {
+			values, err := parseLegacyEnvironmentVariables(legacyRow.Value)
+			if err != nil {
+				repository.Logger.LogErrorF("failed to migrate the environment variables of app %s: %v", legacyRow.AppId, err)
+				continue
+			}
+
+			for key, value := range values {
+				encryptedValue, err := repository.Encryptor.Encrypt(ctx, []byte(value))
+				if err != nil {
+					return err
+				}
+
+				_, err = tx.NewInsert().
+					Model(&EnvironmentVariable{
+						AppId:      legacyRow.AppId,
+						Key:        key,
+						Ciphertext: encryptedValue.Ciphertext,
+						WrappedKey: encryptedValue.WrappedKey,
+						KeyId:      encryptedValue.KeyId,
+					}).
+					On("CONFLICT (app_id, key) DO NOTHING").
+					Returning("NULL").
+					Exec(ctx)
+				if err != nil {
+					return err
+				}
+			}
+
+			migratedIds = append(migratedIds, legacyRow.Id)
+		}
+
+		if len(migratedIds) < len(legacyRows) {
+			if len(migratedIds) > 0 {
+				_, err = tx.NewDelete().Model((*legacyEnvironmentVariables)(nil)).Where("id IN (?)", bun.In(migratedIds)).Exec(ctx)
+			}
+			return err
+		}
+
+		_, err = tx.NewDropTable().Model((*legacyEnvironmentVariables)(nil)).Exec(ctx)
+		return err
+	})
+}
+
+// parseLegacyEnvironmentVariables reads a JSON object of variables, the values which are not strings,
+// e.g. numbers, are kept as written.
+func parseLegacyEnvironmentVariables(value string) (map[string]string, error) {
+	rawValues := map[string]json.RawMessage{}
+	err := json.Unmarshal([]byte(value), &rawValues)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]string{}
+	for key, rawValue := range rawValues {
+		stringValue := ""
+		if json.Unmarshal(rawValue, &stringValue) != nil {
+			stringValue = string(rawValue)
+		}
+		values[key] = stringValue
+	}
+
+	return values, nil
+}
+
+func (repository *EnvironmentVariablesRepository) GetEnvironmentVariables(ctx context.Context, appId string) ([]EnvironmentVariable, error) {
+	environmentVariables := []EnvironmentVariable{}
+	err := repository.Database.
+		NewSelect().
+		Model(&environmentVariables).
+		Where("app_id = ?", appId).
+		Order("key ASC").
+		Scan(ctx)
+
+	if err != nil {
+		return []EnvironmentVariable{}, err
+	}
+
+	for i := range environmentVariables {
+		if err := repository.decrypt(ctx, &environmentVariables[i]); err != nil {
+			return []EnvironmentVariable{}, err
+		}
+	}
+
+	return environmentVariables, nil
+}
+
+func (repository *EnvironmentVariablesRepository) GetEnvironmentVariable(ctx context.Context, appId, key string) (*EnvironmentVariable, error) {
 	environmentVariable := EnvironmentVariable{}
 	err := repository.Database.
 		NewSelect().
 		Model(&environmentVariable).
-		Where("app_id = ?", appId).
+		Where("app_id = ? AND key = ?", appId, key).
 		Scan(ctx)
 
 	if err != nil {
@@ -60,56 +205,96 @@ func (repository *EnvironmentVariablesRepository) GetEnvironmentVariable(ctx con
 		return nil, err
 	}
 
+	if err := repository.decrypt(ctx, &environmentVariable); err != nil {
+		return nil, err
+	}
+
 	return &environmentVariable, nil
 }
 
-func (repository *EnvironmentVariablesRepository) CreateEnvironmentVariables(ctx context.Context, appId string, createEnvironmentVariableParams CreateEnvironmentVariableParams) (*EnvironmentVariable, error) {
-	environmentVariable := EnvironmentVariable{
-		AppId: appId,
-		Value: createEnvironmentVariableParams.Value,
-	}
+// SetEnvironmentVariable creates the variable or overwrites the value of an existing key.
+func (repository *EnvironmentVariablesRepository) SetEnvironmentVariable(ctx context.Context, appId string, setEnvironmentVariableParams SetEnvironmentVariableParams) (*EnvironmentVariable, error) {
+	return repository.setEnvironmentVariable(ctx, repository.Database, appId, setEnvironmentVariableParams)
+}
 
-	_, err := repository.Database.
-		NewInsert().
-		Model(&environmentVariable).
-		Exec(ctx)
+// SetEnvironmentVariables upserts all the given variables in a single transaction.
+func (repository *EnvironmentVariablesRepository) SetEnvironmentVariables(ctx context.Context, appId string, setEnvironmentVariablesParams []SetEnvironmentVariableParams) ([]EnvironmentVariable, error) {
+	environmentVariables := make([]EnvironmentVariable, 0, len(setEnvironmentVariablesParams))
+
+	err := repository.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, params := range setEnvironmentVariablesParams {
+			environmentVariable, err := repository.setEnvironmentVariable(ctx, tx, appId, params)
+			if err != nil {
+				return err
+			}
+			environmentVariables = append(environmentVariables, *environmentVariable)
+		}
+		return nil
+	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &environmentVariable, nil
+	return environmentVariables, nil
 }
 
-func (repository *EnvironmentVariablesRepository) UpdateEnvironmentVariables(ctx context.Context, appId string, updateEnvironmentVariableParams UpdateEnvironmentVariableParams) (*EnvironmentVariable, error) {
-	environmentVariable := EnvironmentVariable{
-		Value: updateEnvironmentVariableParams.Value,
+// ReplaceEnvironmentVariables upserts the given variables and deletes every other key of the app.
+func (repository *EnvironmentVariablesRepository) ReplaceEnvironmentVariables(ctx context.Context, appId string, setEnvironmentVariablesParams []SetEnvironmentVariableParams) ([]EnvironmentVariable, error) {
+	environmentVariables := make([]EnvironmentVariable, 0, len(setEnvironmentVariablesParams))
+	keys := make([]string, 0, len(setEnvironmentVariablesParams))
+
+	err := repository.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for _, params := range setEnvironmentVariablesParams {
+			environmentVariable, err := repository.setEnvironmentVariable(ctx, tx, appId, params)
+			if err != nil {
+				return err
+			}
+			environmentVariables = append(environmentVariables, *environmentVariable)
+			keys = append(keys, params.Key)
+		}
+
+		query := tx.NewDelete().
+			Model((*EnvironmentVariable)(nil)).
+			Where("app_id = ?", appId)
+		if len(keys) > 0 {
+			query = query.Where("key NOT IN (?)", bun.In(keys))
+		}
+
+		_, err := query.Exec(ctx)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
 	}
 
+	return environmentVariables, nil
+}
+
+func (repository *EnvironmentVariablesRepository) DeleteEnvironmentVariable(ctx context.Context, appId, key string) error {
 	result, err := repository.Database.
-		NewUpdate().
-		Model(&environmentVariable).
-		Column("value").
-		Where("app_id = ?", appId).
-		Returning("*").
+		NewDelete().
+		Model((*EnvironmentVariable)(nil)).
+		Where("app_id = ? AND key = ?", appId, key).
 		Exec(ctx)
 
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	rowsAffected, _ := result.RowsAffected()
 	if rowsAffected == 0 {
-		return nil, ErrEnvVarNotFound
+		return ErrEnvVarNotFound
 	}
 
-	return &environmentVariable, nil
+	return nil
 }
 
 func (repository *EnvironmentVariablesRepository) DeleteEnvironmentVariableByAppId(ctx context.Context, appId string) error {
 	_, err := repository.Database.
 		NewDelete().
-		Model(&EnvironmentVariable{AppId: appId}).
+		Model((*EnvironmentVariable)(nil)).
 		Where("app_id = ?", appId).
 		Exec(ctx)
 	return err
@@ -123,3 +308,53 @@ func (repository *EnvironmentVariablesRepository) DeleteEnvironmentVariablesByAp
 		Exec(ctx)
 	return err
 }
+
+func (repository *EnvironmentVariablesRepository) setEnvironmentVariable(ctx context.Context, db bun.IDB, appId string, setEnvironmentVariableParams SetEnvironmentVariableParams) (*EnvironmentVariable, error) {
+	encryptedValue, err := repository.Encryptor.Encrypt(ctx, []byte(setEnvironmentVariableParams.Value))
+	if err != nil {
+		return nil, err
+	}
+
+	environmentVariable := EnvironmentVariable{
+		AppId:      appId,
+		Key:        setEnvironmentVariableParams.Key,
+		Ciphertext: encryptedValue.Ciphertext,
+		WrappedKey: encryptedValue.WrappedKey,
+		KeyId:      encryptedValue.KeyId,
+		IsSecret:   setEnvironmentVariableParams.IsSecret,
+	}
+
+	_, err = db.
+		NewInsert().
+		Model(&environmentVariable).
+		On("CONFLICT (app_id, key) DO UPDATE").
+		Set("ciphertext = EXCLUDED.ciphertext").
+		Set("wrapped_key = EXCLUDED.wrapped_key").
+		Set("key_id = EXCLUDED.key_id").
+		Set("is_secret = EXCLUDED.is_secret").
+		Set("updated_at = now()").
+		Returning("*").
+		Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	environmentVariable.Value = setEnvironmentVariableParams.Value
+
+	return &environmentVariable, nil
+}
+
+func (repository *EnvironmentVariablesRepository) decrypt(ctx context.Context, environmentVariable *EnvironmentVariable) error {
+	value, err := repository.Encryptor.Decrypt(ctx, &encryption.EncryptedValue{
+		Ciphertext: environmentVariable.Ciphertext,
+		WrappedKey: environmentVariable.WrappedKey,
+		KeyId:      environmentVariable.KeyId,
+	})
+	if err != nil {
+		return err
+	}
+
+	environmentVariable.Value = string(value)
+	return nil
+}
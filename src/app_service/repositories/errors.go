@@ -8,4 +8,13 @@ var (
 	ErrDomainNameInUse       = errors.New("domain with that name already exists")
 	ErrAppNotFound           = errors.New("app not found")
 	ErrGitRepositoryNotFound = errors.New("git repository not found")
+
+	ErrEnvironmentGroupNotFound     = errors.New("environment group not found")
+	ErrEnvironmentGroupNameInUse    = errors.New("environment group with that name already exists")
+	ErrEnvironmentGroupLinkNotFound = errors.New("app is not linked to the environment group")
+
+	ErrDiskNotFound = errors.New("app has no disk")
+
+	// ErrInvalidAppStatusTransition is returned for an event that does not apply to the current status of the app.
+	ErrInvalidAppStatusTransition = errors.New("invalid app status transition")
 )
@@ -41,7 +41,7 @@ type UpdateGitRepository struct {
 }
 
 type GitRepositoryRepository struct {
-	Database *bun.DB
+	Database bun.IDB
 	Logger   logging.ServiceLogger
 }
 
@@ -52,6 +52,12 @@ func NewGitRepositoryRepository(database *bun.DB, logger logging.ServiceLogger)
 	}
 }
 
+func (repository *GitRepositoryRepository) WithTx(tx bun.Tx) GitRepositoryRepository {
+	txRepository := *repository
+	txRepository.Database = tx
+	return txRepository
+}
+
 func (repository *GitRepositoryRepository) CreateGitRepositoryRepositoryTable() (sql.Result, error) {
 	repository.Logger.LogInfo("Creating git_repositories table.")
 	return repository.Database.NewCreateTable().Model((*GitRepository)(nil)).IfNotExists().Exec(context.Background())
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+// addColumnsIfNotExists brings tables created by an older version up to date,
+// CreateTable().IfNotExists() leaves existing tables untouched.
+func addColumnsIfNotExists(database bun.IDB, model interface{}, columns ...string) error {
+	for _, column := range columns {
+		_, err := database.NewAddColumn().Model(model).ColumnExpr(column).IfNotExists().Exec(context.Background())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
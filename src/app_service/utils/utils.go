@@ -1,11 +1,12 @@
 package utils
 
 import (
-	"strings"
+	"apps-hosting.com/messaging/platform"
 )
 
+// GetDomainName is the domain of the app under the domain of the platform (APPS_DOMAIN).
 func GetDomainName(appName string) string {
-	return strings.ToLower(strings.ReplaceAll(appName, " ", "-")) + ".apps-hosting.com"
+	return platform.FromEnv().AppDomainName(appName)
 }
 
 func SafeString(s *string) string {
@@ -5,8 +5,8 @@ go 1.24.0
 toolchain go1.24.3
 
 require (
-	apps-hosting.com/logging v0.0.1-20251127192047-9a4b3aa8018d
-	apps-hosting.com/messaging v0.0.1-20251127192047-9a4b3aa8018d
+	apps-hosting.com/logging v0.0.1-20261019033112-43aa3e925870
+	apps-hosting.com/messaging v0.0.1-20261019040005-85cad14baaac
 	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.63.0
 	k8s.io/api v0.34.1
 	k8s.io/apimachinery v0.34.1
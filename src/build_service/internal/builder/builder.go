@@ -9,6 +9,7 @@ import (
 	"apps-hosting.com/buildservice/internal/buildexecutor"
 	"apps-hosting.com/buildservice/internal/models"
 	"apps-hosting.com/buildservice/internal/repomanager"
+	"apps-hosting.com/buildservice/internal/staticsite"
 	"apps-hosting.com/buildservice/internal/storage"
 	"apps-hosting.com/buildservice/proto/user_service_pb"
 	"apps-hosting.com/logging"
@@ -20,6 +21,11 @@ var runtimeDockerFilesPaths = map[string]string{
 	"NodeJS": "assets/runtime/NodeJS.Dockerfile",
 }
 
+// AppTypeStaticSite mirrors the static site app type of app-service.
+const AppTypeStaticSite = "static_site"
+
+const staticSiteDockerFilePath = "assets/nginx/Dockerfile"
+
 type Builder struct {
 	gitRepoManager    repomanager.GitRepoManager
 	buildExecutor     buildexecutor.BuildExecutor
@@ -45,7 +51,7 @@ func NewBuilder(
 	}
 }
 
-func (b *Builder) CloneGitRepository(ctx context.Context, userId string, cloneUrl string, isPrivate bool) (*repomanager.GitRepo, error) {
+func (b *Builder) CloneGitRepository(ctx context.Context, userId, cloneUrl, gitRef string, isPrivate bool) (*repomanager.GitRepo, error) {
 	span := trace.SpanFromContext(ctx)
 
 	getGithubUserAccessTokenResponse, err := b.userServiceClient.
@@ -64,6 +70,7 @@ func (b *Builder) CloneGitRepository(ctx context.Context, userId string, cloneUr
 	b.serviceLogger.LogInfo(fmt.Sprintf("Cloning github repository '%s'...", cloneUrl))
 	gitRepo, err := b.gitRepoManager.Clone(
 		cloneUrl,
+		gitRef,
 		isPrivate,
 		getGithubUserAccessTokenResponse.GithubUserAccessToken,
 		b.userAppLogger,
@@ -78,12 +85,12 @@ func (b *Builder) CloneGitRepository(ctx context.Context, userId string, cloneUr
 	return gitRepo, nil
 }
 
-func (b *Builder) PrepareSourceCode(ctx context.Context, runtime, gitRepositoryFilename, gitRepositoryPath string) error {
+func (b *Builder) PrepareSourceCode(ctx context.Context, runtime, appType, gitRepositoryFilename, gitRepositoryPath string) error {
 	span := trace.SpanFromContext(ctx)
 
 	// Copy Docker Image
 	b.serviceLogger.LogInfo(fmt.Sprintf("Copy Dockerfile for the target runtime '%s' to repository path '%s'...", runtime, gitRepositoryPath))
-	_, err := b.AddDockerfile(gitRepositoryPath, runtime)
+	_, err := b.AddDockerfile(gitRepositoryPath, runtime, appType)
 	if err != nil {
 		b.userAppLogger.LogError(err.Error())
 		b.serviceLogger.LogError(err.Error())
@@ -115,14 +122,16 @@ func (b *Builder) PrepareSourceCode(ctx context.Context, runtime, gitRepositoryF
 	return nil
 }
 
-func (b *Builder) BuildAndPushDockerImage(ctx context.Context, appId, appName, repositoryFileName string) (*string, error) {
+// BuildAndPushDockerImage tags the image with the commit it is built from, so that
+// deploying a new build of a running app always changes its Deployment.
+func (b *Builder) BuildAndPushDockerImage(ctx context.Context, appId, appName, commitHash, repositoryFileName string, buildArgs map[string]string) (*string, error) {
 	span := trace.SpanFromContext(ctx)
 	registryURL := os.Getenv("REGISTRY_URL")
-	imageURL := registryURL + buildexecutor.ToImageName(appName)
+	imageURL := registryURL + buildexecutor.ToImageName(appName) + ":" + commitHash
 	srcContext := fmt.Sprintf("s3://apps-source/%s", repositoryFileName)
 
 	b.serviceLogger.LogInfoF("Running kaniko build job for image '%s'...", imageURL)
-	err := b.buildExecutor.Execute(srcContext, imageURL, appId, appName)
+	err := b.buildExecutor.Execute(srcContext, imageURL, appId, appName, buildArgs)
 	if err != nil {
 		b.serviceLogger.LogError(err.Error())
 		span.SetAttributes(attribute.String("error", err.Error()))
@@ -132,12 +141,21 @@ func (b *Builder) BuildAndPushDockerImage(ctx context.Context, appId, appName, r
 	return &imageURL, nil
 }
 
-func (b *Builder) AddDockerfile(repoPath string, runtime string) (string, error) {
+func (b *Builder) AddDockerfile(repoPath string, runtime string, appType string) (string, error) {
 	src, exists := runtimeDockerFilesPaths[runtime]
 	if !exists {
 		return "", fmt.Errorf("unsupported runtime: %s", runtime)
 	}
 
+	if appType == AppTypeStaticSite {
+		err := b.AddStaticSiteConfig(repoPath)
+		if err != nil {
+			return "", err
+		}
+
+		src = staticSiteDockerFilePath
+	}
+
 	dest := filepath.Join(repoPath, "Dockerfile")
 
 	err := CopyFile(src, dest)
@@ -148,19 +166,40 @@ func (b *Builder) AddDockerfile(repoPath string, runtime string) (string, error)
 	return dest, nil
 }
 
-func (b *Builder) StartBuilding(ctx context.Context, userId, appId, appName, appRuntime, cloneURL string, isPrivate bool) (*models.Build, error) {
-	repository, err := b.CloneGitRepository(ctx, userId, cloneURL, isPrivate)
+// AddStaticSiteConfig turns the routing rules of the repository into the nginx config of the image.
+func (b *Builder) AddStaticSiteConfig(repoPath string) error {
+	config, err := staticsite.LoadConfig(repoPath)
+	if err != nil {
+		return err
+	}
+
+	b.userAppLogger.LogInfo(fmt.Sprintf(
+		"Static site: %d header rules, %d redirect rules",
+		len(config.Headers),
+		len(config.Redirects),
+	))
+
+	return staticsite.WriteNginxConfig(repoPath, config)
+}
+
+func (b *Builder) StartBuilding(ctx context.Context, userId, appId, appName, appRuntime, appType, publishDir, cloneURL, gitRef string, isPrivate bool) (*models.Build, error) {
+	repository, err := b.CloneGitRepository(ctx, userId, cloneURL, gitRef, isPrivate)
 	if err != nil {
 		return nil, err
 	}
 
 	repositoryFileName := fmt.Sprintf("%s.tar.gz", repository.Id)
-	err = b.PrepareSourceCode(ctx, appRuntime, repositoryFileName, repository.Path)
+	err = b.PrepareSourceCode(ctx, appRuntime, appType, repositoryFileName, repository.Path)
 	if err != nil {
 		return nil, err
 	}
 
-	imageUrl, err := b.BuildAndPushDockerImage(ctx, appId, appName, repositoryFileName)
+	buildArgs := map[string]string{}
+	if appType == AppTypeStaticSite {
+		buildArgs["PUBLISH_DIR"] = publishDir
+	}
+
+	imageUrl, err := b.BuildAndPushDockerImage(ctx, appId, appName, repository.LastCommitHash, repositoryFileName, buildArgs)
 	if err != nil {
 		return nil, err
 	}
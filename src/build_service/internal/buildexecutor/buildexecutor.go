@@ -1,5 +1,5 @@
 package buildexecutor
 
 type BuildExecutor interface {
-	Execute(srcContext, destination, appId, appName string) error
+	Execute(srcContext, destination, appId, appName string, buildArgs map[string]string) error
 }
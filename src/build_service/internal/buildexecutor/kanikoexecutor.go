@@ -24,8 +24,8 @@ func NewKanikoExecutor(kubernetesClientset *kubernetes.Clientset, logger logging
 	}
 }
 
-func (k *KanikoExecutor) Execute(srcContext, destination, appId, appName string) error {
-	job := NewKanikoJob(srcContext, destination, appId, appName)
+func (k *KanikoExecutor) Execute(srcContext, destination, appId, appName string, buildArgs map[string]string) error {
+	job := NewKanikoJob(srcContext, destination, appId, appName, buildArgs)
 
 	_, err := k.kubernetesClientset.BatchV1().Jobs("default").Create(context.Background(), &job, metav1.CreateOptions{})
 	if err != nil {
@@ -75,9 +75,16 @@ func (k *KanikoExecutor) DeleteJobs(appName string) error {
 		)
 }
 
-func NewKanikoJob(srcContext, destination, appId, appName string) batchv1.Job {
+func NewKanikoJob(srcContext, destination, appId, appName string, buildArgs map[string]string) batchv1.Job {
 	containerRestartPolicy := corev1.ContainerRestartPolicyNever
 
+	args := []string{
+		fmt.Sprintf("--context=%s", srcContext),
+		fmt.Sprintf("--destination=%s", destination),
+	}
+	args = append(args, ToKanikoBuildArgs(buildArgs)...)
+	args = append(args, "--insecure", "--skip-tls-verify")
+
 	return batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: ToK8sJobName(appName),
@@ -101,14 +108,7 @@ func NewKanikoJob(srcContext, destination, appId, appName string) batchv1.Job {
 						{
 							Name:  "kaniko",
 							Image: "gcr.io/kaniko-project/executor:latest",
-							Args: []string{
-								fmt.Sprintf("--context=%s", srcContext),
-								fmt.Sprintf("--destination=%s", destination),
-								"--build-arg=START_CMD=start",
-								"--build-arg=BUILD_CMD=build",
-								"--insecure",
-								"--skip-tls-verify",
-							},
+							Args:  args,
 							Env: []corev1.EnvVar{
 								{Name: "AWS_ACCESS_KEY_ID", Value: "minioadmin"},
 								{Name: "AWS_SECRET_ACCESS_KEY", Value: "minioadmin"},
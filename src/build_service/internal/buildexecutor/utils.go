@@ -1,6 +1,10 @@
 package buildexecutor
 
-import "strings"
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
 
 func ToImageName(appName string) string {
 	return strings.ToLower(strings.ReplaceAll(appName, " ", "-"))
@@ -13,3 +17,27 @@ func ToK8sLabelValue(appName string) string {
 func ToK8sJobName(appName string) string {
 	return ToK8sLabelValue(appName) + "-job"
 }
+
+// ToKanikoBuildArgs adds the default START_CMD and BUILD_CMD to the given build args.
+func ToKanikoBuildArgs(buildArgs map[string]string) []string {
+	values := map[string]string{
+		"START_CMD": "start",
+		"BUILD_CMD": "build",
+	}
+	for key, value := range buildArgs {
+		values[key] = value
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	args := make([]string, 0, len(keys))
+	for _, key := range keys {
+		args = append(args, fmt.Sprintf("--build-arg=%s=%s", key, values[key]))
+	}
+
+	return args
+}
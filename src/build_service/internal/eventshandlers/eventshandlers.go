@@ -12,6 +12,7 @@ import (
 
 	"apps-hosting.com/messaging"
 	"apps-hosting.com/messaging/proto/events_pb"
+	"apps-hosting.com/messaging/proto/models_pb"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
@@ -19,19 +20,19 @@ import (
 )
 
 type EventsHandlers struct {
-	eventBus          messaging.EventBus
+	eventBus          messaging.Bus
 	buildExecutor     buildexecutor.BuildExecutor
 	gitRepoManager    repomanager.GitRepoManager
-	buildRepository   repositories.BuildRepository
+	buildRepository   repositories.BuildRepositoryInterface
 	userServiceClient user_service_pb.UserServiceClient
 	logger            logging.ServiceLogger
 }
 
 func NewEventsHandlers(
-	eventBus messaging.EventBus,
+	eventBus messaging.Bus,
 	buildExecutor buildexecutor.BuildExecutor,
 	gitRepoManager repomanager.GitRepoManager,
-	buildRepository repositories.BuildRepository,
+	buildRepository repositories.BuildRepositoryInterface,
 	userServiceClient user_service_pb.UserServiceClient,
 	logger logging.ServiceLogger,
 ) EventsHandlers {
@@ -45,7 +46,7 @@ func NewEventsHandlers(
 	}
 }
 
-func (h *EventsHandlers) HandleAppCreatedEvent(ctx context.Context, message *events_pb.Message) {
+func (h *EventsHandlers) HandleAppCreatedEvent(ctx context.Context, message *events_pb.Message) error {
 	h.logger.LogInfo("Handle 'app.created' event")
 	span := trace.SpanFromContext(ctx)
 
@@ -53,20 +54,43 @@ func (h *EventsHandlers) HandleAppCreatedEvent(ctx context.Context, message *eve
 	if data == nil {
 		h.logger.LogError("Invalid app.created event message")
 		span.SetAttributes(attribute.String("error", "Invalid app.created event message"))
-		return
+		return messaging.ErrInvalidMessage
 	}
 
+	return h.build(ctx, data.UserId, data.App, data.GitRepository, data.GitRef)
+}
+
+// HandleAppBuildRequestedEvent builds an existing app again, e.g. a pull request
+// preview after new commits were pushed.
+func (h *EventsHandlers) HandleAppBuildRequestedEvent(ctx context.Context, message *events_pb.Message) error {
+	h.logger.LogInfo("Handle 'app.build_requested' event")
+	span := trace.SpanFromContext(ctx)
+
+	data := message.Data.GetAppBuildRequestedData()
+	if data == nil {
+		h.logger.LogError("Invalid app.build_requested event message")
+		span.SetAttributes(attribute.String("error", "Invalid app.build_requested event message"))
+		return messaging.ErrInvalidMessage
+	}
+
+	return h.build(ctx, data.UserId, data.App, data.GitRepository, data.GitRef)
+}
+
+func (h *EventsHandlers) build(ctx context.Context, userId string, app *models_pb.App, gitRepository *models_pb.GitRepository, gitRef string) error {
+	span := trace.SpanFromContext(ctx)
+
 	span.SetAttributes(
-		attribute.String("app.id", data.App.Id),
-		attribute.String("project.id", data.App.ProjectId),
-		attribute.String("git_repository.id", data.GitRepository.Id),
+		attribute.String("app.id", app.Id),
+		attribute.String("project.id", app.ProjectId),
+		attribute.String("git_repository.id", gitRepository.Id),
+		attribute.String("git_repository.ref", gitRef),
 	)
 
 	// Create Build Entity
 	h.logger.LogInfo("Creating build entity...")
 	build, err := h.buildRepository.CreateBuild(
 		ctx,
-		data.App.Id,
+		app.Id,
 		repositories.CreateBuildParams{
 			Status: models.BuildStatusPending,
 		},
@@ -74,14 +98,14 @@ func (h *EventsHandlers) HandleAppCreatedEvent(ctx context.Context, message *eve
 	if err != nil {
 		h.logger.LogError(err.Error())
 		span.SetAttributes(attribute.String("error", err.Error()))
-		return
+		return err
 	}
 
 	span.SetAttributes(
 		attribute.String("build.id", build.Id),
 	)
 
-	userAppLogger := logging.NewUserAppLogger(data.App.Id, data.UserId, logging.StageBuild)
+	userAppLogger := logging.NewUserAppLogger(app.Id, userId, logging.StageBuild)
 	builder := builder.NewBuilder(
 		h.gitRepoManager,
 		h.buildExecutor,
@@ -92,21 +116,24 @@ func (h *EventsHandlers) HandleAppCreatedEvent(ctx context.Context, message *eve
 
 	buildResult, err := builder.StartBuilding(
 		ctx,
-		data.UserId,
-		data.App.Id,
-		data.App.Name,
-		data.App.Runtime,
-		data.GitRepository.CloneUrl,
-		data.GitRepository.IsPrivate,
+		userId,
+		app.Id,
+		app.Name,
+		app.Runtime,
+		app.Type,
+		app.PublishDir,
+		gitRepository.CloneUrl,
+		gitRef,
+		gitRepository.IsPrivate,
 	)
 
 	if err != nil {
 		h.eventBus.Publish(ctx, events_pb.EventName_BUILD_FAILED, &events_pb.EventData{
 			Value: &events_pb.EventData_BuildFailedData{
 				BuildFailedData: &events_pb.BuildFailedData{
-					AppId:   data.App.Id,
+					AppId:   app.Id,
 					BuildId: build.Id,
-					AppName: data.App.Name,
+					AppName: app.Name,
 					Reason:  err.Error(),
 				},
 			},
@@ -114,14 +141,15 @@ func (h *EventsHandlers) HandleAppCreatedEvent(ctx context.Context, message *eve
 
 		h.buildRepository.UpdateBuildById(
 			ctx,
-			data.App.Id,
+			app.Id,
 			build.Id,
 			repositories.UpdateBuildParams{Status: models.BuildStatusFailed},
 		)
-		return
+		// the failure is reported with 'build.failed', building again would fail the same way
+		return nil
 	}
 
-	build, err = h.buildRepository.UpdateBuildById(ctx, data.App.Id, build.Id, repositories.UpdateBuildParams{
+	build, err = h.buildRepository.UpdateBuildById(ctx, app.Id, build.Id, repositories.UpdateBuildParams{
 		Status:     buildResult.Status,
 		ImageURL:   buildResult.ImageURL,
 		CommitHash: buildResult.CommitHash,
@@ -129,7 +157,7 @@ func (h *EventsHandlers) HandleAppCreatedEvent(ctx context.Context, message *eve
 	if err != nil {
 		h.logger.LogError("Failed to update build status.")
 		span.SetAttributes(attribute.String("error", err.Error()))
-		return
+		return err
 	}
 
 	h.logger.LogInfo("Publishing 'build.completed' event...")
@@ -137,21 +165,24 @@ func (h *EventsHandlers) HandleAppCreatedEvent(ctx context.Context, message *eve
 		Value: &events_pb.EventData_BuildCompletedData{
 			BuildCompletedData: &events_pb.BuildCompletedData{
 				ImageUrl:   buildResult.ImageURL,
-				AppName:    data.App.Name,
-				AppId:      data.App.Id,
+				AppName:    app.Name,
+				AppId:      app.Id,
 				BuildId:    build.Id,
-				DomainName: data.App.DomainName,
+				DomainName: app.DomainName,
+				CommitHash: buildResult.CommitHash,
 			},
 		},
 	})
 	if err != nil {
 		h.logger.LogError(err.Error())
 		span.SetAttributes(attribute.String("error", err.Error()))
-		return
+		return err
 	}
+
+	return nil
 }
 
-func (h *EventsHandlers) HandleAppDeletedEvent(ctx context.Context, message *events_pb.Message) {
+func (h *EventsHandlers) HandleAppDeletedEvent(ctx context.Context, message *events_pb.Message) error {
 	h.logger.LogInfo("Handle 'app.deleted' event")
 	span := trace.SpanFromContext(ctx)
 
@@ -159,7 +190,7 @@ func (h *EventsHandlers) HandleAppDeletedEvent(ctx context.Context, message *eve
 	if data == nil {
 		h.logger.LogError("Invalid app deleted message")
 		span.SetAttributes(attribute.String("error", "Invalid app deleted message"))
-		return
+		return messaging.ErrInvalidMessage
 	}
 
 	span.SetAttributes(attribute.String("app.id", data.AppId))
@@ -169,7 +200,7 @@ func (h *EventsHandlers) HandleAppDeletedEvent(ctx context.Context, message *eve
 	if err != nil {
 		h.logger.LogError(err.Error())
 		span.SetAttributes(attribute.String("error", err.Error()))
-		return
+		return err
 	}
 
 	// FIXME: find a safe way to do this.
@@ -177,6 +208,8 @@ func (h *EventsHandlers) HandleAppDeletedEvent(ctx context.Context, message *eve
 	if err != nil {
 		h.logger.LogError(err.Error())
 		span.SetAttributes(attribute.String("error", err.Error()))
-		return
+		return err
 	}
+
+	return nil
 }
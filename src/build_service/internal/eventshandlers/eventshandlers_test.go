@@ -0,0 +1,180 @@
+package eventshandlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"apps-hosting.com/buildservice/internal/models"
+	"apps-hosting.com/buildservice/internal/repomanager"
+	"apps-hosting.com/buildservice/internal/repositories"
+	"apps-hosting.com/buildservice/proto/user_service_pb"
+	"apps-hosting.com/logging"
+	"apps-hosting.com/messaging"
+	"apps-hosting.com/messaging/proto/events_pb"
+	"apps-hosting.com/messaging/proto/models_pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeBuildRepository keeps the builds in memory, it fails the first builds created with failure.
+type fakeBuildRepository struct {
+	repositories.BuildRepositoryInterface
+
+	failures int
+	failure  error
+	calls    int
+	builds   map[string]*models.Build
+}
+
+func (r *fakeBuildRepository) CreateBuild(ctx context.Context, appId string, createBuildParams repositories.CreateBuildParams) (*models.Build, error) {
+	r.calls++
+	if r.failures > 0 {
+		r.failures--
+		return nil, r.failure
+	}
+
+	build := &models.Build{
+		Id:     fmt.Sprintf("build-%d", len(r.builds)+1),
+		AppId:  appId,
+		Status: createBuildParams.Status,
+	}
+	r.builds[build.Id] = build
+	return build, nil
+}
+
+func (r *fakeBuildRepository) UpdateBuildById(ctx context.Context, appId, buildId string, updateBuildParams repositories.UpdateBuildParams) (*models.Build, error) {
+	build, ok := r.builds[buildId]
+	if !ok {
+		return nil, errors.New("build not found")
+	}
+
+	build.Status = updateBuildParams.Status
+	return build, nil
+}
+
+// unavailableUserServiceClient fails every build before the repository is cloned.
+type unavailableUserServiceClient struct {
+	user_service_pb.UserServiceClient
+}
+
+func (c unavailableUserServiceClient) GetGithubUserAccessToken(ctx context.Context, in *user_service_pb.GetGithubUserAccessTokenRequest, opts ...grpc.CallOption) (*user_service_pb.GetGithubUserAccessTokenRespone, error) {
+	return nil, status.Error(codes.Unavailable, "user service unavailable")
+}
+
+func TestHandleAppCreatedEvent(t *testing.T) {
+	appCreatedData := &events_pb.EventData{
+		Value: &events_pb.EventData_AppCreatedData{
+			AppCreatedData: &events_pb.AppCreatedEventData{
+				UserId:        "user-1",
+				App:           &models_pb.App{Id: "app-1", Name: "web", ProjectId: "project-1"},
+				GitRepository: &models_pb.GitRepository{Id: "repository-1", CloneUrl: "https://github.com/user/web.git"},
+			},
+		},
+	}
+	transientFailure := errors.New("connection reset by peer")
+
+	tests := []struct {
+		name                string
+		data                *events_pb.EventData
+		failures            int
+		expectedCalls       int
+		expectedBuildFailed int
+		expectedDeliveries  uint64
+	}{
+		{
+			name:                "failed build reported once, without a delivery again",
+			data:                appCreatedData,
+			expectedCalls:       1,
+			expectedBuildFailed: 1,
+		},
+		{
+			name:                "delivered again while the database fails",
+			data:                appCreatedData,
+			failures:            2,
+			expectedCalls:       3,
+			expectedBuildFailed: 1,
+		},
+		{
+			name:               "dead-lettered once every delivery failed",
+			data:               appCreatedData,
+			failures:           messaging.DefaultMaxDeliver,
+			expectedCalls:      messaging.DefaultMaxDeliver,
+			expectedDeliveries: messaging.DefaultMaxDeliver,
+		},
+		{
+			name: "invalid message dead-lettered right away",
+			data: &events_pb.EventData{
+				Value: &events_pb.EventData_AppDeletedData{
+					AppDeletedData: &events_pb.AppDeletedEventData{AppId: "app-1"},
+				},
+			},
+			expectedDeliveries: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buildRepository := &fakeBuildRepository{
+				failures: test.failures,
+				failure:  transientFailure,
+				builds:   map[string]*models.Build{},
+			}
+
+			eventBus := messaging.NewMemoryBus("build-service")
+			eventsHandlers := NewEventsHandlers(
+				eventBus,
+				nil,
+				repomanager.NewGitRepoManager(),
+				buildRepository,
+				unavailableUserServiceClient{},
+				logging.NewServiceLogger(logging.ServiceBuild),
+			)
+
+			err := eventBus.Subscribe(events_pb.EventName_APP_CREATED, eventsHandlers.HandleAppCreatedEvent)
+			if err != nil {
+				t.Fatalf("Subscribe: %v", err)
+			}
+
+			err = eventBus.Publish(context.Background(), events_pb.EventName_APP_CREATED, test.data)
+			if err != nil {
+				t.Fatalf("Publish: %v", err)
+			}
+
+			if buildRepository.calls != test.expectedCalls {
+				t.Errorf("build created %d times, expected %d", buildRepository.calls, test.expectedCalls)
+			}
+
+			buildFailed := eventBus.Published(events_pb.EventName_BUILD_FAILED)
+			if len(buildFailed) != test.expectedBuildFailed {
+				t.Fatalf("%d build.failed events, expected %d", len(buildFailed), test.expectedBuildFailed)
+			}
+			for _, message := range buildFailed {
+				build := buildRepository.builds[message.Data.GetBuildFailedData().BuildId]
+				if build == nil || build.Status != models.BuildStatusFailed {
+					t.Errorf("build of build.failed is %+v, expected a failed build", build)
+				}
+			}
+
+			deadLetters := eventBus.DeadLetters()
+			if test.expectedDeliveries == 0 {
+				if len(deadLetters) != 0 {
+					t.Errorf("%d dead letters, expected none", len(deadLetters))
+				}
+				return
+			}
+
+			if len(deadLetters) != 1 {
+				t.Fatalf("%d dead letters, expected 1", len(deadLetters))
+			}
+			if deadLetters[0].Deliveries != test.expectedDeliveries {
+				t.Errorf("dead-lettered after %d deliveries, expected %d", deadLetters[0].Deliveries, test.expectedDeliveries)
+			}
+			if deadLetters[0].Consumer != "build-service-app-created" {
+				t.Errorf("dead-lettered by %q, expected build-service-app-created", deadLetters[0].Consumer)
+			}
+		})
+	}
+}
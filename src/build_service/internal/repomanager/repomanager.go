@@ -7,6 +7,7 @@ import (
 	"apps-hosting.com/logging"
 
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
 	"github.com/google/uuid"
 )
@@ -24,7 +25,8 @@ func NewGitRepoManager() GitRepoManager {
 	return GitRepoManager{}
 }
 
-func (gitRepoManager *GitRepoManager) Clone(repoURL string, isPrivateRepo bool, userAccessToken string, userAppLogger logging.UserAppLogger) (*GitRepo, error) {
+// Clone checks out gitRef, or the default branch when it is empty.
+func (gitRepoManager *GitRepoManager) Clone(repoURL, gitRef string, isPrivateRepo bool, userAccessToken string, userAppLogger logging.UserAppLogger) (*GitRepo, error) {
 	repoId := uuid.New().String()
 	localPath := fmt.Sprintf("/shared/repos/%s", repoId)
 
@@ -36,12 +38,20 @@ func (gitRepoManager *GitRepoManager) Clone(repoURL string, isPrivateRepo bool,
 	if isPrivateRepo {
 		auth = &http.TokenAuth{Token: userAccessToken}
 	}
-	userAppLogger.LogInfo(fmt.Sprintf("Cloning %s into %s...", repoURL, localPath))
-	repo, err := git.PlainClone(localPath, false, &git.CloneOptions{
+	cloneOptions := &git.CloneOptions{
 		URL:      repoURL,
 		Progress: userAppLogger,
 		Auth:     auth,
-	})
+	}
+	if len(gitRef) != 0 {
+		cloneOptions.ReferenceName = plumbing.ReferenceName(gitRef)
+		cloneOptions.SingleBranch = true
+		userAppLogger.LogInfo(fmt.Sprintf("Cloning %s at %s into %s...", repoURL, gitRef, localPath))
+	} else {
+		userAppLogger.LogInfo(fmt.Sprintf("Cloning %s into %s...", repoURL, localPath))
+	}
+
+	repo, err := git.PlainClone(localPath, false, cloneOptions)
 
 	if err != nil {
 		userAppLogger.LogError(err.Error())
@@ -23,6 +23,14 @@ type UpdateBuildParams struct {
 	CommitHash string
 }
 
+type BuildRepositoryInterface interface {
+	CreateBuildsTable() (sql.Result, error)
+	CreateBuild(ctx context.Context, appId string, createBuildParams CreateBuildParams) (*models.Build, error)
+	UpdateBuildById(ctx context.Context, appId, buildId string, updateBuildParams UpdateBuildParams) (*models.Build, error)
+	GetBuilds(ctx context.Context, appId string) ([]models.Build, error)
+	DeleteBuilds(ctx context.Context, appId string) error
+}
+
 type BuildRepository struct {
 	Database *bun.DB
 	Logger   logging.ServiceLogger
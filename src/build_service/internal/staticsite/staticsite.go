@@ -0,0 +1,226 @@
+package staticsite
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// ConfigFileName is the optional file, at the root of the repository, holding the routing rules.
+const ConfigFileName = "static.json"
+
+// NginxConfigFileName is the generated nginx config, the static site Dockerfile copies it into the image.
+const NginxConfigFileName = "apps-hosting.nginx.conf"
+
+const nginxConfigTemplatePath = "assets/nginx/default.conf.tmpl"
+
+var (
+	sourcePattern      = regexp.MustCompile(`^/[A-Za-z0-9._~\-/]*(\*)?$`)
+	destinationPattern = regexp.MustCompile(`^(https?://[A-Za-z0-9.\-:]+)?/[A-Za-z0-9._~\-/]*(\*)?$`)
+	headerNamePattern  = regexp.MustCompile(`^[A-Za-z0-9\-]+$`)
+
+	redirectStatuses = []int{301, 302, 307, 308}
+)
+
+type HeaderRule struct {
+	Source  string            `json:"source"`
+	Headers map[string]string `json:"headers"`
+}
+
+type RedirectRule struct {
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	Status      int    `json:"status"`
+}
+
+type Config struct {
+	// SPA serves index.html for unknown paths, it is enabled unless set to false.
+	SPA       *bool          `json:"spa"`
+	Headers   []HeaderRule   `json:"headers"`
+	Redirects []RedirectRule `json:"redirects"`
+}
+
+type header struct {
+	Name  string
+	Value string
+}
+
+type pathHeaders struct {
+	Location string
+	Headers  []header
+}
+
+type redirect struct {
+	Location    string
+	Destination string
+	Status      int
+}
+
+type nginxConfig struct {
+	Fallback      string
+	GlobalHeaders []header
+	PathHeaders   []pathHeaders
+	Redirects     []redirect
+}
+
+// LoadConfig reads the routing rules of the repository, a missing file means the defaults.
+func LoadConfig(repoPath string) (*Config, error) {
+	config := Config{}
+
+	content, err := os.ReadFile(filepath.Join(repoPath, ConfigFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return &config, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", ConfigFileName, err)
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", ConfigFileName, err)
+	}
+
+	return &config, nil
+}
+
+// Validate rejects anything that could break out of the generated nginx directives.
+func (config *Config) Validate() error {
+	for _, rule := range config.Headers {
+		if !sourcePattern.MatchString(rule.Source) {
+			return fmt.Errorf("invalid header source '%s'", rule.Source)
+		}
+
+		for name, value := range rule.Headers {
+			if !headerNamePattern.MatchString(name) {
+				return fmt.Errorf("invalid header name '%s'", name)
+			}
+
+			if strings.ContainsAny(value, "\"\\\r\n$") {
+				return fmt.Errorf("invalid value for header '%s'", name)
+			}
+		}
+	}
+
+	for _, rule := range config.Redirects {
+		if !sourcePattern.MatchString(rule.Source) {
+			return fmt.Errorf("invalid redirect source '%s'", rule.Source)
+		}
+
+		if !destinationPattern.MatchString(rule.Destination) {
+			return fmt.Errorf("invalid redirect destination '%s'", rule.Destination)
+		}
+
+		if strings.HasSuffix(rule.Destination, "*") && !strings.HasSuffix(rule.Source, "*") {
+			return fmt.Errorf("redirect destination '%s' uses a wildcard missing from its source", rule.Destination)
+		}
+
+		status := rule.Status
+		if status == 0 {
+			status = 301
+		}
+
+		valid := false
+		for _, redirectStatus := range redirectStatuses {
+			valid = valid || status == redirectStatus
+		}
+		if !valid {
+			return fmt.Errorf("unsupported redirect status %d", rule.Status)
+		}
+	}
+
+	return nil
+}
+
+// WriteNginxConfig renders the nginx config of the site into the repository.
+func WriteNginxConfig(repoPath string, config *Config) error {
+	nginxConfigTemplate, err := template.ParseFiles(nginxConfigTemplatePath)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(filepath.Join(repoPath, NginxConfigFileName))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return nginxConfigTemplate.Execute(file, config.toNginxConfig())
+}
+
+func (config *Config) toNginxConfig() nginxConfig {
+	nginxConfig := nginxConfig{Fallback: "/index.html"}
+	if config.SPA != nil && !*config.SPA {
+		nginxConfig.Fallback = "=404"
+	}
+
+	for _, rule := range config.Headers {
+		headers := sortedHeaders(rule.Headers)
+		if rule.Source == "/*" {
+			nginxConfig.GlobalHeaders = append(nginxConfig.GlobalHeaders, headers...)
+			continue
+		}
+
+		nginxConfig.PathHeaders = append(nginxConfig.PathHeaders, pathHeaders{
+			Location: toLocation(rule.Source),
+			Headers:  headers,
+		})
+	}
+
+	// add_header inside a location drops the ones inherited from the server block.
+	for i := range nginxConfig.PathHeaders {
+		headers := make([]header, 0, len(nginxConfig.GlobalHeaders)+len(nginxConfig.PathHeaders[i].Headers))
+		headers = append(headers, nginxConfig.GlobalHeaders...)
+		nginxConfig.PathHeaders[i].Headers = append(headers, nginxConfig.PathHeaders[i].Headers...)
+	}
+
+	for _, rule := range config.Redirects {
+		status := rule.Status
+		if status == 0 {
+			status = 301
+		}
+
+		destination := rule.Destination
+		if strings.HasSuffix(destination, "*") {
+			destination = strings.TrimSuffix(destination, "*") + "$1"
+		}
+
+		nginxConfig.Redirects = append(nginxConfig.Redirects, redirect{
+			Location:    toLocation(rule.Source),
+			Destination: destination + "$is_args$args",
+			Status:      status,
+		})
+	}
+
+	return nginxConfig
+}
+
+// toLocation maps "/path" to an exact match and "/path/*" to a regex capturing the rest of the path.
+func toLocation(source string) string {
+	if strings.HasSuffix(source, "*") {
+		return "~ ^" + regexp.QuoteMeta(strings.TrimSuffix(source, "*")) + "(.*)$"
+	}
+
+	return "= " + source
+}
+
+func sortedHeaders(values map[string]string) []header {
+	headers := make([]header, 0, len(values))
+	for name, value := range values {
+		headers = append(headers, header{Name: name, Value: value})
+	}
+
+	sort.Slice(headers, func(i, j int) bool {
+		return headers[i].Name < headers[j].Name
+	})
+
+	return headers
+}
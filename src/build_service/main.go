@@ -16,6 +16,7 @@ import (
 	"apps-hosting.com/buildservice/proto/user_service_pb"
 	"apps-hosting.com/logging"
 	"apps-hosting.com/messaging"
+	"apps-hosting.com/messaging/dedup"
 	"apps-hosting.com/messaging/proto/events_pb"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
@@ -56,15 +57,7 @@ func main() {
 	}
 
 	natsURL := os.Getenv("NATS_URL")
-	eventBus, err := messaging.NewEventBus(
-		serviceName,
-		natsURL,
-		events_pb.StreamName_BUILD_STREAM,
-		[]events_pb.EventName{
-			events_pb.EventName_BUILD_COMPLETED,
-			events_pb.EventName_BUILD_FAILED,
-		},
-	)
+	eventBus, err := messaging.NewEventBus(serviceName, natsURL, messaging.BuildStream)
 	if err != nil {
 		panic(err)
 	}
@@ -87,20 +80,32 @@ func main() {
 	kanikoExecutor := buildexecutor.NewKanikoExecutor(clientset, logger)
 	gitRepoManager := repomanager.NewGitRepoManager()
 
+	deduplicator := dedup.NewDeduplicator(database)
+	err = deduplicator.CreateProcessedMessagesTable(ctx)
+	if err != nil {
+		logger.LogError(err.Error())
+		return
+	}
+	go deduplicator.Run(ctx)
+
 	eventsHandlers := eventshandlers.NewEventsHandlers(
-		*eventBus,
+		eventBus,
 		&kanikoExecutor,
 		gitRepoManager,
-		buildRepository,
+		&buildRepository,
 		userServiceClient,
 		logger,
 	)
 
-	err = eventBus.Subscribe(events_pb.EventName_APP_CREATED, eventsHandlers.HandleAppCreatedEvent)
+	err = eventBus.Subscribe(events_pb.EventName_APP_CREATED, deduplicator.Idempotent(eventsHandlers.HandleAppCreatedEvent))
 	if err != nil {
 		logger.LogErrorF("failed to subscribe to '%s': %v", events_pb.EventName_name[int32(events_pb.EventName_APP_CREATED)], err)
 	}
-	err = eventBus.Subscribe(events_pb.EventName_APP_DELETED, eventsHandlers.HandleAppDeletedEvent)
+	err = eventBus.Subscribe(events_pb.EventName_APP_BUILD_REQUESTED, deduplicator.Idempotent(eventsHandlers.HandleAppBuildRequestedEvent))
+	if err != nil {
+		logger.LogErrorF("failed to subscribe to '%s': %v", events_pb.EventName_name[int32(events_pb.EventName_APP_BUILD_REQUESTED)], err)
+	}
+	err = eventBus.Subscribe(events_pb.EventName_APP_DELETED, deduplicator.Idempotent(eventsHandlers.HandleAppDeletedEvent))
 	if err != nil {
 		logger.LogErrorF("failed to subscribe to '%s': %v", events_pb.EventName_name[int32(events_pb.EventName_APP_DELETED)], err)
 	}
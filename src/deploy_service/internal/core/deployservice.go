@@ -2,25 +2,37 @@ package core
 
 import (
 	"context"
+	"time"
 
+	"apps-hosting.com/deployservice/internal/deployer"
+	"apps-hosting.com/deployservice/internal/eventshandlers"
+	"apps-hosting.com/deployservice/internal/models"
 	"apps-hosting.com/deployservice/internal/repositories"
 	"apps-hosting.com/deployservice/proto/deploy_service_pb"
+	"apps-hosting.com/messaging"
+	"apps-hosting.com/messaging/proto/events_pb"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// how many events of an app wait for a slow WatchAppEvents client
+const watchedEventsBuffer = 64
+
 type GRPCDeployServiceServer struct {
 	deploy_service_pb.UnimplementedDeployServiceServer
 
 	deploymentRepository repositories.DeploymentRepository
+	eventBus             messaging.Bus
 }
 
-func NewGRPCDeployServiceServer(deploymentRepository repositories.DeploymentRepository) *GRPCDeployServiceServer {
+func NewGRPCDeployServiceServer(deploymentRepository repositories.DeploymentRepository, eventBus messaging.Bus) *GRPCDeployServiceServer {
 	return &GRPCDeployServiceServer{
 		deploymentRepository: deploymentRepository,
+		eventBus:             eventBus,
 	}
 }
 
@@ -54,3 +66,287 @@ func (server *GRPCDeployServiceServer) GetDeployments(ctx context.Context, getDe
 		Deployments: _deployments,
 	}, nil
 }
+
+func (server *GRPCDeployServiceServer) PromoteDeployment(ctx context.Context, promoteDeploymentRequest *deploy_service_pb.PromoteDeploymentRequest) (*deploy_service_pb.PromoteDeploymentResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.String("app.id", promoteDeploymentRequest.AppId))
+
+	deployment, err := server.endCanaryDeployment(ctx, promoteDeploymentRequest.AppId, models.DeploymentStatusSuccessed)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.String("deployment.id", deployment.Id))
+
+	return &deploy_service_pb.PromoteDeploymentResponse{
+		Deployment: DeploymentToProto(deployment),
+	}, nil
+}
+
+func (server *GRPCDeployServiceServer) AbortDeployment(ctx context.Context, abortDeploymentRequest *deploy_service_pb.AbortDeploymentRequest) (*deploy_service_pb.AbortDeploymentResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.String("app.id", abortDeploymentRequest.AppId))
+
+	deployment, err := server.endCanaryDeployment(ctx, abortDeploymentRequest.AppId, models.DeploymentStatusAborted)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.String("deployment.id", deployment.Id))
+
+	return &deploy_service_pb.AbortDeploymentResponse{
+		Deployment: DeploymentToProto(deployment),
+	}, nil
+}
+
+func (server *GRPCDeployServiceServer) GetAppRuntimeStatus(ctx context.Context, getAppRuntimeStatusRequest *deploy_service_pb.GetAppRuntimeStatusRequest) (*deploy_service_pb.GetAppRuntimeStatusResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.String("app.id", getAppRuntimeStatusRequest.AppId))
+
+	kubernetesClient, err := eventshandlers.NewKubernetesClient()
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	runtimeDeployer := deployer.NewDeployer(kubernetesClient)
+	runtimeStatus, err := runtimeDeployer.GetRuntimeStatus(getAppRuntimeStatusRequest.AppId)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	span.SetAttributes(
+		attribute.Int("replicas.ready", int(runtimeStatus.ReadyReplicas)),
+		attribute.Int("pods.count", len(runtimeStatus.Pods)),
+	)
+
+	return &deploy_service_pb.GetAppRuntimeStatusResponse{
+		Status: RuntimeStatusToProto(getAppRuntimeStatusRequest.AppId, runtimeStatus),
+	}, nil
+}
+
+func (server *GRPCDeployServiceServer) GetAppInstances(ctx context.Context, getAppInstancesRequest *deploy_service_pb.GetAppInstancesRequest) (*deploy_service_pb.GetAppInstancesResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.String("app.id", getAppInstancesRequest.AppId))
+
+	kubernetesClient, err := eventshandlers.NewKubernetesClient()
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	instancesDeployer := deployer.NewDeployer(kubernetesClient)
+	instances, err := instancesDeployer.GetInstances(getAppInstancesRequest.AppId)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	events, err := instancesDeployer.GetEvents(getAppInstancesRequest.AppId)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	span.SetAttributes(
+		attribute.Int("instances.count", len(instances)),
+		attribute.Int("events.count", len(events)),
+	)
+
+	return &deploy_service_pb.GetAppInstancesResponse{
+		Instances: InstancesToProto(instances, time.Now()),
+		Events:    AppEventsToProto(events),
+	}, nil
+}
+
+func (server *GRPCDeployServiceServer) RestartApp(ctx context.Context, restartAppRequest *deploy_service_pb.RestartAppRequest) (*deploy_service_pb.RestartAppResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("app.id", restartAppRequest.AppId),
+		attribute.String("user.id", restartAppRequest.UserId),
+	)
+
+	kubernetesClient, err := eventshandlers.NewKubernetesClient()
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	restartDeployer := deployer.NewDeployer(kubernetesClient)
+	err = restartDeployer.Restart(restartAppRequest.AppId, time.Now())
+	if err == deployer.ErrAppNotDeployed {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	deployment, err := server.recordRestart(ctx, restartAppRequest.AppId, repositories.CreateDeploymentParams{
+		Kind:        models.DeploymentKindRestart,
+		TriggeredBy: restartAppRequest.UserId,
+	})
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	span.SetAttributes(attribute.String("deployment.id", deployment.Id))
+
+	return &deploy_service_pb.RestartAppResponse{
+		Deployment: DeploymentToProto(deployment),
+	}, nil
+}
+
+func (server *GRPCDeployServiceServer) RestartInstance(ctx context.Context, restartInstanceRequest *deploy_service_pb.RestartInstanceRequest) (*deploy_service_pb.RestartInstanceResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("app.id", restartInstanceRequest.AppId),
+		attribute.String("instance.name", restartInstanceRequest.InstanceName),
+		attribute.String("user.id", restartInstanceRequest.UserId),
+	)
+
+	kubernetesClient, err := eventshandlers.NewKubernetesClient()
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	restartDeployer := deployer.NewDeployer(kubernetesClient)
+	err = restartDeployer.RestartInstance(restartInstanceRequest.AppId, restartInstanceRequest.InstanceName)
+	if err == deployer.ErrInstanceNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	deployment, err := server.recordRestart(ctx, restartInstanceRequest.AppId, repositories.CreateDeploymentParams{
+		Kind:         models.DeploymentKindInstanceRestart,
+		TriggeredBy:  restartInstanceRequest.UserId,
+		InstanceName: restartInstanceRequest.InstanceName,
+	})
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	span.SetAttributes(attribute.String("deployment.id", deployment.Id))
+
+	return &deploy_service_pb.RestartInstanceResponse{
+		Deployment: DeploymentToProto(deployment),
+	}, nil
+}
+
+// WatchAppEvents streams the events about the app published from now on, until the client goes away.
+func (server *GRPCDeployServiceServer) WatchAppEvents(watchAppEventsRequest *deploy_service_pb.WatchAppEventsRequest, stream grpc.ServerStreamingServer[deploy_service_pb.WatchAppEventsResponse]) error {
+	span := trace.SpanFromContext(stream.Context())
+
+	span.SetAttributes(
+		attribute.String("app.id", watchAppEventsRequest.AppId),
+		attribute.String("user.id", watchAppEventsRequest.UserId),
+	)
+
+	// the NATS callbacks must not wait for the client, an event is dropped when it falls too far behind
+	events := make(chan *events_pb.Message, watchedEventsBuffer)
+	stopWatching, err := server.eventBus.Watch(watchedEvents(), func(ctx context.Context, message *events_pb.Message) error {
+		if messaging.AppId(message) != watchAppEventsRequest.AppId {
+			return nil
+		}
+
+		select {
+		case events <- message:
+		default:
+		}
+		return nil
+	})
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer stopWatching()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case message := <-events:
+			event, err := EventToProto(message)
+			if err != nil {
+				span.SetAttributes(attribute.String("error", err.Error()))
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			err = stream.Send(event)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// recordRestart adds the restart to the deployments of the app, it keeps the build and the image of the
+// last successful deployment since the app still runs them.
+func (server *GRPCDeployServiceServer) recordRestart(ctx context.Context, appId string, createDeploymentParams repositories.CreateDeploymentParams) (*models.Deployment, error) {
+	buildId := ""
+	latestDeployment, err := server.deploymentRepository.GetLatestDeployment(ctx, appId, models.DeploymentStatusSuccessed)
+	if err != nil && err != repositories.ErrDeploymentNotFound {
+		return nil, err
+	}
+	if latestDeployment != nil {
+		buildId = latestDeployment.BuildId
+		createDeploymentParams.ImageURL = latestDeployment.ImageURL
+	}
+
+	createDeploymentParams.Status = models.DeploymentStatusSuccessed
+	return server.deploymentRepository.CreateDeployment(ctx, buildId, appId, createDeploymentParams)
+}
+
+// endCanaryDeployment promotes the canary deployment of the app when deploymentStatus is successed and
+// aborts it otherwise, then records the outcome.
+func (server *GRPCDeployServiceServer) endCanaryDeployment(ctx context.Context, appId string, deploymentStatus models.DeploymentStatus) (*models.Deployment, error) {
+	deployment, err := server.deploymentRepository.GetLatestDeployment(ctx, appId, models.DeploymentStatusCanary)
+	if err == repositories.ErrDeploymentNotFound {
+		return nil, status.Error(codes.FailedPrecondition, deployer.ErrNoCanaryDeployment.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	kubernetesClient, err := eventshandlers.NewKubernetesClient()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	canaryDeployer := deployer.NewDeployer(kubernetesClient)
+	if deploymentStatus == models.DeploymentStatusSuccessed {
+		err = canaryDeployer.PromoteCanary(appId)
+	} else {
+		err = canaryDeployer.AbortCanary(appId)
+	}
+	if err == deployer.ErrNoCanaryDeployment {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	deployment, err = server.deploymentRepository.UpdateDeploymentById(ctx, deployment.Id, repositories.UpdateDeploymentParams{
+		Status: deploymentStatus,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return deployment, nil
+}
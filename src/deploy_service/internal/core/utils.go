@@ -1,17 +1,29 @@
 package core
 
 import (
+	"strings"
+	"time"
+
+	"apps-hosting.com/deployservice/internal/deployer"
 	"apps-hosting.com/deployservice/internal/models"
 	"apps-hosting.com/deployservice/proto/deploy_service_pb"
+	"apps-hosting.com/messaging"
+	"apps-hosting.com/messaging/proto/events_pb"
+
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func DeploymentToProto(deployment *models.Deployment) *deploy_service_pb.Deployment {
 	return &deploy_service_pb.Deployment{
-		Id:        deployment.Id,
-		BuildId:   deployment.BuildId,
-		AppId:     deployment.AppId,
-		Status:    string(deployment.Status),
-		CreatedAt: deployment.CreatedAt.String(),
+		Id:           deployment.Id,
+		BuildId:      deployment.BuildId,
+		AppId:        deployment.AppId,
+		ImageUrl:     deployment.ImageURL,
+		Status:       string(deployment.Status),
+		CreatedAt:    deployment.CreatedAt.String(),
+		Kind:         string(deployment.Kind),
+		TriggeredBy:  deployment.TriggeredBy,
+		InstanceName: deployment.InstanceName,
 	}
 }
 
@@ -23,6 +35,96 @@ func DeploymentListToProto(deployments []models.Deployment) []*deploy_service_pb
 	return _deployments
 }
 
+func RuntimeStatusToProto(appId string, runtimeStatus *deployer.RuntimeStatus) *deploy_service_pb.AppRuntimeStatus {
+	pods := make([]*deploy_service_pb.PodStatus, 0, len(runtimeStatus.Pods))
+	for _, pod := range runtimeStatus.Pods {
+		startedAt := ""
+		if pod.StartedAt != nil {
+			startedAt = pod.StartedAt.Format(time.RFC3339)
+		}
+
+		pods = append(pods, &deploy_service_pb.PodStatus{
+			Name:      pod.Name,
+			Phase:     pod.Phase,
+			Ready:     pod.Ready,
+			Restarts:  pod.Restarts,
+			ImageUrl:  pod.ImageURL,
+			StartedAt: startedAt,
+		})
+	}
+
+	return &deploy_service_pb.AppRuntimeStatus{
+		AppId:             appId,
+		DesiredReplicas:   runtimeStatus.DesiredReplicas,
+		ReadyReplicas:     runtimeStatus.ReadyReplicas,
+		AvailableReplicas: runtimeStatus.AvailableReplicas,
+		UpdatedReplicas:   runtimeStatus.UpdatedReplicas,
+		Pods:              pods,
+	}
+}
+
+func InstancesToProto(instances []deployer.Instance, now time.Time) []*deploy_service_pb.Instance {
+	_instances := make([]*deploy_service_pb.Instance, 0, len(instances))
+	for _, instance := range instances {
+		_instances = append(_instances, &deploy_service_pb.Instance{
+			Name:                    instance.Name,
+			Phase:                   instance.Phase,
+			Ready:                   instance.Ready,
+			Restarts:                instance.Restarts,
+			LastTerminationReason:   instance.LastTerminationReason,
+			LastTerminationExitCode: instance.LastTerminationExitCode,
+			NodeName:                instance.NodeName,
+			CreatedAt:               instance.CreatedAt.Format(time.RFC3339),
+			AgeSeconds:              int64(now.Sub(instance.CreatedAt).Seconds()),
+		})
+	}
+	return _instances
+}
+
+func AppEventsToProto(events []deployer.AppEvent) []*deploy_service_pb.AppEvent {
+	_events := make([]*deploy_service_pb.AppEvent, 0, len(events))
+	for _, event := range events {
+		_events = append(_events, &deploy_service_pb.AppEvent{
+			Type:       event.Type,
+			Reason:     event.Reason,
+			Message:    event.Message,
+			ObjectKind: event.ObjectKind,
+			ObjectName: event.ObjectName,
+			Count:      event.Count,
+			LastSeenAt: event.LastSeenAt.Format(time.RFC3339),
+		})
+	}
+	return _events
+}
+
+func EventToProto(message *events_pb.Message) (*deploy_service_pb.WatchAppEventsResponse, error) {
+	data, err := protojson.Marshal(message.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deploy_service_pb.WatchAppEventsResponse{
+		Id:        message.Id,
+		EventName: messaging.Subject(message.EventName),
+		Timestamp: message.Timestamp,
+		Data:      string(data),
+	}, nil
+}
+
+// watchedEvents are the events about a single app, the ones of the app.*, build.* and deploy.* subjects.
+func watchedEvents() []events_pb.EventName {
+	eventNames := []events_pb.EventName{}
+	for value := range events_pb.EventName_name {
+		eventName := events_pb.EventName(value)
+		subject := messaging.Subject(eventName)
+		if strings.HasPrefix(subject, "app.") || strings.HasPrefix(subject, "build.") || strings.HasPrefix(subject, "deploy.") {
+			eventNames = append(eventNames, eventName)
+		}
+	}
+
+	return eventNames
+}
+
 func ExtractDeploymentIDs(deployments []models.Deployment) []string {
 	deploymentIDs := make([]string, 0, len(deployments))
 	for _, deployment := range deployments {
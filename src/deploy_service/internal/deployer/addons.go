@@ -0,0 +1,325 @@
+package deployer
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+
+	v1Apps "k8s.io/api/apps/v1"
+	v1Core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	addOnPasswordKey = "password"
+	addOnURLKey      = "url"
+
+	addOnStorageSize = "1Gi"
+)
+
+type addOnSpec struct {
+	Image    string
+	Port     int32
+	Database string
+	Username string
+	DataPath string
+	Scheme   string
+}
+
+var addOnSpecs = map[AddOnType]addOnSpec{
+	AddOnTypePostgres: {
+		Image:    "postgres:16-alpine",
+		Port:     5432,
+		Database: "app",
+		Username: "app",
+		DataPath: "/var/lib/postgresql/data",
+		Scheme:   "postgres",
+	},
+	AddOnTypeRedis: {
+		Image:    "redis:7-alpine",
+		Port:     6379,
+		Database: "0",
+		Username: "default",
+		DataPath: "/data",
+		Scheme:   "redis",
+	},
+}
+
+// ProvisionAddOn runs the add-on as a single replica StatefulSet behind a headless
+// service. It is safe to call again, the password of an existing add-on is kept.
+//
+// FIXME: add-ons live in the same namespace as the apps until projects get their own.
+func (d *Deployer) ProvisionAddOn(addOnId, projectId string, addOnType AddOnType) (*AddOnConnection, error) {
+	spec, ok := addOnSpecs[addOnType]
+	if !ok {
+		return nil, ErrUnsupportedAddOnType
+	}
+
+	labels := map[string]string{
+		"add_on_id":  addOnId,
+		"project_id": projectId,
+	}
+
+	connection := AddOnConnection{
+		Host:     fmt.Sprintf("%s.%s.svc.cluster.local", ToK8sAddOnName(addOnId), NAMESPACE),
+		Port:     spec.Port,
+		Database: spec.Database,
+		Username: spec.Username,
+	}
+
+	// 1. store the credentials and the connection url in a secret
+	err := d.applyAddOnSecret(addOnId, spec, connection, labels)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. give the pod a stable dns name
+	err = d.applyAddOnService(addOnId, spec, labels)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. run the database with its own volume
+	err = d.applyAddOnStatefulSet(addOnId, addOnType, spec, labels)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connection, nil
+}
+
+// DestroyAddOn removes the add-on with its volumes, the data is lost.
+func (d *Deployer) DestroyAddOn(addOnId string) error {
+	err := d.kubernetesClient.AppsV1().StatefulSets(NAMESPACE).Delete(context.Background(), ToK8sAddOnName(addOnId), metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete statefulset: %w", err)
+	}
+
+	err = d.kubernetesClient.CoreV1().Services(NAMESPACE).Delete(context.Background(), ToK8sAddOnName(addOnId), metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete service: %w", err)
+	}
+
+	// Volume claims created from the StatefulSet template outlive it.
+	err = d.kubernetesClient.CoreV1().PersistentVolumeClaims(NAMESPACE).DeleteCollection(
+		context.Background(),
+		metav1.DeleteOptions{},
+		metav1.ListOptions{LabelSelector: "add_on_id=" + addOnId},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete volume claims: %w", err)
+	}
+
+	err = d.kubernetesClient.CoreV1().Secrets(NAMESPACE).Delete(context.Background(), ToK8sAddOnSecretName(addOnId), metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete secret: %w", err)
+	}
+
+	d.logger.LogInfoF("Add-on %q deleted in namespace %q", addOnId, NAMESPACE)
+	return nil
+}
+
+// ResolveAddOnEnvironment reads the connection url of every linked add-on from its secret.
+func (d *Deployer) ResolveAddOnEnvironment(bindings []AddOnBinding) (map[string]string, error) {
+	envVars := map[string]string{}
+	secretsClient := d.kubernetesClient.CoreV1().Secrets(NAMESPACE)
+
+	for _, binding := range bindings {
+		secret, err := secretsClient.Get(context.Background(), ToK8sAddOnSecretName(binding.AddOnId), metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrAddOnNotProvisioned, binding.AddOnId)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get add-on secret: %w", err)
+		}
+
+		envVars[binding.EnvVarName] = string(secret.Data[addOnURLKey])
+	}
+
+	return envVars, nil
+}
+
+func (d *Deployer) applyAddOnSecret(addOnId string, spec addOnSpec, connection AddOnConnection, labels map[string]string) error {
+	secretsClient := d.kubernetesClient.CoreV1().Secrets(NAMESPACE)
+
+	password := ""
+	existingSecret, err := secretsClient.Get(context.Background(), ToK8sAddOnSecretName(addOnId), metav1.GetOptions{})
+	if err == nil {
+		password = string(existingSecret.Data[addOnPasswordKey])
+	} else if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get add-on secret: %w", err)
+	}
+
+	if len(password) == 0 {
+		password, err = generateAddOnPassword()
+		if err != nil {
+			return err
+		}
+	}
+
+	connectionURL := url.URL{
+		Scheme: spec.Scheme,
+		User:   url.UserPassword(connection.Username, password),
+		Host:   fmt.Sprintf("%s:%d", connection.Host, connection.Port),
+		Path:   "/" + connection.Database,
+	}
+
+	secretObject := v1Core.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ToK8sAddOnSecretName(addOnId),
+			Namespace: NAMESPACE,
+			Labels:    labels,
+		},
+		Type: v1Core.SecretTypeOpaque,
+		StringData: map[string]string{
+			addOnPasswordKey: password,
+			addOnURLKey:      connectionURL.String(),
+		},
+	}
+
+	_, err = secretsClient.Create(context.Background(), &secretObject, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		_, err = secretsClient.Update(context.Background(), &secretObject, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to apply add-on secret: %w", err)
+	}
+
+	d.logger.LogInfoF("Secret %q applied in namespace %q", secretObject.Name, NAMESPACE)
+	return nil
+}
+
+func (d *Deployer) applyAddOnService(addOnId string, spec addOnSpec, labels map[string]string) error {
+	serviceObject := v1Core.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ToK8sAddOnName(addOnId),
+			Namespace: NAMESPACE,
+			Labels:    labels,
+		},
+		Spec: v1Core.ServiceSpec{
+			ClusterIP: v1Core.ClusterIPNone,
+			Selector:  labels,
+			Ports: []v1Core.ServicePort{
+				{
+					Port:       spec.Port,
+					TargetPort: intstr.FromInt32(spec.Port),
+					Protocol:   v1Core.ProtocolTCP,
+				},
+			},
+		},
+	}
+
+	_, err := d.kubernetesClient.CoreV1().Services(NAMESPACE).Create(context.Background(), &serviceObject, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create add-on service: %w", err)
+	}
+
+	d.logger.LogInfoF("Service %q applied in namespace %q", serviceObject.Name, NAMESPACE)
+	return nil
+}
+
+func (d *Deployer) applyAddOnStatefulSet(addOnId string, addOnType AddOnType, spec addOnSpec, labels map[string]string) error {
+	statefulSetObject := d.generateAddOnStatefulSetObject(addOnId, addOnType, spec, labels)
+	statefulSetsClient := d.kubernetesClient.AppsV1().StatefulSets(NAMESPACE)
+
+	_, err := statefulSetsClient.Create(context.Background(), &statefulSetObject, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		_, err = statefulSetsClient.Update(context.Background(), &statefulSetObject, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to apply add-on statefulset: %w", err)
+	}
+
+	d.logger.LogInfoF("StatefulSet %q applied in namespace %q with image: %s", statefulSetObject.Name, NAMESPACE, spec.Image)
+	return nil
+}
+
+func (d *Deployer) generateAddOnStatefulSetObject(addOnId string, addOnType AddOnType, spec addOnSpec, labels map[string]string) v1Apps.StatefulSet {
+	replicas := int32(1)
+	passwordSource := &v1Core.EnvVarSource{
+		SecretKeyRef: &v1Core.SecretKeySelector{
+			LocalObjectReference: v1Core.LocalObjectReference{Name: ToK8sAddOnSecretName(addOnId)},
+			Key:                  addOnPasswordKey,
+		},
+	}
+
+	container := v1Core.Container{
+		Name:  ToK8sAddOnName(addOnId),
+		Image: spec.Image,
+		Ports: []v1Core.ContainerPort{
+			{ContainerPort: spec.Port},
+		},
+		VolumeMounts: []v1Core.VolumeMount{
+			{Name: "data", MountPath: spec.DataPath},
+		},
+	}
+
+	switch addOnType {
+	case AddOnTypePostgres:
+		container.Env = []v1Core.EnvVar{
+			{Name: "POSTGRES_USER", Value: spec.Username},
+			{Name: "POSTGRES_DB", Value: spec.Database},
+			{Name: "POSTGRES_PASSWORD", ValueFrom: passwordSource},
+			// the root of a fresh volume holds lost+found, which initdb refuses
+			{Name: "PGDATA", Value: spec.DataPath + "/pgdata"},
+		}
+	case AddOnTypeRedis:
+		container.Env = []v1Core.EnvVar{
+			{Name: "REDIS_PASSWORD", ValueFrom: passwordSource},
+		}
+		container.Args = []string{"--requirepass", "$(REDIS_PASSWORD)", "--appendonly", "yes"}
+	}
+
+	return v1Apps.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ToK8sAddOnName(addOnId),
+			Namespace: NAMESPACE,
+			Labels:    labels,
+		},
+		Spec: v1Apps.StatefulSetSpec{
+			Replicas:    &replicas,
+			ServiceName: ToK8sAddOnName(addOnId),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: v1Core.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: v1Core.PodSpec{
+					Containers: []v1Core.Container{container},
+				},
+			},
+			VolumeClaimTemplates: []v1Core.PersistentVolumeClaim{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "data",
+						Labels: labels,
+					},
+					Spec: v1Core.PersistentVolumeClaimSpec{
+						AccessModes: []v1Core.PersistentVolumeAccessMode{v1Core.ReadWriteOnce},
+						Resources: v1Core.VolumeResourceRequirements{
+							Requests: v1Core.ResourceList{
+								v1Core.ResourceStorage: resource.MustParse(addOnStorageSize),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func generateAddOnPassword() (string, error) {
+	bytes := make([]byte, 24)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("failed to generate add-on password: %w", err)
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
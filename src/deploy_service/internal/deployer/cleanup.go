@@ -0,0 +1,154 @@
+package deployer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"apps-hosting.com/messaging/platform"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Destroy removes every resource of the app. It goes through all of them even when some fail and
+// returns the errors together, resources that are already gone are skipped so it can be called again.
+func (d *Deployer) Destroy(appId string) error {
+	ctx := context.Background()
+	propagationPolicy := metav1.DeletePropagationBackground
+	deleteOptions := metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}
+	listOptions := metav1.ListOptions{LabelSelector: "app_id=" + appId}
+
+	errs := []error{}
+	collect := func(resource string, err error) {
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to delete %s: %w", resource, err))
+		}
+	}
+
+	// the traffic is cut first
+	collect("ingresses", d.kubernetesClient.NetworkingV1().Ingresses(NAMESPACE).DeleteCollection(ctx, deleteOptions, listOptions))
+	if d.platform.RoutingBackend == platform.RoutingBackendGatewayAPI {
+		collect("http routes", d.deleteHTTPRoutes(ctx, listOptions.LabelSelector))
+	}
+	collect("services", d.deleteServices(ctx, listOptions))
+	collect("horizontal pod autoscalers", d.kubernetesClient.AutoscalingV2().HorizontalPodAutoscalers(NAMESPACE).DeleteCollection(ctx, deleteOptions, listOptions))
+	collect("deployments", d.kubernetesClient.AppsV1().Deployments(NAMESPACE).DeleteCollection(ctx, deleteOptions, listOptions))
+	collect("cron jobs", d.kubernetesClient.BatchV1().CronJobs(NAMESPACE).DeleteCollection(ctx, deleteOptions, listOptions))
+	collect("jobs", d.kubernetesClient.BatchV1().Jobs(NAMESPACE).DeleteCollection(ctx, deleteOptions, listOptions))
+	collect("secrets", d.kubernetesClient.CoreV1().Secrets(NAMESPACE).DeleteCollection(ctx, deleteOptions, listOptions))
+
+	// the disk is kept for its grace period, the janitor deletes it afterwards
+	err := d.releaseDisk(appId)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	d.logger.LogInfoF("Resources of app %q deleted in namespace %q", appId, NAMESPACE)
+	return nil
+}
+
+// AppIds returns the ids of the apps that have resources in the cluster.
+func (d *Deployer) AppIds() ([]string, error) {
+	ctx := context.Background()
+	listOptions := metav1.ListOptions{LabelSelector: "app_id"}
+
+	listers := map[string]func() (runtime.Object, error){
+		"ingresses": func() (runtime.Object, error) {
+			return d.kubernetesClient.NetworkingV1().Ingresses(NAMESPACE).List(ctx, listOptions)
+		},
+		"services": func() (runtime.Object, error) {
+			return d.kubernetesClient.CoreV1().Services(NAMESPACE).List(ctx, listOptions)
+		},
+		"horizontal pod autoscalers": func() (runtime.Object, error) {
+			return d.kubernetesClient.AutoscalingV2().HorizontalPodAutoscalers(NAMESPACE).List(ctx, listOptions)
+		},
+		"deployments": func() (runtime.Object, error) {
+			return d.kubernetesClient.AppsV1().Deployments(NAMESPACE).List(ctx, listOptions)
+		},
+		"cron jobs": func() (runtime.Object, error) {
+			return d.kubernetesClient.BatchV1().CronJobs(NAMESPACE).List(ctx, listOptions)
+		},
+		"jobs": func() (runtime.Object, error) {
+			return d.kubernetesClient.BatchV1().Jobs(NAMESPACE).List(ctx, listOptions)
+		},
+		"secrets": func() (runtime.Object, error) {
+			return d.kubernetesClient.CoreV1().Secrets(NAMESPACE).List(ctx, listOptions)
+		},
+		"disks": func() (runtime.Object, error) {
+			return d.kubernetesClient.CoreV1().PersistentVolumeClaims(NAMESPACE).List(ctx, listOptions)
+		},
+	}
+
+	appIds := map[string]bool{}
+	for resource, list := range listers {
+		objects, err := list()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", resource, err)
+		}
+
+		err = meta.EachListItem(objects, func(object runtime.Object) error {
+			accessor, err := meta.Accessor(object)
+			if err != nil {
+				return err
+			}
+
+			// a released disk waits for the janitor, the app is already destroyed
+			if _, ok := accessor.GetAnnotations()[DiskDeleteAfterAnnotation]; ok {
+				return nil
+			}
+
+			appIds[accessor.GetLabels()["app_id"]] = true
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", resource, err)
+		}
+	}
+
+	if d.platform.RoutingBackend == platform.RoutingBackendGatewayAPI {
+		routes, err := d.listHTTPRoutes(ctx, listOptions.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list http routes: %w", err)
+		}
+
+		for _, route := range routes {
+			appIds[route.Metadata.Labels["app_id"]] = true
+		}
+	}
+
+	ids := []string{}
+	for appId := range appIds {
+		ids = append(ids, appId)
+	}
+	slices.Sort(ids)
+
+	return ids, nil
+}
+
+// deleteServices deletes the services one by one, they can not be deleted as a collection.
+func (d *Deployer) deleteServices(ctx context.Context, listOptions metav1.ListOptions) error {
+	servicesClient := d.kubernetesClient.CoreV1().Services(NAMESPACE)
+
+	services, err := servicesClient.List(ctx, listOptions)
+	if err != nil {
+		return err
+	}
+
+	errs := []error{}
+	for _, service := range services.Items {
+		err := servicesClient.Delete(ctx, service.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
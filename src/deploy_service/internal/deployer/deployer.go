@@ -3,15 +3,18 @@ package deployer
 import (
 	"context"
 	"fmt"
-	"os"
+	"maps"
 
 	"apps-hosting.com/logging"
+	"apps-hosting.com/messaging/platform"
 
 	v1Apps "k8s.io/api/apps/v1"
 	v1Core "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
 )
@@ -19,70 +22,195 @@ import (
 // FIXME: must be a dynamic value
 const NAMESPACE = "default"
 
+// EnvChecksumAnnotation is set on the pod template so that changing the
+// environment secret rolls the pods, Kubernetes does not do it on its own.
+const EnvChecksumAnnotation = "apps-hosting.com/env-checksum"
+
 type Deployer struct {
-	kubernetesClient *kubernetes.Clientset
+	kubernetesClient kubernetes.Interface
+	platform         platform.Config
 	logger           logging.ServiceLogger
 }
 
-func NewDeployer(kubernetesClient *kubernetes.Clientset) Deployer {
-	return Deployer{kubernetesClient: kubernetesClient}
+func NewDeployer(kubernetesClient kubernetes.Interface) Deployer {
+	return Deployer{kubernetesClient: kubernetesClient, platform: platform.FromEnv()}
 }
 
-func (d *Deployer) Deploy(appId, appName, domainName, imageUrl string, envVars []v1Core.EnvVar) error {
+func (d *Deployer) Deploy(params DeployParams) error {
 	labels := map[string]string{
-		"app_name": ToK8sLabelValue(appName),
-		"app_id":   appId,
+		"app_name": ToK8sLabelValue(params.AppName),
+		"app_id":   params.AppId,
 	}
 
-	// 1. create deployment resource
-	err := d.deployImage(appName, imageUrl, labels, envVars)
+	// 1. store environment variables in a secret
+	secretName, err := d.applyEnvironmentSecret(params.AppName, labels, params.EnvVars)
 	if err != nil {
 		return err
 	}
 
-	// 2. expose the app to the cluster network
-	serviceName, err := d.exposeAppInternally(appName, labels)
+	envChecksum := EnvChecksum(params.EnvVars)
+
+	if params.Disk != nil {
+		err = d.applyDisk(params.AppId, *params.Disk, labels)
+	} else {
+		// the disk may have been detached since the last deployment
+		err = d.releaseDisk(params.AppId)
+	}
 	if err != nil {
 		return err
 	}
 
-	// 3. expsoing http/https routes from outside cluster to cluster network
-	err = d.exposeAppExternally(appName, domainName, *serviceName, labels)
+	switch params.Type {
+	case AppTypeCronJob:
+		return d.applyCronJob(params.AppName, params.ImageURL, params.Schedule, *secretName, labels, params.Suspended)
+	case AppTypeJob:
+		return d.runJob(params.AppName, params.ImageURL, *secretName, labels, params.Suspended)
+	}
+
+	// 2. run the pre-deploy command, e.g. the migrations, before the new version takes traffic
+	if len(params.PreDeployCommand) != 0 {
+		err = d.runDeployHook(params, DeployHookPreDeploy, params.PreDeployCommand, *secretName, labels)
+		if err != nil {
+			return err
+		}
+	}
+
+	if params.Type == AppTypeWorker {
+		// workers run like web services but are never reachable from the network
+		err = d.deployImage(params, ToK8sDeploymentName(params.AppName), *secretName, envChecksum, labels, false)
+		if err != nil {
+			return err
+		}
+
+		return d.runPostDeployHook(params, *secretName, labels)
+	}
+
+	// 3. expose the app to the cluster network
+	serviceName, err := d.exposeAppInternally(params.AppName, labels)
 	if err != nil {
 		return err
 	}
 
-	return nil
-}
+	// 4. create deployment resource, the strategy decides when the service moves to it
+	strategy := params.Strategy.Type
+	if params.Disk != nil {
+		// a ReadWriteOnce volume cannot be mounted by two versions of the app
+		strategy = DeploymentStrategyRolling
+	}
 
-func (d *Deployer) Destroy(appName string) error {
-	err := d.unDeployImage(appName)
+	switch strategy {
+	case DeploymentStrategyBlueGreen:
+		err = d.deployBlueGreen(params, *secretName, envChecksum, labels)
+	case DeploymentStrategyCanary:
+		err = d.deployCanary(params, *secretName, envChecksum, labels)
+	default:
+		err = d.deployRolling(params, *secretName, envChecksum, labels)
+	}
 	if err != nil {
 		return err
 	}
 
-	err = d.unExposeAppInternally(appName)
+	// a suspended app keeps its domain but the placeholder answers instead,
+	// the requests of an app scaled to zero when idle go through the activator
+	backendServiceName := *serviceName
+	if params.Suspended {
+		backendServiceName = SuspendedBackendServiceName()
+	} else if params.IdleTimeout > 0 {
+		backendServiceName = ActivatorServiceName()
+	}
+
+	// 5. expsoing http/https routes from outside cluster to cluster network
+	err = d.exposeAppExternally(params.AppId, params.AppName, params.DomainName, backendServiceName, labels)
 	if err != nil {
 		return err
 	}
 
-	err = d.unExposeAppExternally(appName)
+	// 6. run the post-deploy command once the new version takes traffic
+	return d.runPostDeployHook(params, *secretName, labels)
+}
+
+// UpdateEnvironment rewrites the environment secret of a running app and rolls
+// its Deployment without building a new image, then waits until the new pods are
+// available. It returns the app name.
+func (d *Deployer) UpdateEnvironment(appId string, envVars map[string]string) (*string, error) {
+	deploymentsClient := d.kubernetesClient.AppsV1().Deployments(NAMESPACE)
+	deployments, err := deploymentsClient.List(context.Background(), metav1.ListOptions{
+		LabelSelector: "app_id=" + appId,
+	})
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
 	}
 
-	return nil
+	if len(deployments.Items) == 0 {
+		return d.updateJobsEnvironment(appId, envVars)
+	}
+
+	appName := deployments.Items[0].Labels["app_name"]
+	labels := map[string]string{
+		"app_name": appName,
+		"app_id":   appId,
+	}
+
+	_, err = d.applyEnvironmentSecret(appName, labels, envVars)
+	if err != nil {
+		return nil, err
+	}
+
+	patch := fmt.Sprintf(
+		`{"spec":{"template":{"metadata":{"annotations":{%q:%q}}}}}`,
+		EnvChecksumAnnotation,
+		EnvChecksum(envVars),
+	)
+
+	// both versions of a canary deployment share the secret
+	for _, deployment := range deployments.Items {
+		_, err = deploymentsClient.Patch(context.Background(), deployment.Name, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to restart deployment: %w", err)
+		}
+
+		d.logger.LogInfoF("Deployment %q restarted with new environment in namespace %q", deployment.Name, NAMESPACE)
+	}
+
+	for _, deployment := range deployments.Items {
+		err = d.waitUntilAvailable(deployment.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &appName, nil
 }
 
-func (d *Deployer) deployImage(appName, imageURL string, labels map[string]string, envVars []v1Core.EnvVar) error {
-	deploymentObject := d.generateDeploymentObject(appName, imageURL, labels, envVars)
+func (d *Deployer) applyEnvironmentSecret(appName string, labels map[string]string, envVars map[string]string) (*string, error) {
+	secretObject := d.generateSecretObject(appName, labels, envVars)
+	secretsClient := d.kubernetesClient.CoreV1().Secrets(NAMESPACE)
+
+	_, err := secretsClient.Create(context.Background(), &secretObject, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		_, err = secretsClient.Update(context.Background(), &secretObject, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply environment secret: %w", err)
+	}
+
+	d.logger.LogInfoF("Secret %q applied in namespace %q", secretObject.Name, NAMESPACE)
+	return &secretObject.Name, nil
+}
+
+func (d *Deployer) deployImage(params DeployParams, deploymentName, secretName, envChecksum string, labels map[string]string, exposed bool) error {
+	deploymentObject := d.generateDeploymentObject(params, deploymentName, secretName, envChecksum, labels, exposed)
 	deploymentsClient := d.kubernetesClient.AppsV1().Deployments(NAMESPACE)
 	_, err := deploymentsClient.Create(context.Background(), &deploymentObject, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		// a new build of an app that is already running, e.g. a pull request preview
+		_, err = deploymentsClient.Update(context.Background(), &deploymentObject, metav1.UpdateOptions{})
+	}
 	if err != nil {
 		return fmt.Errorf("failed to deploy the image: %v", err)
 	}
 
-	d.logger.LogInfoF("Deployment created successfully in namespace %s with image: %s", NAMESPACE, imageURL)
+	d.logger.LogInfoF("Deployment %q applied successfully in namespace %s with image: %s", deploymentName, NAMESPACE, params.ImageURL)
 	return nil
 }
 
@@ -93,78 +221,44 @@ func (d *Deployer) exposeAppInternally(appName string, labels map[string]string)
 		CoreV1().
 		Services(NAMESPACE).
 		Create(context.TODO(), &serviceObject, metav1.CreateOptions{})
-	if err != nil {
+	if err != nil && !apierrors.IsAlreadyExists(err) {
 		return nil, err
 	}
 	return &serviceObject.Name, nil
 }
 
-func (d *Deployer) exposeAppExternally(appName, domainName, serviceName string, labels map[string]string) error {
+func (d *Deployer) exposeAppExternally(appId, appName, domainName, serviceName string, labels map[string]string) error {
+	if d.platform.RoutingBackend == platform.RoutingBackendGatewayAPI {
+		return d.applyHTTPRoute(appId, appName, domainName, serviceName, labels)
+	}
+
 	d.logger.LogInfo("Generating kubernetes ingress object...")
 
 	ingressObject := d.generateIngressObject(appName, domainName, serviceName, labels)
-	_, err := d.kubernetesClient.NetworkingV1().Ingresses(NAMESPACE).Create(context.TODO(), &ingressObject, metav1.CreateOptions{})
-	if err != nil {
-		return fmt.Errorf("failed to create ingress: %w", err)
+	ingressesClient := d.kubernetesClient.NetworkingV1().Ingresses(NAMESPACE)
+	_, err := ingressesClient.Create(context.TODO(), &ingressObject, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		_, err = ingressesClient.Update(context.TODO(), &ingressObject, metav1.UpdateOptions{})
 	}
-
-	d.logger.LogInfoF("Ingress %q created in namespace %q\n", ingressObject.Name, NAMESPACE)
-	return nil
-}
-
-func (d *Deployer) unDeployImage(appName string) error {
-	err := d.kubernetesClient.
-		AppsV1().
-		Deployments(NAMESPACE).
-		DeleteCollection(
-			context.Background(),
-			metav1.DeleteOptions{},
-			metav1.ListOptions{LabelSelector: "app_name=" + ToK8sLabelValue(appName)},
-		)
 	if err != nil {
-		return fmt.Errorf("failed to delete deployment: %w", err)
+		return fmt.Errorf("failed to apply ingress: %w", err)
 	}
-	d.logger.LogInfoF("Deployment %q deleted in namespace %q\n", appName, NAMESPACE)
-	return nil
-}
 
-func (d *Deployer) unExposeAppInternally(appName string) error {
-	err := d.kubernetesClient.
-		CoreV1().
-		Services(NAMESPACE).
-		Delete(
-			context.Background(),
-			ToK8sServiceName(appName),
-			metav1.DeleteOptions{},
-		)
-
-	if err != nil {
-		return fmt.Errorf("failed to delete service: %w", err)
-	}
-	d.logger.LogInfoF("Service %q deleted in namespace %q\n", appName, NAMESPACE)
+	d.logger.LogInfoF("Ingress %q applied in namespace %q\n", ingressObject.Name, NAMESPACE)
 	return nil
 }
 
-func (d *Deployer) unExposeAppExternally(appName string) error {
-	err := d.kubernetesClient.
-		NetworkingV1().
-		Ingresses(NAMESPACE).
-		DeleteCollection(
-			context.Background(),
-			metav1.DeleteOptions{},
-			metav1.ListOptions{LabelSelector: "app_name=" + ToK8sLabelValue(appName)},
-		)
-	if err != nil {
-		return fmt.Errorf("failed to delete ingress: %w", err)
+func (d *Deployer) generateDeploymentObject(params DeployParams, deploymentName, secretName, envChecksum string, labels map[string]string, exposed bool) v1Apps.Deployment {
+	container := d.generateContainer(params.AppName, params.ImageURL, secretName)
+	if exposed {
+		container.Ports = []v1Core.ContainerPort{
+			{ContainerPort: 3000},
+		}
 	}
-	d.logger.LogInfoF("Ingress %q deleted in namespace %q\n", appName, NAMESPACE)
-	return nil
-}
 
-func (d *Deployer) generateDeploymentObject(appName, imageURL string, labels map[string]string, envVars []v1Core.EnvVar) v1Apps.Deployment {
-	return v1Apps.Deployment{
+	deployment := v1Apps.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   ToK8sDeploymentName(appName),
+			Name:   deploymentName,
 			Labels: labels,
 		},
 		Spec: v1Apps.DeploymentSpec{
@@ -174,23 +268,68 @@ func (d *Deployer) generateDeploymentObject(appName, imageURL string, labels map
 			Template: v1Core.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: labels,
+					Annotations: map[string]string{
+						EnvChecksumAnnotation: envChecksum,
+					},
 				},
 				Spec: v1Core.PodSpec{
-					Containers: []v1Core.Container{
-						{
-							Name:  ToK8sContainerName(appName),
-							Image: imageURL,
-							Ports: []v1Core.ContainerPort{
-								{ContainerPort: 3000},
-							},
-							Env: envVars,
-						},
+					Containers: []v1Core.Container{container},
+				},
+			},
+		},
+	}
+
+	if params.Suspended {
+		deployment.Spec.Replicas = &suspendedReplicas
+	}
+
+	deployment.Spec.Strategy = generateRollingUpdateStrategy(params.Strategy)
+
+	if disk := params.Disk; disk != nil {
+		// A ReadWriteOnce volume cannot be mounted by the old and the new pod at the same time.
+		deployment.Spec.Strategy = v1Apps.DeploymentStrategy{Type: v1Apps.RecreateDeploymentStrategyType}
+		deployment.Spec.Template.Spec.Volumes = []v1Core.Volume{
+			{
+				Name: diskVolumeName,
+				VolumeSource: v1Core.VolumeSource{
+					PersistentVolumeClaim: &v1Core.PersistentVolumeClaimVolumeSource{
+						ClaimName: ToK8sDiskName(labels["app_id"]),
 					},
 				},
 			},
+		}
+		deployment.Spec.Template.Spec.Containers[0].VolumeMounts = []v1Core.VolumeMount{
+			{Name: diskVolumeName, MountPath: disk.MountPath},
+		}
+	}
+
+	return deployment
+}
+
+func (d *Deployer) generateContainer(appName, imageURL, secretName string) v1Core.Container {
+	return v1Core.Container{
+		Name:  ToK8sContainerName(appName),
+		Image: imageURL,
+		EnvFrom: []v1Core.EnvFromSource{
+			{
+				SecretRef: &v1Core.SecretEnvSource{
+					LocalObjectReference: v1Core.LocalObjectReference{Name: secretName},
+				},
+			},
 		},
 	}
+}
 
+func (d *Deployer) generateSecretObject(appName string, labels map[string]string, envVars map[string]string) v1Core.Secret {
+	return v1Core.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ToK8sSecretName(appName),
+			Namespace: NAMESPACE,
+			Labels:    labels,
+		},
+		Type:       v1Core.SecretTypeOpaque,
+		StringData: envVars,
+	}
 }
 
 func (d *Deployer) generateServiceObject(namespace, appName string, labels map[string]string) v1Core.Service {
@@ -216,22 +355,35 @@ func (d *Deployer) generateServiceObject(namespace, appName string, labels map[s
 func (d *Deployer) generateIngressObject(appName, host, serviceName string, labels map[string]string) networkingv1.Ingress {
 	pathType := networkingv1.PathTypePrefix
 
-	ingressClassName := "nginx"
+	ingressClassName := d.platform.IngressClass
+	annotations := map[string]string{}
+	maps.Copy(annotations, d.platform.IngressAnnotations)
+
+	tls := []networkingv1.IngressTLS{}
+	switch d.platform.TLSMode {
+	case platform.TLSModeWildcard:
+		tls = append(tls, networkingv1.IngressTLS{
+			Hosts:      []string{host},
+			SecretName: d.platform.TLSSecretName,
+		})
+	case platform.TLSModeCertManager:
+		annotations["cert-manager.io/cluster-issuer"] = d.platform.CertManagerClusterIssuer
+		tls = append(tls, networkingv1.IngressTLS{
+			Hosts:      []string{host},
+			SecretName: ToK8sTLSSecretName(appName),
+		})
+	}
 
 	return networkingv1.Ingress{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      ToK8sIngressName(appName),
-			Labels:    labels,
-			Namespace: NAMESPACE,
+			Name:        ToK8sIngressName(appName),
+			Labels:      labels,
+			Annotations: annotations,
+			Namespace:   NAMESPACE,
 		},
 		Spec: networkingv1.IngressSpec{
 			IngressClassName: &ingressClassName,
-			TLS: []networkingv1.IngressTLS{
-				{
-					Hosts:      []string{host},
-					SecretName: os.Getenv("TLS_WILDCARD_SECRET_NAME"),
-				},
-			},
+			TLS:              tls,
 			Rules: []networkingv1.IngressRule{
 				{
 					Host: host,
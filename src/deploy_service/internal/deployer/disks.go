@@ -0,0 +1,154 @@
+package deployer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	v1Core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// DiskDeleteAfterAnnotation marks a detached disk, it is deleted once the time it holds has passed.
+const DiskDeleteAfterAnnotation = "apps-hosting.com/delete-after"
+
+const DefaultDiskDeletionGracePeriod = 7 * 24 * time.Hour
+
+const diskVolumeName = "disk"
+
+// DiskDeletionGracePeriod is how long a detached disk is kept, so that deleting an
+// app or its disk by mistake does not lose the data right away.
+func DiskDeletionGracePeriod() time.Duration {
+	gracePeriod, err := time.ParseDuration(os.Getenv("DISK_DELETION_GRACE_PERIOD"))
+	if err != nil || gracePeriod < 0 {
+		return DefaultDiskDeletionGracePeriod
+	}
+
+	return gracePeriod
+}
+
+// applyDisk creates the volume claim of the app or grows it, and cancels a pending deletion.
+func (d *Deployer) applyDisk(appId string, disk Disk, labels map[string]string) error {
+	claimsClient := d.kubernetesClient.CoreV1().PersistentVolumeClaims(NAMESPACE)
+	size := resource.MustParse(fmt.Sprintf("%dGi", disk.SizeGB))
+
+	claim, err := claimsClient.Get(context.Background(), ToK8sDiskName(appId), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		claimObject := d.generateDiskObject(appId, size, labels)
+		_, err = claimsClient.Create(context.Background(), &claimObject, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to create disk: %w", err)
+		}
+
+		d.logger.LogInfoF("PersistentVolumeClaim %q created in namespace %q", claimObject.Name, NAMESPACE)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get disk: %w", err)
+	}
+
+	if _, ok := claim.Annotations[DiskDeleteAfterAnnotation]; ok {
+		patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:null}}}`, DiskDeleteAfterAnnotation)
+		_, err = claimsClient.Patch(context.Background(), claim.Name, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to cancel disk deletion: %w", err)
+		}
+	}
+
+	// Growing relies on a storage class allowing volume expansion, shrinking is refused by app-service.
+	currentSize := claim.Spec.Resources.Requests[v1Core.ResourceStorage]
+	if size.Cmp(currentSize) > 0 {
+		patch := fmt.Sprintf(`{"spec":{"resources":{"requests":{"storage":%q}}}}`, size.String())
+		_, err = claimsClient.Patch(context.Background(), claim.Name, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to resize disk: %w", err)
+		}
+
+		d.logger.LogInfoF("PersistentVolumeClaim %q resized to %s", claim.Name, size.String())
+	}
+
+	return nil
+}
+
+// releaseDisk schedules the deletion of the disk of the app, if it has one.
+func (d *Deployer) releaseDisk(appId string) error {
+	claimsClient := d.kubernetesClient.CoreV1().PersistentVolumeClaims(NAMESPACE)
+
+	claim, err := claimsClient.Get(context.Background(), ToK8sDiskName(appId), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get disk: %w", err)
+	}
+
+	// A disk released twice keeps its first deadline.
+	if _, ok := claim.Annotations[DiskDeleteAfterAnnotation]; ok {
+		return nil
+	}
+
+	deleteAfter := time.Now().Add(DiskDeletionGracePeriod()).UTC().Format(time.RFC3339)
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, DiskDeleteAfterAnnotation, deleteAfter)
+	_, err = claimsClient.Patch(context.Background(), claim.Name, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to schedule disk deletion: %w", err)
+	}
+
+	d.logger.LogInfoF("PersistentVolumeClaim %q will be deleted after %s", claim.Name, deleteAfter)
+	return nil
+}
+
+// DeleteExpiredDisks deletes the released disks whose grace period is over.
+func (d *Deployer) DeleteExpiredDisks(now time.Time) (int, error) {
+	claimsClient := d.kubernetesClient.CoreV1().PersistentVolumeClaims(NAMESPACE)
+
+	claims, err := claimsClient.List(context.Background(), metav1.ListOptions{LabelSelector: "app_id"})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list disks: %w", err)
+	}
+
+	deleted := 0
+	for _, claim := range claims.Items {
+		value, ok := claim.Annotations[DiskDeleteAfterAnnotation]
+		if !ok {
+			continue
+		}
+
+		deleteAfter, err := time.Parse(time.RFC3339, value)
+		if err != nil || now.Before(deleteAfter) {
+			continue
+		}
+
+		err = claimsClient.Delete(context.Background(), claim.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return deleted, fmt.Errorf("failed to delete disk: %w", err)
+		}
+
+		d.logger.LogInfoF("PersistentVolumeClaim %q deleted in namespace %q", claim.Name, NAMESPACE)
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+func (d *Deployer) generateDiskObject(appId string, size resource.Quantity, labels map[string]string) v1Core.PersistentVolumeClaim {
+	return v1Core.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ToK8sDiskName(appId),
+			Namespace: NAMESPACE,
+			Labels:    labels,
+		},
+		Spec: v1Core.PersistentVolumeClaimSpec{
+			AccessModes: []v1Core.PersistentVolumeAccessMode{v1Core.ReadWriteOnce},
+			Resources: v1Core.VolumeResourceRequirements{
+				Requests: v1Core.ResourceList{
+					v1Core.ResourceStorage: size,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,15 @@
+package deployer
+
+import "errors"
+
+var (
+	ErrAppNotDeployed       = errors.New("app is not deployed")
+	ErrUnsupportedAddOnType = errors.New("unsupported add-on type")
+	ErrAddOnNotProvisioned  = errors.New("add-on is not provisioned")
+	ErrNoCanaryDeployment   = errors.New("app has no canary deployment")
+	ErrInstanceNotFound     = errors.New("instance not found")
+	// ErrCanaryNotSupported is returned when the Ingresses are not served by ingress-nginx, the
+	// only controller whose canary annotations are used.
+	ErrCanaryNotSupported    = errors.New("canary deployments need the nginx ingress class or the Gateway API routing backend")
+	ErrGatewayAPIUnavailable = errors.New("the Gateway API is not available")
+)
@@ -0,0 +1,158 @@
+package deployer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"time"
+
+	v1Batch "k8s.io/api/batch/v1"
+	v1Core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeployHookLabel is set on the Jobs running the pre-deploy and post-deploy commands of an app.
+const DeployHookLabel = "deploy_hook"
+
+const (
+	DeployHookPreDeploy  = "pre-deploy"
+	DeployHookPostDeploy = "post-deploy"
+)
+
+const DefaultDeployHookTimeout = 15 * time.Minute
+
+const (
+	// the finished Jobs are kept for a day, so that the logs of their run can be read
+	deployHookTTL        = int32(24 * 60 * 60)
+	deployHookLogLines   = int64(50)
+	deployHookPollPeriod = 2 * time.Second
+)
+
+// DeployHookTimeout is how long a pre-deploy or post-deploy command may run before it is stopped.
+func DeployHookTimeout() time.Duration {
+	timeout, err := time.ParseDuration(os.Getenv("DEPLOY_HOOK_TIMEOUT"))
+	if err != nil || timeout <= 0 {
+		return DefaultDeployHookTimeout
+	}
+
+	return timeout
+}
+
+// runDeployHook runs the command in a Job with the image and the environment of the new version,
+// and waits until it finishes. A failure is returned with the last lines of the output of the command.
+func (d *Deployer) runDeployHook(params DeployParams, hook, command, secretName string, labels map[string]string) error {
+	jobObject := d.generateDeployHookJobObject(params, hook, command, secretName, labels)
+	jobsClient := d.kubernetesClient.BatchV1().Jobs(NAMESPACE)
+
+	job, err := jobsClient.Create(context.Background(), &jobObject, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create %s job: %w", hook, err)
+	}
+
+	d.logger.LogInfoF("Job %q created in namespace %q to run the %s command", job.Name, NAMESPACE, hook)
+
+	// the Job is stopped by its deadline, the margin leaves it the time to report it
+	ctx, cancel := context.WithTimeout(context.Background(), DeployHookTimeout()+time.Minute)
+	defer cancel()
+
+	ticker := time.NewTicker(deployHookPollPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s command did not finish in %s", hook, DeployHookTimeout())
+		case <-ticker.C:
+		}
+
+		job, err = jobsClient.Get(ctx, job.Name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get %s job: %w", hook, err)
+		}
+
+		for _, condition := range job.Status.Conditions {
+			if condition.Status != v1Core.ConditionTrue {
+				continue
+			}
+
+			switch condition.Type {
+			case v1Batch.JobComplete:
+				d.logger.LogInfoF("Job %q completed", job.Name)
+				return nil
+			case v1Batch.JobFailed:
+				return fmt.Errorf("%s command failed (%s): %s", hook, condition.Reason, d.readDeployHookLogs(job.Name))
+			}
+		}
+	}
+}
+
+// runPostDeployHook runs the post-deploy command of the app, if it has one. The new version
+// keeps serving when it fails, but the deployment is reported as failed.
+func (d *Deployer) runPostDeployHook(params DeployParams, secretName string, labels map[string]string) error {
+	if len(params.PostDeployCommand) == 0 {
+		return nil
+	}
+
+	return d.runDeployHook(params, DeployHookPostDeploy, params.PostDeployCommand, secretName, labels)
+}
+
+// readDeployHookLogs returns the end of the output of the pod of the Job, it is only used
+// to explain a failure so errors are reported in its place.
+func (d *Deployer) readDeployHookLogs(jobName string) string {
+	podsClient := d.kubernetesClient.CoreV1().Pods(NAMESPACE)
+
+	pods, err := podsClient.List(context.Background(), metav1.ListOptions{LabelSelector: "job-name=" + jobName})
+	if err != nil {
+		return fmt.Sprintf("failed to list pods: %v", err)
+	}
+
+	if len(pods.Items) == 0 {
+		return "no pod was started"
+	}
+
+	tailLines := deployHookLogLines
+	stream, err := podsClient.GetLogs(pods.Items[0].Name, &v1Core.PodLogOptions{TailLines: &tailLines}).Stream(context.Background())
+	if err != nil {
+		return fmt.Sprintf("failed to read logs: %v", err)
+	}
+	defer stream.Close()
+
+	logs, err := io.ReadAll(stream)
+	if err != nil {
+		return fmt.Sprintf("failed to read logs: %v", err)
+	}
+
+	return string(bytes.TrimSpace(logs))
+}
+
+func (d *Deployer) generateDeployHookJobObject(params DeployParams, hook, command, secretName string, labels map[string]string) v1Batch.Job {
+	jobLabels := maps.Clone(labels)
+	jobLabels[DeployHookLabel] = hook
+
+	// without app_name the service of the app does not select the pod, app_id keeps
+	// its output in the logs of the app
+	podLabels := map[string]string{
+		"app_id":        labels["app_id"],
+		DeployHookLabel: hook,
+	}
+
+	jobSpec := d.generateJobSpec(params.AppName, params.ImageURL, secretName, podLabels)
+	jobSpec.Template.Spec.Containers[0].Command = []string{"/bin/sh", "-c", command}
+
+	activeDeadlineSeconds := int64(DeployHookTimeout().Seconds())
+	ttlSecondsAfterFinished := deployHookTTL
+	jobSpec.ActiveDeadlineSeconds = &activeDeadlineSeconds
+	jobSpec.TTLSecondsAfterFinished = &ttlSecondsAfterFinished
+
+	return v1Batch.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-%s-", ToK8sLabelValue(params.AppName), hook),
+			Namespace:    NAMESPACE,
+			Labels:       jobLabels,
+		},
+		Spec: jobSpec,
+	}
+}
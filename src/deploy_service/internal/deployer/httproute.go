@@ -0,0 +1,248 @@
+package deployer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+)
+
+// CanaryWeightAnnotation holds the percentage of the requests sent to the canary service,
+// the HTTPRoute of the app is built from it.
+const CanaryWeightAnnotation = "apps-hosting.com/canary-weight"
+
+// the Gateway API is not part of client-go, its resources are sent as JSON through the REST client
+const httpRoutesPath = "/apis/gateway.networking.k8s.io/v1/namespaces/" + NAMESPACE + "/httproutes"
+
+const fieldManager = "deploy-service"
+
+type httpRoute struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Metadata   metav1.ObjectMeta `json:"metadata"`
+	Spec       httpRouteSpec     `json:"spec"`
+}
+
+type httpRouteList struct {
+	Items []httpRoute `json:"items"`
+}
+
+type httpRouteSpec struct {
+	ParentRefs []httpRouteParentRef `json:"parentRefs"`
+	Hostnames  []string             `json:"hostnames"`
+	Rules      []httpRouteRule      `json:"rules"`
+}
+
+type httpRouteParentRef struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+type httpRouteRule struct {
+	BackendRefs []httpBackendRef `json:"backendRefs"`
+}
+
+type httpBackendRef struct {
+	Name   string `json:"name"`
+	Port   int32  `json:"port"`
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+func (d *Deployer) httpRoutesClient() (rest.Interface, error) {
+	client := d.kubernetesClient.Discovery().RESTClient()
+	if client == nil {
+		return nil, ErrGatewayAPIUnavailable
+	}
+
+	return client, nil
+}
+
+// applyHTTPRoute attaches the domain of the app to the Gateway of the platform, the requests
+// are split with the canary service of the app when it has one.
+func (d *Deployer) applyHTTPRoute(appId, appName, host, serviceName string, labels map[string]string) error {
+	client, err := d.httpRoutesClient()
+	if err != nil {
+		return err
+	}
+
+	backendRefs, err := d.httpRouteBackends(appId, serviceName)
+	if err != nil {
+		return err
+	}
+
+	route := httpRoute{
+		APIVersion: "gateway.networking.k8s.io/v1",
+		Kind:       "HTTPRoute",
+		Metadata: metav1.ObjectMeta{
+			Name:      ToK8sHTTPRouteName(appName),
+			Namespace: NAMESPACE,
+			Labels:    labels,
+		},
+		Spec: httpRouteSpec{
+			ParentRefs: []httpRouteParentRef{
+				{Name: d.platform.GatewayName, Namespace: d.platform.GatewayNamespace},
+			},
+			Hostnames: []string{host},
+			Rules:     []httpRouteRule{{BackendRefs: backendRefs}},
+		},
+	}
+
+	body, err := json.Marshal(route)
+	if err != nil {
+		return fmt.Errorf("failed to encode http route: %w", err)
+	}
+
+	// server-side apply creates the route or updates it in a single request
+	_, err = client.Patch(types.ApplyPatchType).
+		AbsPath(httpRoutesPath, route.Metadata.Name).
+		Param("fieldManager", fieldManager).
+		Param("force", "true").
+		Body(body).
+		DoRaw(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to apply http route: %w", err)
+	}
+
+	d.logger.LogInfoF("HTTPRoute %q applied in namespace %q", route.Metadata.Name, NAMESPACE)
+	return nil
+}
+
+// httpRouteBackends sends the requests to serviceName, and the share of the canary to the canary
+// service of the app. The placeholder of suspended apps takes every request.
+func (d *Deployer) httpRouteBackends(appId, serviceName string) ([]httpBackendRef, error) {
+	backendRefs := []httpBackendRef{{Name: serviceName, Port: 80}}
+	if serviceName == SuspendedBackendServiceName() {
+		return backendRefs, nil
+	}
+
+	canaryService, err := d.getCanaryService(appId)
+	if err == ErrNoCanaryDeployment {
+		return backendRefs, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	weight, err := strconv.Atoi(canaryService.Annotations[CanaryWeightAnnotation])
+	if err != nil {
+		return nil, fmt.Errorf("invalid canary weight of service %q: %w", canaryService.Name, err)
+	}
+
+	stableWeight := int32(100 - weight)
+	canaryWeight := int32(weight)
+	backendRefs[0].Weight = &stableWeight
+
+	return append(backendRefs, httpBackendRef{Name: canaryService.Name, Port: 80, Weight: &canaryWeight}), nil
+}
+
+// setHTTPRouteBackends replaces the backends of the route, the rest of it is kept.
+func (d *Deployer) setHTTPRouteBackends(routeName string, backendRefs []httpBackendRef) error {
+	client, err := d.httpRoutesClient()
+	if err != nil {
+		return err
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"rules": []httpRouteRule{{BackendRefs: backendRefs}},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode http route patch: %w", err)
+	}
+
+	_, err = client.Patch(types.MergePatchType).
+		AbsPath(httpRoutesPath, routeName).
+		Body(patch).
+		DoRaw(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to update http route: %w", err)
+	}
+
+	d.logger.LogInfoF("HTTPRoute %q routed to %d services in namespace %q", routeName, len(backendRefs), NAMESPACE)
+	return nil
+}
+
+// removeHTTPRouteCanary sends every request of the app back to its main backend.
+func (d *Deployer) removeHTTPRouteCanary(appName string) error {
+	route, err := d.getHTTPRoute(ToK8sHTTPRouteName(appName))
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	backendRefs := []httpBackendRef{}
+	hasCanary := false
+	for _, rule := range route.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			if backendRef.Name == ToK8sCanaryServiceName(appName) {
+				hasCanary = true
+				continue
+			}
+			backendRefs = append(backendRefs, httpBackendRef{Name: backendRef.Name, Port: backendRef.Port})
+		}
+	}
+
+	if !hasCanary {
+		return nil
+	}
+
+	return d.setHTTPRouteBackends(route.Metadata.Name, backendRefs)
+}
+
+func (d *Deployer) getHTTPRoute(name string) (*httpRoute, error) {
+	client, err := d.httpRoutesClient()
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.Get().AbsPath(httpRoutesPath, name).DoRaw(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	route := httpRoute{}
+	err = json.Unmarshal(body, &route)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode http route: %w", err)
+	}
+
+	return &route, nil
+}
+
+func (d *Deployer) listHTTPRoutes(ctx context.Context, labelSelector string) ([]httpRoute, error) {
+	client, err := d.httpRoutesClient()
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.Get().AbsPath(httpRoutesPath).Param("labelSelector", labelSelector).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := httpRouteList{}
+	err = json.Unmarshal(body, &routes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode http routes: %w", err)
+	}
+
+	return routes.Items, nil
+}
+
+func (d *Deployer) deleteHTTPRoutes(ctx context.Context, labelSelector string) error {
+	client, err := d.httpRoutesClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Delete().AbsPath(httpRoutesPath).Param("labelSelector", labelSelector).DoRaw(ctx)
+	return err
+}
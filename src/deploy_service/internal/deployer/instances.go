@@ -0,0 +1,167 @@
+package deployer
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	v1Core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Kubernetes only keeps the Events of the last hour, the most recent ones are enough to explain the state of the app.
+const maxAppEvents = 50
+
+// GetInstances returns the pods of the app, newest first. The pods of finished one-off runs and
+// of the deploy commands are left out.
+func (d *Deployer) GetInstances(appId string) ([]Instance, error) {
+	pods, err := d.kubernetesClient.CoreV1().Pods(NAMESPACE).List(context.Background(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("app_id=%s,!%s", appId, DeployHookLabel),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	instances := []Instance{}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == v1Core.PodSucceeded {
+			continue
+		}
+
+		instance := Instance{
+			Name:      pod.Name,
+			Phase:     string(pod.Status.Phase),
+			NodeName:  pod.Spec.NodeName,
+			CreatedAt: pod.CreationTimestamp.Time,
+		}
+
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == v1Core.PodReady {
+				instance.Ready = condition.Status == v1Core.ConditionTrue
+			}
+		}
+
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			instance.Restarts += containerStatus.RestartCount
+
+			// a container stopped for good has no last state yet
+			terminated := containerStatus.LastTerminationState.Terminated
+			if terminated == nil {
+				terminated = containerStatus.State.Terminated
+			}
+			if terminated != nil {
+				instance.LastTerminationReason = terminated.Reason
+				instance.LastTerminationExitCode = terminated.ExitCode
+			}
+		}
+
+		instances = append(instances, instance)
+	}
+
+	slices.SortFunc(instances, func(a, b Instance) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	return instances, nil
+}
+
+// GetEvents returns the latest Kubernetes Events about the resources of the app, most recent first.
+func (d *Deployer) GetEvents(appId string) ([]AppEvent, error) {
+	ctx := context.Background()
+	listOptions := metav1.ListOptions{LabelSelector: "app_id=" + appId}
+
+	// the Events point to their object by kind and name
+	listers := map[string]func() (runtime.Object, error){
+		"Pod": func() (runtime.Object, error) {
+			return d.kubernetesClient.CoreV1().Pods(NAMESPACE).List(ctx, listOptions)
+		},
+		"Deployment": func() (runtime.Object, error) {
+			return d.kubernetesClient.AppsV1().Deployments(NAMESPACE).List(ctx, listOptions)
+		},
+		"ReplicaSet": func() (runtime.Object, error) {
+			return d.kubernetesClient.AppsV1().ReplicaSets(NAMESPACE).List(ctx, listOptions)
+		},
+		"CronJob": func() (runtime.Object, error) {
+			return d.kubernetesClient.BatchV1().CronJobs(NAMESPACE).List(ctx, listOptions)
+		},
+		"Job": func() (runtime.Object, error) {
+			return d.kubernetesClient.BatchV1().Jobs(NAMESPACE).List(ctx, listOptions)
+		},
+		"PersistentVolumeClaim": func() (runtime.Object, error) {
+			return d.kubernetesClient.CoreV1().PersistentVolumeClaims(NAMESPACE).List(ctx, listOptions)
+		},
+		"HorizontalPodAutoscaler": func() (runtime.Object, error) {
+			return d.kubernetesClient.AutoscalingV2().HorizontalPodAutoscalers(NAMESPACE).List(ctx, listOptions)
+		},
+	}
+
+	objects := map[string]bool{}
+	for kind, list := range listers {
+		list, err := list()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s objects: %w", kind, err)
+		}
+
+		err = meta.EachListItem(list, func(object runtime.Object) error {
+			accessor, err := meta.Accessor(object)
+			if err != nil {
+				return err
+			}
+
+			objects[kind+"/"+accessor.GetName()] = true
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s objects: %w", kind, err)
+		}
+	}
+
+	events, err := d.kubernetesClient.CoreV1().Events(NAMESPACE).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	appEvents := []AppEvent{}
+	for _, event := range events.Items {
+		if !objects[event.InvolvedObject.Kind+"/"+event.InvolvedObject.Name] {
+			continue
+		}
+
+		appEvent := AppEvent{
+			Type:       event.Type,
+			Reason:     event.Reason,
+			Message:    event.Message,
+			ObjectKind: event.InvolvedObject.Kind,
+			ObjectName: event.InvolvedObject.Name,
+			Count:      event.Count,
+			LastSeenAt: event.LastTimestamp.Time,
+		}
+
+		// Events written through the events.k8s.io API only fill the newer fields
+		if event.Series != nil {
+			appEvent.Count = event.Series.Count
+			appEvent.LastSeenAt = event.Series.LastObservedTime.Time
+		}
+		if appEvent.LastSeenAt.IsZero() {
+			appEvent.LastSeenAt = event.EventTime.Time
+		}
+		if appEvent.LastSeenAt.IsZero() {
+			appEvent.LastSeenAt = event.CreationTimestamp.Time
+		}
+		appEvent.Count = max(appEvent.Count, 1)
+
+		appEvents = append(appEvents, appEvent)
+	}
+
+	slices.SortFunc(appEvents, func(a, b AppEvent) int {
+		return b.LastSeenAt.Compare(a.LastSeenAt)
+	})
+
+	if len(appEvents) > maxAppEvents {
+		appEvents = appEvents[:maxAppEvents]
+	}
+
+	return appEvents, nil
+}
@@ -0,0 +1,347 @@
+package deployer
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	v1Apps "k8s.io/api/apps/v1"
+	v1Core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var testNow = time.Date(2025, 11, 27, 12, 0, 0, 0, time.UTC)
+
+func testPod(name string, labels map[string]string, age time.Duration, status v1Core.PodStatus) *v1Core.Pod {
+	return &v1Core.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         NAMESPACE,
+			Labels:            labels,
+			CreationTimestamp: metav1.NewTime(testNow.Add(-age)),
+		},
+		Spec:   v1Core.PodSpec{NodeName: "node-1"},
+		Status: status,
+	}
+}
+
+func readyCondition(status v1Core.ConditionStatus) []v1Core.PodCondition {
+	return []v1Core.PodCondition{{Type: v1Core.PodReady, Status: status}}
+}
+
+func TestGetInstances(t *testing.T) {
+	appLabels := map[string]string{"app_id": "app-1"}
+
+	tests := []struct {
+		name     string
+		pods     []runtime.Object
+		expected []Instance
+	}{
+		{
+			name: "ready pod",
+			pods: []runtime.Object{
+				testPod("web-1", appLabels, time.Minute, v1Core.PodStatus{
+					Phase:      v1Core.PodRunning,
+					Conditions: readyCondition(v1Core.ConditionTrue),
+					ContainerStatuses: []v1Core.ContainerStatus{
+						{State: v1Core.ContainerState{Running: &v1Core.ContainerStateRunning{}}},
+					},
+				}),
+			},
+			expected: []Instance{
+				{Name: "web-1", Phase: "Running", Ready: true, NodeName: "node-1", CreatedAt: testNow.Add(-time.Minute)},
+			},
+		},
+		{
+			name: "restarts of every container are added up",
+			pods: []runtime.Object{
+				testPod("web-1", appLabels, time.Minute, v1Core.PodStatus{
+					Phase:      v1Core.PodRunning,
+					Conditions: readyCondition(v1Core.ConditionTrue),
+					ContainerStatuses: []v1Core.ContainerStatus{
+						{RestartCount: 2},
+						{RestartCount: 3},
+					},
+				}),
+			},
+			expected: []Instance{
+				{Name: "web-1", Phase: "Running", Ready: true, Restarts: 5, NodeName: "node-1", CreatedAt: testNow.Add(-time.Minute)},
+			},
+		},
+		{
+			name: "crash looping pod reports its last termination",
+			pods: []runtime.Object{
+				testPod("web-1", appLabels, time.Minute, v1Core.PodStatus{
+					Phase:      v1Core.PodRunning,
+					Conditions: readyCondition(v1Core.ConditionFalse),
+					ContainerStatuses: []v1Core.ContainerStatus{
+						{
+							RestartCount: 4,
+							State: v1Core.ContainerState{
+								Waiting: &v1Core.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+							},
+							LastTerminationState: v1Core.ContainerState{
+								Terminated: &v1Core.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+							},
+						},
+					},
+				}),
+			},
+			expected: []Instance{
+				{
+					Name:                    "web-1",
+					Phase:                   "Running",
+					Restarts:                4,
+					LastTerminationReason:   "OOMKilled",
+					LastTerminationExitCode: 137,
+					NodeName:                "node-1",
+					CreatedAt:               testNow.Add(-time.Minute),
+				},
+			},
+		},
+		{
+			name: "container stopped for good reports its current termination",
+			pods: []runtime.Object{
+				testPod("web-1", appLabels, time.Minute, v1Core.PodStatus{
+					Phase: v1Core.PodFailed,
+					ContainerStatuses: []v1Core.ContainerStatus{
+						{
+							State: v1Core.ContainerState{
+								Terminated: &v1Core.ContainerStateTerminated{Reason: "Error", ExitCode: 1},
+							},
+						},
+					},
+				}),
+			},
+			expected: []Instance{
+				{
+					Name:                    "web-1",
+					Phase:                   "Failed",
+					LastTerminationReason:   "Error",
+					LastTerminationExitCode: 1,
+					NodeName:                "node-1",
+					CreatedAt:               testNow.Add(-time.Minute),
+				},
+			},
+		},
+		{
+			name: "finished runs, deploy commands and other apps are left out",
+			pods: []runtime.Object{
+				testPod("run-1", appLabels, time.Minute, v1Core.PodStatus{Phase: v1Core.PodSucceeded}),
+				testPod("hook-1", map[string]string{"app_id": "app-1", DeployHookLabel: "true"}, time.Minute, v1Core.PodStatus{Phase: v1Core.PodRunning}),
+				testPod("other-1", map[string]string{"app_id": "app-2"}, time.Minute, v1Core.PodStatus{Phase: v1Core.PodRunning}),
+			},
+			expected: []Instance{},
+		},
+		{
+			name: "newest first",
+			pods: []runtime.Object{
+				testPod("web-old", appLabels, time.Hour, v1Core.PodStatus{Phase: v1Core.PodRunning}),
+				testPod("web-new", appLabels, time.Minute, v1Core.PodStatus{Phase: v1Core.PodPending}),
+			},
+			expected: []Instance{
+				{Name: "web-new", Phase: "Pending", NodeName: "node-1", CreatedAt: testNow.Add(-time.Minute)},
+				{Name: "web-old", Phase: "Running", NodeName: "node-1", CreatedAt: testNow.Add(-time.Hour)},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deployer := NewDeployer(fake.NewClientset(test.pods...))
+
+			instances, err := deployer.GetInstances("app-1")
+			if err != nil {
+				t.Fatalf("GetInstances: %v", err)
+			}
+
+			if !reflect.DeepEqual(instances, test.expected) {
+				t.Errorf("GetInstances = %+v, expected %+v", instances, test.expected)
+			}
+		})
+	}
+}
+
+func testEvent(name, kind, objectName string, modify func(event *v1Core.Event)) *v1Core.Event {
+	event := &v1Core.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         NAMESPACE,
+			CreationTimestamp: metav1.NewTime(testNow.Add(-time.Hour)),
+		},
+		InvolvedObject: v1Core.ObjectReference{Kind: kind, Name: objectName, Namespace: NAMESPACE},
+		Type:           v1Core.EventTypeWarning,
+		Reason:         "BackOff",
+		Message:        "Back-off restarting failed container",
+	}
+	if modify != nil {
+		modify(event)
+	}
+
+	return event
+}
+
+func TestGetEvents(t *testing.T) {
+	appLabels := map[string]string{"app_id": "app-1"}
+	objects := []runtime.Object{
+		testPod("web-1", appLabels, time.Hour, v1Core.PodStatus{Phase: v1Core.PodRunning}),
+		testPod("other-1", map[string]string{"app_id": "app-2"}, time.Hour, v1Core.PodStatus{Phase: v1Core.PodRunning}),
+		&v1Apps.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: NAMESPACE, Labels: appLabels}},
+	}
+
+	tests := []struct {
+		name     string
+		events   []runtime.Object
+		expected []AppEvent
+	}{
+		{
+			name: "events of the resources of the app",
+			events: []runtime.Object{
+				testEvent("event-1", "Pod", "web-1", func(event *v1Core.Event) {
+					event.Count = 3
+					event.LastTimestamp = metav1.NewTime(testNow.Add(-2 * time.Minute))
+				}),
+				testEvent("event-2", "Deployment", "web", func(event *v1Core.Event) {
+					event.Type = v1Core.EventTypeNormal
+					event.Reason = "ScalingReplicaSet"
+					event.Message = "Scaled up replica set web-5d9 to 1"
+					event.Count = 1
+					event.LastTimestamp = metav1.NewTime(testNow.Add(-time.Minute))
+				}),
+			},
+			expected: []AppEvent{
+				{
+					Type:       "Normal",
+					Reason:     "ScalingReplicaSet",
+					Message:    "Scaled up replica set web-5d9 to 1",
+					ObjectKind: "Deployment",
+					ObjectName: "web",
+					Count:      1,
+					LastSeenAt: testNow.Add(-time.Minute),
+				},
+				{
+					Type:       "Warning",
+					Reason:     "BackOff",
+					Message:    "Back-off restarting failed container",
+					ObjectKind: "Pod",
+					ObjectName: "web-1",
+					Count:      3,
+					LastSeenAt: testNow.Add(-2 * time.Minute),
+				},
+			},
+		},
+		{
+			name: "events of other apps and of objects of another kind are left out",
+			events: []runtime.Object{
+				testEvent("event-1", "Pod", "other-1", nil),
+				testEvent("event-2", "ReplicaSet", "web-1", nil),
+			},
+			expected: []AppEvent{},
+		},
+		{
+			name: "series of the events.k8s.io API",
+			events: []runtime.Object{
+				testEvent("event-1", "Pod", "web-1", func(event *v1Core.Event) {
+					event.EventTime = metav1.NewMicroTime(testNow.Add(-10 * time.Minute))
+					event.Series = &v1Core.EventSeries{
+						Count:            7,
+						LastObservedTime: metav1.NewMicroTime(testNow.Add(-time.Minute)),
+					}
+				}),
+			},
+			expected: []AppEvent{
+				{
+					Type:       "Warning",
+					Reason:     "BackOff",
+					Message:    "Back-off restarting failed container",
+					ObjectKind: "Pod",
+					ObjectName: "web-1",
+					Count:      7,
+					LastSeenAt: testNow.Add(-time.Minute),
+				},
+			},
+		},
+		{
+			name: "single event of the events.k8s.io API",
+			events: []runtime.Object{
+				testEvent("event-1", "Pod", "web-1", func(event *v1Core.Event) {
+					event.EventTime = metav1.NewMicroTime(testNow.Add(-10 * time.Minute))
+				}),
+			},
+			expected: []AppEvent{
+				{
+					Type:       "Warning",
+					Reason:     "BackOff",
+					Message:    "Back-off restarting failed container",
+					ObjectKind: "Pod",
+					ObjectName: "web-1",
+					Count:      1,
+					LastSeenAt: testNow.Add(-10 * time.Minute),
+				},
+			},
+		},
+		{
+			name: "event without any time",
+			events: []runtime.Object{
+				testEvent("event-1", "Pod", "web-1", nil),
+			},
+			expected: []AppEvent{
+				{
+					Type:       "Warning",
+					Reason:     "BackOff",
+					Message:    "Back-off restarting failed container",
+					ObjectKind: "Pod",
+					ObjectName: "web-1",
+					Count:      1,
+					LastSeenAt: testNow.Add(-time.Hour),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deployer := NewDeployer(fake.NewClientset(append(objects, test.events...)...))
+
+			events, err := deployer.GetEvents("app-1")
+			if err != nil {
+				t.Fatalf("GetEvents: %v", err)
+			}
+
+			if !reflect.DeepEqual(events, test.expected) {
+				t.Errorf("GetEvents = %+v, expected %+v", events, test.expected)
+			}
+		})
+	}
+}
+
+func TestGetEventsKeepsTheMostRecent(t *testing.T) {
+	objects := []runtime.Object{
+		testPod("web-1", map[string]string{"app_id": "app-1"}, time.Hour, v1Core.PodStatus{Phase: v1Core.PodRunning}),
+	}
+	for i := range maxAppEvents + 10 {
+		objects = append(objects, testEvent(fmt.Sprintf("event-%d", i), "Pod", "web-1", func(event *v1Core.Event) {
+			event.LastTimestamp = metav1.NewTime(testNow.Add(-time.Duration(i) * time.Minute))
+		}))
+	}
+
+	deployer := NewDeployer(fake.NewClientset(objects...))
+
+	events, err := deployer.GetEvents("app-1")
+	if err != nil {
+		t.Fatalf("GetEvents: %v", err)
+	}
+
+	if len(events) != maxAppEvents {
+		t.Fatalf("GetEvents returned %d events, expected %d", len(events), maxAppEvents)
+	}
+	if !events[0].LastSeenAt.Equal(testNow) {
+		t.Errorf("first event last seen at %s, expected %s", events[0].LastSeenAt, testNow)
+	}
+	if oldest := testNow.Add(-(maxAppEvents - 1) * time.Minute); !events[maxAppEvents-1].LastSeenAt.Equal(oldest) {
+		t.Errorf("last event last seen at %s, expected %s", events[maxAppEvents-1].LastSeenAt, oldest)
+	}
+}
@@ -0,0 +1,132 @@
+package deployer
+
+import (
+	"context"
+	"fmt"
+
+	v1Batch "k8s.io/api/batch/v1"
+	v1Core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func (d *Deployer) applyCronJob(appName, imageURL, schedule, secretName string, labels map[string]string, suspended bool) error {
+	cronJobObject := d.generateCronJobObject(appName, imageURL, schedule, secretName, labels, suspended)
+	cronJobsClient := d.kubernetesClient.BatchV1().CronJobs(NAMESPACE)
+
+	_, err := cronJobsClient.Create(context.Background(), &cronJobObject, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		_, err = cronJobsClient.Update(context.Background(), &cronJobObject, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to apply cron job: %w", err)
+	}
+
+	d.logger.LogInfoF("CronJob %q applied in namespace %q with schedule %q", cronJobObject.Name, NAMESPACE, schedule)
+	return nil
+}
+
+// runJob replaces the previous run of a one-off job, Jobs cannot be updated in place.
+func (d *Deployer) runJob(appName, imageURL, secretName string, labels map[string]string, suspended bool) error {
+	jobObject := d.generateJobObject(appName, imageURL, secretName, labels, suspended)
+	jobsClient := d.kubernetesClient.BatchV1().Jobs(NAMESPACE)
+
+	propagationPolicy := metav1.DeletePropagationBackground
+	err := jobsClient.Delete(context.Background(), jobObject.Name, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete previous job: %w", err)
+	}
+
+	_, err = jobsClient.Create(context.Background(), &jobObject, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create job: %w", err)
+	}
+
+	d.logger.LogInfoF("Job %q created in namespace %q with image: %s", jobObject.Name, NAMESPACE, imageURL)
+	return nil
+}
+
+// updateJobsEnvironment only rewrites the secret, the next runs read it when they start.
+func (d *Deployer) updateJobsEnvironment(appId string, envVars map[string]string) (*string, error) {
+	listOptions := metav1.ListOptions{LabelSelector: "app_id=" + appId}
+
+	cronJobs, err := d.kubernetesClient.BatchV1().CronJobs(NAMESPACE).List(context.Background(), listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cron jobs: %w", err)
+	}
+
+	var labels map[string]string
+	if len(cronJobs.Items) > 0 {
+		labels = cronJobs.Items[0].Labels
+	} else {
+		jobs, err := d.kubernetesClient.BatchV1().Jobs(NAMESPACE).List(context.Background(), listOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list jobs: %w", err)
+		}
+
+		if len(jobs.Items) == 0 {
+			return nil, ErrAppNotDeployed
+		}
+		labels = jobs.Items[0].Labels
+	}
+
+	appName := labels["app_name"]
+	_, err = d.applyEnvironmentSecret(appName, labels, envVars)
+	if err != nil {
+		return nil, err
+	}
+
+	return &appName, nil
+}
+
+func (d *Deployer) generateCronJobObject(appName, imageURL, schedule, secretName string, labels map[string]string, suspended bool) v1Batch.CronJob {
+	return v1Batch.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ToK8sCronJobName(appName),
+			Namespace: NAMESPACE,
+			Labels:    labels,
+		},
+		Spec: v1Batch.CronJobSpec{
+			Schedule:          schedule,
+			ConcurrencyPolicy: v1Batch.ForbidConcurrent,
+			Suspend:           &suspended,
+			JobTemplate: v1Batch.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: d.generateJobSpec(appName, imageURL, secretName, labels),
+			},
+		},
+	}
+}
+
+func (d *Deployer) generateJobObject(appName, imageURL, secretName string, labels map[string]string, suspended bool) v1Batch.Job {
+	jobSpec := d.generateJobSpec(appName, imageURL, secretName, labels)
+	jobSpec.Suspend = &suspended
+
+	return v1Batch.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ToK8sJobName(appName),
+			Namespace: NAMESPACE,
+			Labels:    labels,
+		},
+		Spec: jobSpec,
+	}
+}
+
+func (d *Deployer) generateJobSpec(appName, imageURL, secretName string, labels map[string]string) v1Batch.JobSpec {
+	backoffLimit := int32(0)
+
+	return v1Batch.JobSpec{
+		BackoffLimit: &backoffLimit,
+		Template: v1Core.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: labels,
+			},
+			Spec: v1Core.PodSpec{
+				RestartPolicy: v1Core.RestartPolicyNever,
+				Containers:    []v1Core.Container{d.generateContainer(appName, imageURL, secretName)},
+			},
+		},
+	}
+}
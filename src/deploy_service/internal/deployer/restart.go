@@ -0,0 +1,69 @@
+package deployer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// RestartedAtAnnotation is changed on the pod template to roll the pods, like `kubectl rollout restart` does.
+const RestartedAtAnnotation = "apps-hosting.com/restarted-at"
+
+// Restart replaces the pods of every Deployment of the app following their update strategy.
+func (d *Deployer) Restart(appId string, now time.Time) error {
+	deploymentsClient := d.kubernetesClient.AppsV1().Deployments(NAMESPACE)
+
+	deployments, err := deploymentsClient.List(context.Background(), metav1.ListOptions{LabelSelector: "app_id=" + appId})
+	if err != nil {
+		return fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	if len(deployments.Items) == 0 {
+		return ErrAppNotDeployed
+	}
+
+	patch := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{%q:%q}}}}}`, RestartedAtAnnotation, now.UTC().Format(time.RFC3339))
+	for _, deployment := range deployments.Items {
+		_, err = deploymentsClient.Patch(context.Background(), deployment.Name, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to restart deployment: %w", err)
+		}
+
+		d.logger.LogInfoF("Deployment %q restarted in namespace %q", deployment.Name, NAMESPACE)
+	}
+
+	return nil
+}
+
+// RestartInstance deletes a pod of the app, its controller starts a new one in its place.
+func (d *Deployer) RestartInstance(appId, instanceName string) error {
+	podsClient := d.kubernetesClient.CoreV1().Pods(NAMESPACE)
+
+	pod, err := podsClient.Get(context.Background(), instanceName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return ErrInstanceNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get pod: %w", err)
+	}
+
+	// the name comes from the user, it must not reach the pods of another app
+	if pod.Labels["app_id"] != appId {
+		return ErrInstanceNotFound
+	}
+
+	err = podsClient.Delete(context.Background(), pod.Name, metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		return ErrInstanceNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete pod: %w", err)
+	}
+
+	d.logger.LogInfoF("Pod %q deleted in namespace %q", pod.Name, NAMESPACE)
+	return nil
+}
@@ -0,0 +1,155 @@
+package deployer
+
+import (
+	"context"
+	"fmt"
+
+	"apps-hosting.com/messaging/platform"
+
+	v1Core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GetRuntimeStatus reads the replicas of the Deployments of the app and its pods, the pods
+// running the deploy commands are left out.
+func (d *Deployer) GetRuntimeStatus(appId string) (*RuntimeStatus, error) {
+	deployments, err := d.kubernetesClient.AppsV1().Deployments(NAMESPACE).List(context.Background(), metav1.ListOptions{
+		LabelSelector: "app_id=" + appId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	runtimeStatus := RuntimeStatus{Pods: []PodStatus{}}
+	for _, deployment := range deployments.Items {
+		if deployment.Spec.Replicas != nil {
+			runtimeStatus.DesiredReplicas += *deployment.Spec.Replicas
+		}
+		runtimeStatus.ReadyReplicas += deployment.Status.ReadyReplicas
+		runtimeStatus.AvailableReplicas += deployment.Status.AvailableReplicas
+		runtimeStatus.UpdatedReplicas += deployment.Status.UpdatedReplicas
+	}
+
+	pods, err := d.kubernetesClient.CoreV1().Pods(NAMESPACE).List(context.Background(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("app_id=%s,!%s", appId, DeployHookLabel),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	for _, pod := range pods.Items {
+		podStatus := PodStatus{
+			Name:  pod.Name,
+			Phase: string(pod.Status.Phase),
+		}
+
+		if len(pod.Spec.Containers) > 0 {
+			podStatus.ImageURL = pod.Spec.Containers[0].Image
+		}
+
+		if pod.Status.StartTime != nil {
+			startedAt := pod.Status.StartTime.Time
+			podStatus.StartedAt = &startedAt
+		}
+
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == v1Core.PodReady {
+				podStatus.Ready = condition.Status == v1Core.ConditionTrue
+			}
+		}
+
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			podStatus.Restarts += containerStatus.RestartCount
+		}
+
+		runtimeStatus.Pods = append(runtimeStatus.Pods, podStatus)
+	}
+
+	return &runtimeStatus, nil
+}
+
+// Drift compares the resources of the app with the ones Deploy creates for params and describes
+// every difference, it does not look at the replicas since suspending the app or scaling it to zero
+// changes them. One-off jobs are not compared, they are not expected to keep running.
+func (d *Deployer) Drift(params DeployParams) ([]string, error) {
+	ctx := context.Background()
+	discrepancies := []string{}
+
+	if params.Type == AppTypeJob {
+		return discrepancies, nil
+	}
+
+	_, err := d.kubernetesClient.CoreV1().Secrets(NAMESPACE).Get(ctx, ToK8sSecretName(params.AppName), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		discrepancies = append(discrepancies, "environment secret is missing")
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get secret: %w", err)
+	}
+
+	if params.Type == AppTypeCronJob {
+		cronJob, err := d.kubernetesClient.BatchV1().CronJobs(NAMESPACE).Get(ctx, ToK8sCronJobName(params.AppName), metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return append(discrepancies, "cron job is missing"), nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cron job: %w", err)
+		}
+
+		image := cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Image
+		if image != params.ImageURL {
+			discrepancies = append(discrepancies, fmt.Sprintf("cron job runs %s instead of %s", image, params.ImageURL))
+		}
+
+		return discrepancies, nil
+	}
+
+	deployments, err := d.kubernetesClient.AppsV1().Deployments(NAMESPACE).List(ctx, metav1.ListOptions{
+		LabelSelector: "app_id=" + params.AppId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	if len(deployments.Items) == 0 {
+		discrepancies = append(discrepancies, "deployment is missing")
+	}
+
+	for _, deployment := range deployments.Items {
+		image := deployment.Spec.Template.Spec.Containers[0].Image
+		if image != params.ImageURL {
+			discrepancies = append(discrepancies, fmt.Sprintf("deployment %q runs %s instead of %s", deployment.Name, image, params.ImageURL))
+		}
+	}
+
+	if params.Type == AppTypeWorker {
+		return discrepancies, nil
+	}
+
+	_, err = d.kubernetesClient.CoreV1().Services(NAMESPACE).Get(ctx, ToK8sServiceName(params.AppName), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		discrepancies = append(discrepancies, "service is missing")
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get service: %w", err)
+	}
+
+	if d.platform.RoutingBackend == platform.RoutingBackendGatewayAPI {
+		_, err = d.getHTTPRoute(ToK8sHTTPRouteName(params.AppName))
+		if apierrors.IsNotFound(err) {
+			discrepancies = append(discrepancies, "http route is missing")
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get http route: %w", err)
+		}
+
+		return discrepancies, nil
+	}
+
+	_, err = d.kubernetesClient.NetworkingV1().Ingresses(NAMESPACE).Get(ctx, ToK8sIngressName(params.AppName), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		discrepancies = append(discrepancies, "ingress is missing")
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get ingress: %w", err)
+	}
+
+	return discrepancies, nil
+}
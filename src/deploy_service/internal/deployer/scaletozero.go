@@ -0,0 +1,109 @@
+package deployer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// The annotations below are set on the Deployment of the apps scaled to zero when idle,
+// they are shared with the activator which answers on their domain.
+const (
+	// IdleTimeoutAnnotation holds how long the app may run without requests, as a Go duration.
+	IdleTimeoutAnnotation = "apps-hosting.com/idle-timeout"
+	// DomainNameAnnotation lets the activator find the app of a request from its host.
+	DomainNameAnnotation = "apps-hosting.com/domain-name"
+	// LastRequestAtAnnotation is the time of the last request proxied by the activator, in RFC3339.
+	LastRequestAtAnnotation = "apps-hosting.com/last-request-at"
+	// RequestCountAnnotation is the number of requests proxied by the activator since the app was deployed.
+	RequestCountAnnotation = "apps-hosting.com/request-count"
+)
+
+// DefaultActivatorServiceName is the service of the activator installed by the chart.
+const DefaultActivatorServiceName = "activator"
+
+// ActivatorServiceName is the service answering on the domain of the apps scaled to zero when idle.
+func ActivatorServiceName() string {
+	serviceName := os.Getenv("ACTIVATOR_SERVICE")
+	if len(serviceName) == 0 {
+		return DefaultActivatorServiceName
+	}
+
+	return serviceName
+}
+
+// applyScaleToZero annotates the Deployment so that it is stopped after idleTimeout
+// without requests, or removes the annotations when idleTimeout is 0.
+// A deployment counts as a request, the idle period starts over.
+func (d *Deployer) applyScaleToZero(deploymentName, domainName string, idleTimeout time.Duration) error {
+	annotations := map[string]interface{}{
+		IdleTimeoutAnnotation:   nil,
+		DomainNameAnnotation:    nil,
+		LastRequestAtAnnotation: nil,
+		RequestCountAnnotation:  nil,
+	}
+	if idleTimeout > 0 {
+		annotations[IdleTimeoutAnnotation] = idleTimeout.String()
+		annotations[DomainNameAnnotation] = domainName
+		annotations[LastRequestAtAnnotation] = time.Now().UTC().Format(time.RFC3339)
+		annotations[RequestCountAnnotation] = "0"
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": annotations},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode scale to zero patch: %w", err)
+	}
+
+	_, err = d.kubernetesClient.AppsV1().Deployments(NAMESPACE).Patch(context.Background(), deploymentName, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to annotate deployment: %w", err)
+	}
+
+	return nil
+}
+
+// ScaleIdleAppsToZero stops the running apps which did not receive a request during their idle timeout.
+// The activator starts them again on the next request.
+func (d *Deployer) ScaleIdleAppsToZero(now time.Time) (int, error) {
+	deploymentsClient := d.kubernetesClient.AppsV1().Deployments(NAMESPACE)
+
+	deployments, err := deploymentsClient.List(context.Background(), metav1.ListOptions{LabelSelector: "app_id"})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	scaled := 0
+	for _, deployment := range deployments.Items {
+		value, ok := deployment.Annotations[IdleTimeoutAnnotation]
+		if !ok || (deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == 0) {
+			continue
+		}
+
+		idleTimeout, err := time.ParseDuration(value)
+		if err != nil || idleTimeout <= 0 {
+			continue
+		}
+
+		lastRequestAt, err := time.Parse(time.RFC3339, deployment.Annotations[LastRequestAtAnnotation])
+		if err != nil || now.Sub(lastRequestAt) < idleTimeout {
+			continue
+		}
+
+		_, err = deploymentsClient.Patch(context.Background(), deployment.Name, types.MergePatchType, []byte(`{"spec":{"replicas":0}}`), metav1.PatchOptions{})
+		if err != nil {
+			return scaled, fmt.Errorf("failed to scale deployment: %w", err)
+		}
+
+		d.logger.LogInfoF("Deployment %q scaled to zero after %s without requests", deployment.Name, idleTimeout)
+		scaled++
+	}
+
+	return scaled, nil
+}
@@ -0,0 +1,441 @@
+package deployer
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"strconv"
+	"time"
+
+	"apps-hosting.com/messaging/platform"
+
+	v1Apps "k8s.io/api/apps/v1"
+	v1Core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// SlotLabel tells apart the two Deployments of blue/green and canary apps, the service
+// of the app selects the slot serving the requests.
+const SlotLabel = "slot"
+
+const (
+	SlotBlue  = "blue"
+	SlotGreen = "green"
+)
+
+const DefaultDeploymentReadyTimeout = 5 * time.Minute
+
+const readyPollInterval = 2 * time.Second
+
+// DeploymentReadyTimeout is how long a blue/green or canary deployment may take to become
+// available before it is removed and the deployment fails, and how long the restart of an app
+// with a new environment may take.
+func DeploymentReadyTimeout() time.Duration {
+	timeout, err := time.ParseDuration(os.Getenv("DEPLOYMENT_READY_TIMEOUT"))
+	if err != nil || timeout <= 0 {
+		return DefaultDeploymentReadyTimeout
+	}
+
+	return timeout
+}
+
+// PromoteCanary sends every request to the canary deployment of the app and removes the previous version.
+func (d *Deployer) PromoteCanary(appId string) error {
+	canaryService, err := d.getCanaryService(appId)
+	if err != nil {
+		return err
+	}
+
+	appName := canaryService.Labels["app_name"]
+	slot := canaryService.Labels[SlotLabel]
+
+	err = d.selectSlot(appName, slot)
+	if err != nil {
+		return err
+	}
+
+	err = d.removeCanaryRouting(appName)
+	if err != nil {
+		return err
+	}
+
+	d.logger.LogInfoF("Canary deployment of app %q promoted", appName)
+	return d.deleteDeploymentsOutsideSlot(appId, slot)
+}
+
+// AbortCanary removes the canary deployment of the app, the previous version keeps serving.
+func (d *Deployer) AbortCanary(appId string) error {
+	canaryService, err := d.getCanaryService(appId)
+	if err != nil {
+		return err
+	}
+
+	appName := canaryService.Labels["app_name"]
+
+	err = d.removeCanaryRouting(appName)
+	if err != nil {
+		return err
+	}
+
+	err = d.deleteDeployment(ToK8sSlotDeploymentName(appName, canaryService.Labels[SlotLabel]))
+	if err != nil {
+		return err
+	}
+
+	d.logger.LogInfoF("Canary deployment of app %q aborted", appName)
+	return nil
+}
+
+// HasCanary tells whether the app runs a canary deployment waiting to be promoted or aborted.
+func (d *Deployer) HasCanary(appId string) (bool, error) {
+	_, err := d.getCanaryService(appId)
+	if err == ErrNoCanaryDeployment {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// deployRolling updates the Deployment of the app in place, the way Kubernetes does by default.
+func (d *Deployer) deployRolling(params DeployParams, secretName, envChecksum string, labels map[string]string) error {
+	deploymentName := ToK8sDeploymentName(params.AppName)
+
+	err := d.deployImage(params, deploymentName, secretName, envChecksum, labels, true)
+	if err != nil {
+		return err
+	}
+
+	err = d.applyScaleToZero(deploymentName, params.DomainName, params.IdleTimeout)
+	if err != nil {
+		return err
+	}
+
+	// the app may have used another strategy until now
+	slotDeployments, err := d.kubernetesClient.AppsV1().Deployments(NAMESPACE).List(context.Background(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("app_id=%s,%s", params.AppId, SlotLabel),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	if len(slotDeployments.Items) == 0 {
+		return nil
+	}
+
+	err = d.waitUntilAvailable(deploymentName)
+	if err != nil {
+		return err
+	}
+
+	err = d.selectSlot(params.AppName, "")
+	if err != nil {
+		return err
+	}
+
+	err = d.removeCanaryRouting(params.AppName)
+	if err != nil {
+		return err
+	}
+
+	return d.deleteSlotDeployments(params.AppId)
+}
+
+// deployBlueGreen starts the new version in the slot not serving the requests, and moves
+// the service of the app to it once it is available.
+func (d *Deployer) deployBlueGreen(params DeployParams, secretName, envChecksum string, labels map[string]string) error {
+	activeSlot, err := d.activeSlot(params.AppName)
+	if err != nil {
+		return err
+	}
+
+	slot := nextSlot(activeSlot)
+	err = d.deploySlot(params, slot, secretName, envChecksum, labels)
+	if err != nil {
+		return err
+	}
+
+	err = d.selectSlot(params.AppName, slot)
+	if err != nil {
+		return err
+	}
+
+	// a canary left by a previous strategy
+	err = d.removeCanaryRouting(params.AppName)
+	if err != nil {
+		return err
+	}
+
+	return d.deleteDeploymentsOutsideSlot(params.AppId, slot)
+}
+
+// deployCanary starts the new version in the slot not serving the requests, and sends
+// CanaryWeight percent of the requests to it through a canary ingress.
+func (d *Deployer) deployCanary(params DeployParams, secretName, envChecksum string, labels map[string]string) error {
+	if d.platform.RoutingBackend == platform.RoutingBackendIngress && d.platform.IngressClass != platform.DefaultIngressClass {
+		return ErrCanaryNotSupported
+	}
+
+	activeSlot, err := d.activeSlot(params.AppName)
+	if err != nil {
+		return err
+	}
+
+	// the service of the app does not select a slot yet, so it would send requests to
+	// both versions, the first canary deployment replaces the app instead
+	if len(activeSlot) == 0 {
+		return d.deployBlueGreen(params, secretName, envChecksum, labels)
+	}
+
+	// a new build replaces the canary still running
+	err = d.removeCanaryRouting(params.AppName)
+	if err != nil {
+		return err
+	}
+
+	slot := nextSlot(activeSlot)
+	err = d.deploySlot(params, slot, secretName, envChecksum, labels)
+	if err != nil {
+		return err
+	}
+
+	canaryServiceObject := d.generateServiceObject(NAMESPACE, params.AppName, slotLabels(labels, slot))
+	canaryServiceObject.Name = ToK8sCanaryServiceName(params.AppName)
+	canaryServiceObject.Annotations = map[string]string{
+		CanaryWeightAnnotation: strconv.Itoa(int(params.Strategy.CanaryWeight)),
+	}
+
+	_, err = d.kubernetesClient.CoreV1().Services(NAMESPACE).Create(context.Background(), &canaryServiceObject, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create canary service: %w", err)
+	}
+
+	// the HTTPRoute of the app splits the requests between both services when Deploy applies it
+	if d.platform.RoutingBackend == platform.RoutingBackendGatewayAPI {
+		d.logger.LogInfoF("Canary deployment of app %q receives %d%% of the requests", params.AppName, params.Strategy.CanaryWeight)
+		return nil
+	}
+
+	backendServiceName := canaryServiceObject.Name
+	if params.Suspended {
+		backendServiceName = SuspendedBackendServiceName()
+	}
+
+	canaryIngressObject := d.generateIngressObject(params.AppName, params.DomainName, backendServiceName, slotLabels(labels, slot))
+	canaryIngressObject.Name = ToK8sCanaryIngressName(params.AppName)
+	// the certificate is the one of the main ingress
+	delete(canaryIngressObject.Annotations, "cert-manager.io/cluster-issuer")
+	canaryIngressObject.Annotations["nginx.ingress.kubernetes.io/canary"] = "true"
+	canaryIngressObject.Annotations["nginx.ingress.kubernetes.io/canary-weight"] = strconv.Itoa(int(params.Strategy.CanaryWeight))
+
+	_, err = d.kubernetesClient.NetworkingV1().Ingresses(NAMESPACE).Create(context.Background(), &canaryIngressObject, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create canary ingress: %w", err)
+	}
+
+	d.logger.LogInfoF("Canary deployment of app %q receives %d%% of the requests", params.AppName, params.Strategy.CanaryWeight)
+	return nil
+}
+
+// deploySlot applies the Deployment of the slot and waits until it is available,
+// it is removed when it does not become available in time.
+func (d *Deployer) deploySlot(params DeployParams, slot, secretName, envChecksum string, labels map[string]string) error {
+	deploymentName := ToK8sSlotDeploymentName(params.AppName, slot)
+
+	err := d.deployImage(params, deploymentName, secretName, envChecksum, slotLabels(labels, slot), true)
+	if err != nil {
+		return err
+	}
+
+	err = d.applyScaleToZero(deploymentName, params.DomainName, params.IdleTimeout)
+	if err != nil {
+		return err
+	}
+
+	err = d.waitUntilAvailable(deploymentName)
+	if err != nil {
+		// the previous version keeps serving
+		if deleteErr := d.deleteDeployment(deploymentName); deleteErr != nil {
+			d.logger.LogError(deleteErr.Error())
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// activeSlot is the slot selected by the service of the app, empty when it does not select one.
+func (d *Deployer) activeSlot(appName string) (string, error) {
+	service, err := d.kubernetesClient.CoreV1().Services(NAMESPACE).Get(context.Background(), ToK8sServiceName(appName), metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get service: %w", err)
+	}
+
+	return service.Spec.Selector[SlotLabel], nil
+}
+
+// selectSlot points the service of the app to the slot, or to every instance of the app when slot is empty.
+func (d *Deployer) selectSlot(appName, slot string) error {
+	patch := fmt.Sprintf(`{"spec":{"selector":{%q:null}}}`, SlotLabel)
+	if len(slot) != 0 {
+		patch = fmt.Sprintf(`{"spec":{"selector":{%q:%q}}}`, SlotLabel, slot)
+	}
+
+	_, err := d.kubernetesClient.CoreV1().Services(NAMESPACE).Patch(context.Background(), ToK8sServiceName(appName), types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to switch service: %w", err)
+	}
+
+	d.logger.LogInfoF("Service %q switched to slot %q", ToK8sServiceName(appName), slot)
+	return nil
+}
+
+func (d *Deployer) getCanaryService(appId string) (*v1Core.Service, error) {
+	services, err := d.kubernetesClient.CoreV1().Services(NAMESPACE).List(context.Background(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("app_id=%s,%s", appId, SlotLabel),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+
+	if len(services.Items) == 0 {
+		return nil, ErrNoCanaryDeployment
+	}
+
+	return &services.Items[0], nil
+}
+
+func (d *Deployer) removeCanaryRouting(appName string) error {
+	if d.platform.RoutingBackend == platform.RoutingBackendGatewayAPI {
+		err := d.removeHTTPRouteCanary(appName)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := d.kubernetesClient.NetworkingV1().Ingresses(NAMESPACE).Delete(context.Background(), ToK8sCanaryIngressName(appName), metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete canary ingress: %w", err)
+	}
+
+	err = d.kubernetesClient.CoreV1().Services(NAMESPACE).Delete(context.Background(), ToK8sCanaryServiceName(appName), metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete canary service: %w", err)
+	}
+
+	return nil
+}
+
+func (d *Deployer) waitUntilAvailable(deploymentName string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), DeploymentReadyTimeout())
+	defer cancel()
+
+	ticker := time.NewTicker(readyPollInterval)
+	defer ticker.Stop()
+
+	for {
+		deployment, err := d.kubernetesClient.AppsV1().Deployments(NAMESPACE).Get(ctx, deploymentName, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get deployment: %w", err)
+		}
+
+		if isDeploymentAvailable(deployment) {
+			d.logger.LogInfoF("Deployment %q is available", deploymentName)
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("deployment %q did not become available in %s", deploymentName, DeploymentReadyTimeout())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Deployer) deleteDeployment(deploymentName string) error {
+	err := d.kubernetesClient.AppsV1().Deployments(NAMESPACE).Delete(context.Background(), deploymentName, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete deployment: %w", err)
+	}
+
+	d.logger.LogInfoF("Deployment %q deleted in namespace %q", deploymentName, NAMESPACE)
+	return nil
+}
+
+// deleteDeploymentsOutsideSlot removes the other slot and the Deployment of rolling updates.
+func (d *Deployer) deleteDeploymentsOutsideSlot(appId, slot string) error {
+	err := d.kubernetesClient.AppsV1().Deployments(NAMESPACE).DeleteCollection(
+		context.Background(),
+		metav1.DeleteOptions{},
+		metav1.ListOptions{LabelSelector: fmt.Sprintf("app_id=%s,%s!=%s", appId, SlotLabel, slot)},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete previous deployment: %w", err)
+	}
+
+	return nil
+}
+
+func (d *Deployer) deleteSlotDeployments(appId string) error {
+	err := d.kubernetesClient.AppsV1().Deployments(NAMESPACE).DeleteCollection(
+		context.Background(),
+		metav1.DeleteOptions{},
+		metav1.ListOptions{LabelSelector: fmt.Sprintf("app_id=%s,%s", appId, SlotLabel)},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete slot deployments: %w", err)
+	}
+
+	return nil
+}
+
+func generateRollingUpdateStrategy(strategy DeploymentStrategy) v1Apps.DeploymentStrategy {
+	rollingUpdate := &v1Apps.RollingUpdateDeployment{}
+
+	if len(strategy.MaxSurge) != 0 {
+		maxSurge := intstr.Parse(strategy.MaxSurge)
+		rollingUpdate.MaxSurge = &maxSurge
+	}
+
+	if len(strategy.MaxUnavailable) != 0 {
+		maxUnavailable := intstr.Parse(strategy.MaxUnavailable)
+		rollingUpdate.MaxUnavailable = &maxUnavailable
+	}
+
+	return v1Apps.DeploymentStrategy{
+		Type:          v1Apps.RollingUpdateDeploymentStrategyType,
+		RollingUpdate: rollingUpdate,
+	}
+}
+
+// isDeploymentAvailable tells whether the rollout of the deployment is complete. The pods of the
+// previous template must be gone, as they would be counted as available during a rolling update.
+func isDeploymentAvailable(deployment *v1Apps.Deployment) bool {
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	return deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.UpdatedReplicas >= replicas &&
+		deployment.Status.Replicas <= deployment.Status.UpdatedReplicas &&
+		deployment.Status.AvailableReplicas >= replicas
+}
+
+func nextSlot(activeSlot string) string {
+	if activeSlot == SlotBlue {
+		return SlotGreen
+	}
+
+	return SlotBlue
+}
+
+func slotLabels(labels map[string]string, slot string) map[string]string {
+	withSlot := maps.Clone(labels)
+	withSlot[SlotLabel] = slot
+	return withSlot
+}
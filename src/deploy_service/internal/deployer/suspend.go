@@ -0,0 +1,188 @@
+package deployer
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"apps-hosting.com/messaging/platform"
+
+	v1Batch "k8s.io/api/batch/v1"
+	v1Core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// DefaultSuspendedBackendServiceName is the service of the placeholder page installed by the chart.
+const DefaultSuspendedBackendServiceName = "suspended-app"
+
+var suspendedReplicas = int32(0)
+
+// SuspendedBackendServiceName is the service answering on the domain of suspended apps.
+func SuspendedBackendServiceName() string {
+	serviceName := os.Getenv("SUSPENDED_BACKEND_SERVICE")
+	if len(serviceName) == 0 {
+		return DefaultSuspendedBackendServiceName
+	}
+
+	return serviceName
+}
+
+// Suspend stops every instance of the app and routes its domain to the placeholder page.
+// Its resources are kept, so that Resume does not need a new deployment. It is safe to call again.
+func (d *Deployer) Suspend(appId string) error {
+	return d.setSuspended(appId, true)
+}
+
+// Resume undoes Suspend.
+func (d *Deployer) Resume(appId string) error {
+	return d.setSuspended(appId, false)
+}
+
+func (d *Deployer) setSuspended(appId string, suspended bool) error {
+	listOptions := metav1.ListOptions{LabelSelector: "app_id=" + appId}
+
+	replicas := 1
+	if suspended {
+		replicas = 0
+	}
+
+	deploymentsClient := d.kubernetesClient.AppsV1().Deployments(NAMESPACE)
+	deployments, err := deploymentsClient.List(context.Background(), listOptions)
+	if err != nil {
+		return fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	scalesToZero := false
+	for _, deployment := range deployments.Items {
+		if _, ok := deployment.Annotations[IdleTimeoutAnnotation]; ok {
+			scalesToZero = true
+		}
+
+		patch := fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas)
+		_, err = deploymentsClient.Patch(context.Background(), deployment.Name, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to scale deployment: %w", err)
+		}
+
+		d.logger.LogInfoF("Deployment %q scaled to %d replicas in namespace %q", deployment.Name, replicas, NAMESPACE)
+	}
+
+	if d.platform.RoutingBackend == platform.RoutingBackendGatewayAPI {
+		err = d.routeSuspendedHTTPRoutes(appId, suspended, scalesToZero)
+		if err != nil {
+			return err
+		}
+	}
+
+	ingressesClient := d.kubernetesClient.NetworkingV1().Ingresses(NAMESPACE)
+	ingresses, err := ingressesClient.List(context.Background(), listOptions)
+	if err != nil {
+		return fmt.Errorf("failed to list ingresses: %w", err)
+	}
+
+	for _, ingress := range ingresses.Items {
+		serviceName := ToK8sServiceName(ingress.Labels["app_name"])
+		if _, ok := ingress.Labels[SlotLabel]; ok {
+			serviceName = ToK8sCanaryServiceName(ingress.Labels["app_name"])
+		} else if scalesToZero {
+			serviceName = ActivatorServiceName()
+		}
+
+		if suspended {
+			serviceName = SuspendedBackendServiceName()
+		}
+
+		for _, rule := range ingress.Spec.Rules {
+			if rule.HTTP == nil {
+				continue
+			}
+			for i := range rule.HTTP.Paths {
+				if rule.HTTP.Paths[i].Backend.Service != nil {
+					rule.HTTP.Paths[i].Backend.Service.Name = serviceName
+				}
+			}
+		}
+
+		_, err = ingressesClient.Update(context.Background(), &ingress, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to update ingress: %w", err)
+		}
+
+		d.logger.LogInfoF("Ingress %q routed to service %q in namespace %q", ingress.Name, serviceName, NAMESPACE)
+	}
+
+	suspendPatch := []byte(fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspended))
+
+	cronJobsClient := d.kubernetesClient.BatchV1().CronJobs(NAMESPACE)
+	cronJobs, err := cronJobsClient.List(context.Background(), listOptions)
+	if err != nil {
+		return fmt.Errorf("failed to list cron jobs: %w", err)
+	}
+
+	for _, cronJob := range cronJobs.Items {
+		_, err = cronJobsClient.Patch(context.Background(), cronJob.Name, types.MergePatchType, suspendPatch, metav1.PatchOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to suspend cron job: %w", err)
+		}
+	}
+
+	jobsClient := d.kubernetesClient.BatchV1().Jobs(NAMESPACE)
+	jobs, err := jobsClient.List(context.Background(), listOptions)
+	if err != nil {
+		return fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	for _, job := range jobs.Items {
+		// the runs of cron jobs are left to finish, only the one-off job is paused
+		if job.Name != ToK8sJobName(job.Labels["app_name"]) || isJobFinished(job) {
+			continue
+		}
+
+		_, err = jobsClient.Patch(context.Background(), job.Name, types.MergePatchType, suspendPatch, metav1.PatchOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to suspend job: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// routeSuspendedHTTPRoutes points the HTTPRoutes of the app to the placeholder page while it is suspended.
+func (d *Deployer) routeSuspendedHTTPRoutes(appId string, suspended, scalesToZero bool) error {
+	routes, err := d.listHTTPRoutes(context.Background(), "app_id="+appId)
+	if err != nil {
+		return fmt.Errorf("failed to list http routes: %w", err)
+	}
+
+	for _, route := range routes {
+		serviceName := ToK8sServiceName(route.Metadata.Labels["app_name"])
+		if suspended {
+			serviceName = SuspendedBackendServiceName()
+		} else if scalesToZero {
+			serviceName = ActivatorServiceName()
+		}
+
+		backendRefs, err := d.httpRouteBackends(appId, serviceName)
+		if err != nil {
+			return err
+		}
+
+		err = d.setHTTPRouteBackends(route.Metadata.Name, backendRefs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isJobFinished(job v1Batch.Job) bool {
+	for _, condition := range job.Status.Conditions {
+		if (condition.Type == v1Batch.JobComplete || condition.Type == v1Batch.JobFailed) && condition.Status == v1Core.ConditionTrue {
+			return true
+		}
+	}
+
+	return false
+}
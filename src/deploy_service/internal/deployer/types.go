@@ -0,0 +1,130 @@
+package deployer
+
+import "time"
+
+// AppType mirrors the app types of app-service.
+type AppType string
+
+const (
+	AppTypeWebService AppType = "web_service"
+	AppTypeWorker     AppType = "worker"
+	AppTypeCronJob    AppType = "cron_job"
+	AppTypeJob        AppType = "job"
+	// AppTypeStaticSite is served by nginx from its image and deployed like a web service.
+	AppTypeStaticSite AppType = "static_site"
+)
+
+type DeployParams struct {
+	AppId      string
+	AppName    string
+	DomainName string
+	ImageURL   string
+	Type       AppType
+	// Schedule is the cron expression of cron jobs.
+	Schedule string
+	EnvVars  map[string]string
+	// Disk is the persistent volume mounted in the app, nil when it has none.
+	Disk *Disk
+	// Suspended apps are deployed without any running instance.
+	Suspended bool
+	// IdleTimeout is how long a web service or static site runs without requests before it is
+	// scaled to zero, 0 keeps it running.
+	IdleTimeout time.Duration
+	Strategy    DeploymentStrategy
+	// PreDeployCommand runs in a Job with the new image before it takes traffic, PostDeployCommand
+	// once it does, both are skipped when empty.
+	PreDeployCommand  string
+	PostDeployCommand string
+}
+
+type DeploymentStrategyType string
+
+const (
+	DeploymentStrategyRolling DeploymentStrategyType = "rolling"
+	// DeploymentStrategyBlueGreen runs the new version next to the previous one and moves
+	// every request to it once it is available.
+	DeploymentStrategyBlueGreen DeploymentStrategyType = "blue_green"
+	// DeploymentStrategyCanary sends CanaryWeight percent of the requests to the new version
+	// until it is promoted or aborted.
+	DeploymentStrategyCanary DeploymentStrategyType = "canary"
+)
+
+type DeploymentStrategy struct {
+	Type DeploymentStrategyType
+	// MaxSurge and MaxUnavailable are a number of instances or a percentage, empty uses the Kubernetes defaults.
+	MaxSurge       string
+	MaxUnavailable string
+	CanaryWeight   int32
+}
+
+type Disk struct {
+	MountPath string
+	SizeGB    int32
+}
+
+// AddOnType mirrors the add-on types of project-service.
+type AddOnType string
+
+const (
+	AddOnTypePostgres AddOnType = "postgres"
+	AddOnTypeRedis    AddOnType = "redis"
+)
+
+// AddOnConnection is the public part of the connection info of an add-on,
+// the password only lives in its secret.
+type AddOnConnection struct {
+	Host     string
+	Port     int32
+	Database string
+	Username string
+}
+
+// AddOnBinding exposes the connection url of an add-on to an app under EnvVarName.
+type AddOnBinding struct {
+	AddOnId    string
+	EnvVarName string
+}
+
+// RuntimeStatus is the state of the app in the cluster, the replicas are summed over its Deployments.
+type RuntimeStatus struct {
+	DesiredReplicas   int32
+	ReadyReplicas     int32
+	AvailableReplicas int32
+	UpdatedReplicas   int32
+	Pods              []PodStatus
+}
+
+type PodStatus struct {
+	Name     string
+	Phase    string
+	Ready    bool
+	Restarts int32
+	ImageURL string
+	// StartedAt is nil until the pod is scheduled.
+	StartedAt *time.Time
+}
+
+// Instance is a pod of the app.
+type Instance struct {
+	Name     string
+	Phase    string
+	Ready    bool
+	Restarts int32
+	// LastTerminationReason is why the container last stopped (OOMKilled, Error, ...), empty when it never did.
+	LastTerminationReason   string
+	LastTerminationExitCode int32
+	NodeName                string
+	CreatedAt               time.Time
+}
+
+// AppEvent is a Kubernetes Event about one of the resources of the app.
+type AppEvent struct {
+	// Type is Normal or Warning.
+	Type       string
+	Reason     string
+	Message    string
+	ObjectKind string
+	ObjectName string
+	Count      int32
+	LastSeenAt time.Time
+}
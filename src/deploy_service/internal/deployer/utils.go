@@ -1,6 +1,11 @@
 package deployer
 
-import "strings"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+)
 
 func ToK8sLabelValue(appName string) string {
 	return strings.ToLower(strings.ReplaceAll(appName, " ", "-"))
@@ -10,6 +15,11 @@ func ToK8sDeploymentName(appName string) string {
 	return ToK8sLabelValue(appName) + "-deployment"
 }
 
+// ToK8sSlotDeploymentName names the Deployment of a blue/green or canary slot.
+func ToK8sSlotDeploymentName(appName, slot string) string {
+	return ToK8sLabelValue(appName) + "-deployment-" + slot
+}
+
 func ToK8sContainerName(appName string) string {
 	return ToK8sLabelValue(appName) + "-container"
 }
@@ -18,6 +28,65 @@ func ToK8sServiceName(appName string) string {
 	return ToK8sLabelValue(appName) + "-service"
 }
 
+func ToK8sCanaryServiceName(appName string) string {
+	return ToK8sLabelValue(appName) + "-canary-service"
+}
+
 func ToK8sIngressName(appName string) string {
 	return ToK8sLabelValue(appName) + "-ingress"
 }
+
+func ToK8sCanaryIngressName(appName string) string {
+	return ToK8sLabelValue(appName) + "-canary-ingress"
+}
+
+// ToK8sHTTPRouteName names the HTTPRoute of the app when the requests go through the Gateway API.
+func ToK8sHTTPRouteName(appName string) string {
+	return ToK8sLabelValue(appName) + "-route"
+}
+
+// ToK8sTLSSecretName names the secret cert-manager stores the certificate of the app in.
+func ToK8sTLSSecretName(appName string) string {
+	return ToK8sLabelValue(appName) + "-tls"
+}
+
+func ToK8sCronJobName(appName string) string {
+	return ToK8sLabelValue(appName) + "-cronjob"
+}
+
+func ToK8sJobName(appName string) string {
+	return ToK8sLabelValue(appName) + "-job"
+}
+
+// ToK8sDiskName uses the app id, a new app reusing the name of a deleted one
+// must not get its volume during the grace period.
+func ToK8sDiskName(appId string) string {
+	return "disk-" + appId
+}
+
+func ToK8sSecretName(appName string) string {
+	return ToK8sLabelValue(appName) + "-env"
+}
+
+func EnvChecksum(envVars map[string]string) string {
+	keys := make([]string, 0, len(envVars))
+	for key := range envVars {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	for _, key := range keys {
+		hash.Write([]byte(key + "=" + envVars[key] + "\n"))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func ToK8sAddOnName(addOnId string) string {
+	return "addon-" + addOnId
+}
+
+func ToK8sAddOnSecretName(addOnId string) string {
+	return ToK8sAddOnName(addOnId) + "-credentials"
+}
@@ -2,21 +2,22 @@ package eventshandlers
 
 import (
 	"context"
+	"time"
 
 	"apps-hosting.com/deployservice/internal/deployer"
 	"apps-hosting.com/deployservice/internal/models"
 	"apps-hosting.com/deployservice/internal/repositories"
 	"apps-hosting.com/deployservice/proto/app_service_pb"
+	"apps-hosting.com/deployservice/proto/project_service_pb"
 	"apps-hosting.com/logging"
 	"apps-hosting.com/messaging"
 	"apps-hosting.com/messaging/proto/events_pb"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/rest"
-
-	v1Core "k8s.io/api/core/v1"
 )
 
 const (
@@ -24,27 +25,36 @@ const (
 )
 
 type EventsHandlers struct {
-	eventBus             messaging.EventBus
+	eventBus             messaging.Bus
 	appServiceClient     app_service_pb.AppServiceClient
-	deploymentRepository repositories.DeploymentRepository
+	projectServiceClient project_service_pb.ProjectServiceClient
+	deploymentRepository repositories.DeploymentRepositoryInterface
 	logger               logging.ServiceLogger
+
+	// newKubernetesClient connects to the cluster the service runs in, the tests replace it with a fake clientset.
+	newKubernetesClient func() (kubernetes.Interface, error)
 }
 
 func NewEventsHandlers(
-	eventBus messaging.EventBus,
+	eventBus messaging.Bus,
 	appServiceClient app_service_pb.AppServiceClient,
-	deploymentRepository repositories.DeploymentRepository,
+	projectServiceClient project_service_pb.ProjectServiceClient,
+	deploymentRepository repositories.DeploymentRepositoryInterface,
 	logger logging.ServiceLogger,
 ) EventsHandlers {
 	return EventsHandlers{
 		eventBus:             eventBus,
 		appServiceClient:     appServiceClient,
+		projectServiceClient: projectServiceClient,
 		deploymentRepository: deploymentRepository,
 		logger:               logger,
+		newKubernetesClient: func() (kubernetes.Interface, error) {
+			return NewKubernetesClient()
+		},
 	}
 }
 
-func (h *EventsHandlers) HandleBuildCompletedEvent(ctx context.Context, message *events_pb.Message) {
+func (h *EventsHandlers) HandleBuildCompletedEvent(ctx context.Context, message *events_pb.Message) error {
 	h.logger.LogInfo("Handle 'build.completed' event")
 	span := trace.SpanFromContext(ctx)
 
@@ -52,7 +62,7 @@ func (h *EventsHandlers) HandleBuildCompletedEvent(ctx context.Context, message
 	if data == nil {
 		h.logger.LogError("Invalid build completed message")
 		span.SetAttributes(attribute.String("error", "Invalid build completed message"))
-		return
+		return messaging.ErrInvalidMessage
 	}
 
 	span.SetAttributes(
@@ -73,12 +83,14 @@ func (h *EventsHandlers) HandleBuildCompletedEvent(ctx context.Context, message
 	// 1. Create Deployment
 	h.logger.LogInfo("Creating deployment entity...")
 	deployment, err := h.deploymentRepository.CreateDeployment(ctx, data.BuildId, data.AppId, repositories.CreateDeploymentParams{
-		Status: models.DeploymentStatusPending,
+		ImageURL: data.ImageUrl,
+		Status:   models.DeploymentStatusPending,
+		Kind:     models.DeploymentKindBuild,
 	})
 	if err != nil {
 		h.logger.LogError(err.Error())
 		span.SetAttributes(attribute.String("error", err.Error()))
-		return
+		return err
 	}
 
 	span.SetAttributes(attribute.String("deployment.id", deployment.Id))
@@ -92,50 +104,31 @@ func (h *EventsHandlers) HandleBuildCompletedEvent(ctx context.Context, message
 	// 	return config, nil
 	// }
 
-	// Initialize Kubernetes client using utils
-	h.logger.LogInfo("Loading cluster config...")
-	config, err := rest.InClusterConfig()
-	if err != nil {
-		handleDeploymentFailure(deployment.Id, err)
-		return
-	}
-
 	h.logger.LogInfo("Creating kubernetes client...")
-	kubernetesClient, err := kubernetes.NewForConfig(config)
+	kubernetesClient, err := h.newKubernetesClient()
 	if err != nil {
 		handleDeploymentFailure(deployment.Id, err)
-		return
+		return err
 	}
 
-	// Get Environment Varaibels
-	h.logger.LogInfo("Get environemnt variables for the target app...")
-	getEnvironmentVariablesResponse, err := h.appServiceClient.GetEnvironmentVariables(context.Background(), &app_service_pb.GetEnvironmentVariablesRequest{
-		AppId: data.AppId,
-	})
+	deployParams, err := h.GetDeployParams(ctx, data.AppId, data.ImageUrl, kubernetesClient)
 	if err != nil {
 		handleDeploymentFailure(deployment.Id, err)
-		return
-	}
-
-	if getEnvironmentVariablesResponse != nil {
-		span.SetAttributes(attribute.String("environment_variable.id", getEnvironmentVariablesResponse.EnvironmentVariable.Id))
-	}
-
-	envVars := []v1Core.EnvVar{}
-	if getEnvironmentVariablesResponse != nil {
-		envVars, err = ConvertJSONToEnvVars(getEnvironmentVariablesResponse.EnvironmentVariable.Value)
-		if err != nil {
-			handleDeploymentFailure(deployment.Id, err)
-			return
+		if status.Code(err) == codes.NotFound {
+			// the app was deleted since
+			return nil
 		}
+		return err
 	}
 
-	// FIXME: Maybe env vars should come from a config instead of passing them to deployment
-	envVars = append(envVars, v1Core.EnvVar{Name: "NODE_ENV", Value: "production"})
+	span.SetAttributes(
+		attribute.String("app.type", string(deployParams.Type)),
+		attribute.Int("environment_variables.count", len(deployParams.EnvVars)),
+	)
 
 	deployer := deployer.NewDeployer(kubernetesClient)
 
-	err = deployer.Deploy(data.AppId, data.AppName, data.DomainName, data.ImageUrl, envVars)
+	err = deployer.Deploy(*deployParams)
 	if err != nil {
 		span.SetAttributes(attribute.String("error", err.Error()))
 		h.logger.LogError(err.Error())
@@ -155,11 +148,26 @@ func (h *EventsHandlers) HandleBuildCompletedEvent(ctx context.Context, message
 				},
 			},
 		})
-		return
+		// the failure is reported with 'deploy.failed', it is up to the user to deploy again
+		return nil
+	}
+
+	// a canary still running was replaced by this deployment
+	h.deploymentRepository.UpdateDeploymentsByStatus(ctx, data.AppId, models.DeploymentStatusCanary, repositories.UpdateDeploymentParams{
+		Status: models.DeploymentStatusAborted,
+	})
+
+	deploymentStatus := models.DeploymentStatusSuccessed
+	hasCanary, err := deployer.HasCanary(data.AppId)
+	if err != nil {
+		h.logger.LogError(err.Error())
+	}
+	if hasCanary {
+		deploymentStatus = models.DeploymentStatusCanary
 	}
 
 	h.deploymentRepository.UpdateDeploymentById(ctx, deployment.Id, repositories.UpdateDeploymentParams{
-		Status: models.DeploymentStatusSuccessed,
+		Status: deploymentStatus,
 	})
 
 	h.logger.LogInfo("Publishing 'deploy.completed' event...")
@@ -168,12 +176,18 @@ func (h *EventsHandlers) HandleBuildCompletedEvent(ctx context.Context, message
 			DeployCompletedData: &events_pb.DeployCompletedData{
 				AppName:  data.AppName,
 				DeployId: deployment.Id,
+				AppId:    data.AppId,
+				BuildId:  deployment.BuildId,
 			},
 		},
 	})
+
+	return nil
 }
 
-func (h *EventsHandlers) HandleAppDeletedEvent(ctx context.Context, message *events_pb.Message) {
+// HandleAppDeletedEvent returns an error when the deletion may succeed on a later attempt,
+// the event is then delivered again.
+func (h *EventsHandlers) HandleAppDeletedEvent(ctx context.Context, message *events_pb.Message) error {
 	h.logger.LogInfo("Handle 'app.deleted' event")
 	span := trace.SpanFromContext(ctx)
 
@@ -181,40 +195,409 @@ func (h *EventsHandlers) HandleAppDeletedEvent(ctx context.Context, message *eve
 	if data == nil {
 		h.logger.LogError("Invalid app deleted message")
 		span.SetAttributes(attribute.String("error", "Invalid app deleted message"))
-		return
+		return messaging.ErrInvalidMessage
 	}
 
 	span.SetAttributes(attribute.String("app_id", data.AppId))
 
+	h.logger.LogInfo("Creating kubernetes client...")
+	kubernetesClient, err := h.newKubernetesClient()
+	if err != nil {
+		h.logger.LogError(err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return err
+	}
+
+	deployer := deployer.NewDeployer(kubernetesClient)
+	err = deployer.Destroy(data.AppId)
+	if err != nil {
+		h.logger.LogError(err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return err
+	}
+
 	h.logger.LogInfoF("Deleting deployments related to app with id '%s'", data.AppId)
-	err := h.deploymentRepository.DeleteDeployments(ctx, data.AppId)
+	err = h.deploymentRepository.DeleteDeployments(ctx, data.AppId)
+	if err != nil && err != repositories.ErrDeploymentNotFound {
+		h.logger.LogError(err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+func (h *EventsHandlers) HandleAppEnvUpdatedEvent(ctx context.Context, message *events_pb.Message) error {
+	h.logger.LogInfo("Handle 'app.env_updated' event")
+	span := trace.SpanFromContext(ctx)
+
+	data := message.Data.GetAppEnvUpdatedData()
+	if data == nil {
+		h.logger.LogError("Invalid app env updated message")
+		span.SetAttributes(attribute.String("error", "Invalid app env updated message"))
+		return messaging.ErrInvalidMessage
+	}
+
+	span.SetAttributes(attribute.String("app.id", data.AppId))
+
+	latestDeployment, err := h.deploymentRepository.GetLatestDeployment(ctx, data.AppId, models.DeploymentStatusSuccessed)
+	if err == repositories.ErrDeploymentNotFound {
+		h.logger.LogInfoF("App '%s' is not deployed yet, the new environment will be used by its first deployment", data.AppId)
+		return nil
+	}
+
 	if err != nil {
 		h.logger.LogError(err.Error())
 		span.SetAttributes(attribute.String("error", err.Error()))
-		return
+		return err
 	}
 
-	// Initialize Kubernetes client using utils
-	// config, err := kubernetes.GetKubernetesConfigFromEnv()
-	config, err := rest.InClusterConfig()
+	// Restarts reuse the image of the last successful deployment, so they are recorded against its build.
+	h.logger.LogInfo("Creating deployment entity...")
+	deployment, err := h.deploymentRepository.CreateDeployment(ctx, latestDeployment.BuildId, data.AppId, repositories.CreateDeploymentParams{
+		ImageURL: latestDeployment.ImageURL,
+		Status:   models.DeploymentStatusPending,
+		Kind:     models.DeploymentKindEnvUpdate,
+	})
 	if err != nil {
 		h.logger.LogError(err.Error())
 		span.SetAttributes(attribute.String("error", err.Error()))
+		return err
 	}
 
-	h.logger.LogInfo("Creating kubernetes client...")
-	kubernetesClient, err := kubernetes.NewForConfig(config)
+	span.SetAttributes(
+		attribute.String("build.id", deployment.BuildId),
+		attribute.String("deployment.id", deployment.Id),
+	)
+
+	handleDeploymentFailure := func(err error) {
+		h.logger.LogError(err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		h.deploymentRepository.UpdateDeploymentById(
+			ctx,
+			deployment.Id,
+			repositories.UpdateDeploymentParams{Status: models.DeploymentStatusFailed},
+		)
+		h.eventBus.Publish(ctx, events_pb.EventName_DEPLOY_FAILED, &events_pb.EventData{
+			Value: &events_pb.EventData_DeployFailedData{
+				DeployFailedData: &events_pb.DeployFailedData{
+					AppId:        data.AppId,
+					BuildId:      deployment.BuildId,
+					DeploymentId: deployment.Id,
+					Reason:       err.Error(),
+				},
+			},
+		})
+	}
+
+	kubernetesClient, err := h.newKubernetesClient()
+	if err != nil {
+		handleDeploymentFailure(err)
+		return nil
+	}
+
+	envVars, err := h.resolveEnvironmentVariables(ctx, data.AppId, kubernetesClient)
+	if err != nil {
+		handleDeploymentFailure(err)
+		return nil
+	}
+
+	span.SetAttributes(attribute.Int("environment_variables.count", len(envVars)))
+
+	deployer := deployer.NewDeployer(kubernetesClient)
+	appName, err := deployer.UpdateEnvironment(data.AppId, envVars)
+	if err != nil {
+		handleDeploymentFailure(err)
+		return nil
+	}
+
+	// a canary still running was replaced by this deployment
+	h.deploymentRepository.UpdateDeploymentsByStatus(ctx, data.AppId, models.DeploymentStatusCanary, repositories.UpdateDeploymentParams{
+		Status: models.DeploymentStatusAborted,
+	})
+
+	deploymentStatus := models.DeploymentStatusSuccessed
+	hasCanary, err := deployer.HasCanary(data.AppId)
+	if err != nil {
+		h.logger.LogError(err.Error())
+	}
+	if hasCanary {
+		deploymentStatus = models.DeploymentStatusCanary
+	}
+
+	h.deploymentRepository.UpdateDeploymentById(ctx, deployment.Id, repositories.UpdateDeploymentParams{
+		Status: deploymentStatus,
+	})
+
+	h.logger.LogInfo("Publishing 'deploy.completed' event...")
+	h.eventBus.Publish(ctx, events_pb.EventName_DEPLOY_COMPLETED, &events_pb.EventData{
+		Value: &events_pb.EventData_DeployCompletedData{
+			DeployCompletedData: &events_pb.DeployCompletedData{
+				AppName:  *appName,
+				DeployId: deployment.Id,
+				AppId:    data.AppId,
+				BuildId:  deployment.BuildId,
+			},
+		},
+	})
+
+	return nil
+}
+
+func (h *EventsHandlers) HandleAppSuspendedEvent(ctx context.Context, message *events_pb.Message) error {
+	h.logger.LogInfo("Handle 'app.suspended' event")
+	span := trace.SpanFromContext(ctx)
+
+	data := message.Data.GetAppSuspendedData()
+	if data == nil {
+		h.logger.LogError("Invalid app suspended message")
+		span.SetAttributes(attribute.String("error", "Invalid app suspended message"))
+		return messaging.ErrInvalidMessage
+	}
+
+	span.SetAttributes(attribute.String("app.id", data.AppId))
+
+	kubernetesClient, err := h.newKubernetesClient()
 	if err != nil {
 		h.logger.LogError(err.Error())
 		span.SetAttributes(attribute.String("error", err.Error()))
-		return
+		return err
 	}
 
 	deployer := deployer.NewDeployer(kubernetesClient)
-	err = deployer.Destroy(data.AppName)
+	err = deployer.Suspend(data.AppId)
 	if err != nil {
 		h.logger.LogError(err.Error())
 		span.SetAttributes(attribute.String("error", err.Error()))
-		return
+		return err
 	}
+
+	h.logger.LogInfoF("App '%s' suspended", data.AppId)
+	return nil
+}
+
+func (h *EventsHandlers) HandleAppResumedEvent(ctx context.Context, message *events_pb.Message) error {
+	h.logger.LogInfo("Handle 'app.resumed' event")
+	span := trace.SpanFromContext(ctx)
+
+	data := message.Data.GetAppResumedData()
+	if data == nil {
+		h.logger.LogError("Invalid app resumed message")
+		span.SetAttributes(attribute.String("error", "Invalid app resumed message"))
+		return messaging.ErrInvalidMessage
+	}
+
+	span.SetAttributes(attribute.String("app.id", data.AppId))
+
+	kubernetesClient, err := h.newKubernetesClient()
+	if err != nil {
+		h.logger.LogError(err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return err
+	}
+
+	deployer := deployer.NewDeployer(kubernetesClient)
+	err = deployer.Resume(data.AppId)
+	if err != nil {
+		h.logger.LogError(err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return err
+	}
+
+	h.logger.LogInfoF("App '%s' resumed", data.AppId)
+	return nil
+}
+
+// GetDeployParams builds the parameters to deploy the image with the current configuration
+// and environment of the app.
+func (h *EventsHandlers) GetDeployParams(ctx context.Context, appId, imageURL string, kubernetesClient kubernetes.Interface) (*deployer.DeployParams, error) {
+	h.logger.LogInfo("Resolve environemnt variables for the target app...")
+	envVars, err := h.resolveEnvironmentVariables(ctx, appId, kubernetesClient)
+	if err != nil {
+		return nil, err
+	}
+
+	h.logger.LogInfo("Fetching deployment config of the target app...")
+	getAppDeploymentConfigResponse, err := h.appServiceClient.GetAppDeploymentConfig(ctx, &app_service_pb.GetAppDeploymentConfigRequest{
+		AppId: appId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	appDeploymentConfig := getAppDeploymentConfigResponse.Config
+
+	deployParams := deployer.DeployParams{
+		AppId:      appId,
+		AppName:    appDeploymentConfig.AppName,
+		DomainName: appDeploymentConfig.DomainName,
+		ImageURL:   imageURL,
+		Type:       deployer.AppType(appDeploymentConfig.Type),
+		Schedule:   appDeploymentConfig.Schedule,
+		EnvVars:    envVars,
+		Suspended:  appDeploymentConfig.Suspended,
+
+		IdleTimeout: time.Duration(appDeploymentConfig.ScaleToZeroIdleMinutes) * time.Minute,
+		Strategy: deployer.DeploymentStrategy{
+			Type:           deployer.DeploymentStrategyType(appDeploymentConfig.DeploymentStrategy),
+			MaxSurge:       appDeploymentConfig.RollingMaxSurge,
+			MaxUnavailable: appDeploymentConfig.RollingMaxUnavailable,
+			CanaryWeight:   appDeploymentConfig.CanaryWeight,
+		},
+		PreDeployCommand:  appDeploymentConfig.PreDeployCmd,
+		PostDeployCommand: appDeploymentConfig.PostDeployCmd,
+	}
+
+	if appDeploymentConfig.Disk != nil {
+		deployParams.Disk = &deployer.Disk{
+			MountPath: appDeploymentConfig.Disk.MountPath,
+			SizeGB:    appDeploymentConfig.Disk.SizeGb,
+		}
+	}
+
+	return &deployParams, nil
+}
+
+// resolveEnvironmentVariables fetches the decrypted variables of the app and adds the
+// connection urls of its add-ons and the platform defaults. Variables set by the user win.
+func (h *EventsHandlers) resolveEnvironmentVariables(ctx context.Context, appId string, kubernetesClient kubernetes.Interface) (map[string]string, error) {
+	resolveEnvironmentVariablesResponse, err := h.appServiceClient.ResolveEnvironmentVariables(ctx, &app_service_pb.ResolveEnvironmentVariablesRequest{
+		AppId: appId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	envVars := resolveEnvironmentVariablesResponse.EnvironmentVariables
+	if envVars == nil {
+		envVars = map[string]string{}
+	}
+
+	getAppAddOnsResponse, err := h.projectServiceClient.GetAppAddOns(ctx, &project_service_pb.GetAppAddOnsRequest{
+		AppId: appId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bindings := make([]deployer.AddOnBinding, 0, len(getAppAddOnsResponse.Links))
+	for _, link := range getAppAddOnsResponse.Links {
+		bindings = append(bindings, deployer.AddOnBinding{
+			AddOnId:    link.AddOnId,
+			EnvVarName: link.EnvVarName,
+		})
+	}
+
+	addOnsDeployer := deployer.NewDeployer(kubernetesClient)
+	addOnEnvVars, err := addOnsDeployer.ResolveAddOnEnvironment(bindings)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range addOnEnvVars {
+		if _, ok := envVars[key]; !ok {
+			envVars[key] = value
+		}
+	}
+
+	// FIXME: Maybe env vars should come from a config instead of passing them to deployment
+	if _, ok := envVars["NODE_ENV"]; !ok {
+		envVars["NODE_ENV"] = "production"
+	}
+
+	return envVars, nil
+}
+
+func (h *EventsHandlers) HandleAddOnCreatedEvent(ctx context.Context, message *events_pb.Message) error {
+	h.logger.LogInfo("Handle 'addon.created' event")
+	span := trace.SpanFromContext(ctx)
+
+	data := message.Data.GetAddOnCreatedData()
+	if data == nil {
+		h.logger.LogError("Invalid add-on created message")
+		span.SetAttributes(attribute.String("error", "Invalid add-on created message"))
+		return messaging.ErrInvalidMessage
+	}
+
+	span.SetAttributes(
+		attribute.String("add_on.id", data.AddOnId),
+		attribute.String("add_on.type", data.Type),
+		attribute.String("project.id", data.ProjectId),
+	)
+
+	handleProvisionFailure := func(err error) {
+		h.logger.LogError(err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		h.eventBus.Publish(ctx, events_pb.EventName_ADDON_PROVISION_FAILED, &events_pb.EventData{
+			Value: &events_pb.EventData_AddOnProvisionFailedData{
+				AddOnProvisionFailedData: &events_pb.AddOnProvisionFailedEventData{
+					AddOnId: data.AddOnId,
+					Reason:  err.Error(),
+				},
+			},
+		})
+	}
+
+	kubernetesClient, err := h.newKubernetesClient()
+	if err != nil {
+		handleProvisionFailure(err)
+		return nil
+	}
+
+	addOnType := deployer.AddOnType(data.Type)
+	deployer := deployer.NewDeployer(kubernetesClient)
+	connection, err := deployer.ProvisionAddOn(data.AddOnId, data.ProjectId, addOnType)
+	if err != nil {
+		handleProvisionFailure(err)
+		return nil
+	}
+
+	h.logger.LogInfo("Publishing 'addon.provisioned' event...")
+	h.eventBus.Publish(ctx, events_pb.EventName_ADDON_PROVISIONED, &events_pb.EventData{
+		Value: &events_pb.EventData_AddOnProvisionedData{
+			AddOnProvisionedData: &events_pb.AddOnProvisionedEventData{
+				AddOnId:  data.AddOnId,
+				Host:     connection.Host,
+				Port:     connection.Port,
+				Database: connection.Database,
+				Username: connection.Username,
+			},
+		},
+	})
+
+	return nil
+}
+
+func (h *EventsHandlers) HandleAddOnDeletedEvent(ctx context.Context, message *events_pb.Message) error {
+	h.logger.LogInfo("Handle 'addon.deleted' event")
+	span := trace.SpanFromContext(ctx)
+
+	data := message.Data.GetAddOnDeletedData()
+	if data == nil {
+		h.logger.LogError("Invalid add-on deleted message")
+		span.SetAttributes(attribute.String("error", "Invalid add-on deleted message"))
+		return messaging.ErrInvalidMessage
+	}
+
+	span.SetAttributes(
+		attribute.String("add_on.id", data.AddOnId),
+		attribute.String("project.id", data.ProjectId),
+	)
+
+	kubernetesClient, err := h.newKubernetesClient()
+	if err != nil {
+		h.logger.LogError(err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return err
+	}
+
+	deployer := deployer.NewDeployer(kubernetesClient)
+	err = deployer.DestroyAddOn(data.AddOnId)
+	if err != nil {
+		h.logger.LogError(err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return err
+	}
+
+	return nil
 }
@@ -0,0 +1,506 @@
+package eventshandlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"apps-hosting.com/deployservice/internal/deployer"
+	"apps-hosting.com/deployservice/internal/models"
+	"apps-hosting.com/deployservice/internal/repositories"
+	"apps-hosting.com/deployservice/proto/app_service_pb"
+	"apps-hosting.com/deployservice/proto/project_service_pb"
+	"apps-hosting.com/logging"
+	"apps-hosting.com/messaging"
+	"apps-hosting.com/messaging/proto/events_pb"
+
+	v1Apps "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newSuspendTest subscribes HandleAppSuspendedEvent to a MemoryBus, the cluster fails the first
+// patches of the Deployment of the app.
+func newSuspendTest(t *testing.T, failedPatches int) (*messaging.MemoryBus, *fake.Clientset) {
+	t.Helper()
+
+	replicas := int32(1)
+	kubernetesClient := fake.NewClientset(&v1Apps.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web",
+			Namespace: deployer.NAMESPACE,
+			Labels:    map[string]string{"app_id": "app-1"},
+		},
+		Spec: v1Apps.DeploymentSpec{Replicas: &replicas},
+	})
+	kubernetesClient.PrependReactor("patch", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if failedPatches > 0 {
+			failedPatches--
+			return true, nil, errors.New("etcdserver: request timed out")
+		}
+		return false, nil, nil
+	})
+
+	eventBus := messaging.NewMemoryBus("deploy-service")
+	eventsHandlers := NewEventsHandlers(eventBus, nil, nil, &repositories.DeploymentRepository{}, logging.NewServiceLogger(logging.ServiceDeploy))
+	eventsHandlers.newKubernetesClient = func() (kubernetes.Interface, error) {
+		return kubernetesClient, nil
+	}
+
+	err := eventBus.Subscribe(events_pb.EventName_APP_SUSPENDED, eventsHandlers.HandleAppSuspendedEvent)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	return eventBus, kubernetesClient
+}
+
+func deploymentReplicas(t *testing.T, kubernetesClient kubernetes.Interface) int32 {
+	t.Helper()
+
+	deployment, err := kubernetesClient.AppsV1().Deployments(deployer.NAMESPACE).Get(context.Background(), "web", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get deployment: %v", err)
+	}
+
+	return *deployment.Spec.Replicas
+}
+
+func TestHandleAppSuspendedEvent(t *testing.T) {
+	appSuspendedData := &events_pb.EventData{
+		Value: &events_pb.EventData_AppSuspendedData{
+			AppSuspendedData: &events_pb.AppSuspendedEventData{AppId: "app-1"},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		data                *events_pb.EventData
+		failedPatches       int
+		expectedReplicas    int32
+		expectedDeliveries  uint64
+		expectedDeadLetters int
+	}{
+		{
+			name:             "suspended on the first delivery",
+			data:             appSuspendedData,
+			expectedReplicas: 0,
+		},
+		{
+			name:             "delivered again while the cluster fails",
+			data:             appSuspendedData,
+			failedPatches:    messaging.DefaultMaxDeliver - 1,
+			expectedReplicas: 0,
+		},
+		{
+			name:                "dead-lettered once every delivery failed",
+			data:                appSuspendedData,
+			failedPatches:       messaging.DefaultMaxDeliver,
+			expectedReplicas:    1,
+			expectedDeliveries:  messaging.DefaultMaxDeliver,
+			expectedDeadLetters: 1,
+		},
+		{
+			name: "invalid message dead-lettered right away",
+			data: &events_pb.EventData{
+				Value: &events_pb.EventData_AppDeletedData{
+					AppDeletedData: &events_pb.AppDeletedEventData{AppId: "app-1"},
+				},
+			},
+			expectedReplicas:    1,
+			expectedDeliveries:  1,
+			expectedDeadLetters: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			eventBus, kubernetesClient := newSuspendTest(t, test.failedPatches)
+
+			err := eventBus.Publish(context.Background(), events_pb.EventName_APP_SUSPENDED, test.data)
+			if err != nil {
+				t.Fatalf("Publish: %v", err)
+			}
+
+			if replicas := deploymentReplicas(t, kubernetesClient); replicas != test.expectedReplicas {
+				t.Errorf("deployment has %d replicas, expected %d", replicas, test.expectedReplicas)
+			}
+
+			deadLetters := eventBus.DeadLetters()
+			if len(deadLetters) != test.expectedDeadLetters {
+				t.Fatalf("%d dead letters, expected %d", len(deadLetters), test.expectedDeadLetters)
+			}
+			if len(deadLetters) > 0 {
+				if deadLetters[0].Deliveries != test.expectedDeliveries {
+					t.Errorf("dead-lettered after %d deliveries, expected %d", deadLetters[0].Deliveries, test.expectedDeliveries)
+				}
+				if deadLetters[0].Consumer != "deploy-service-app-suspended" {
+					t.Errorf("dead-lettered by %q, expected deploy-service-app-suspended", deadLetters[0].Consumer)
+				}
+			}
+		})
+	}
+}
+
+func TestHandleAppSuspendedEventRedelivered(t *testing.T) {
+	eventBus, kubernetesClient := newSuspendTest(t, 0)
+
+	err := eventBus.Publish(context.Background(), events_pb.EventName_APP_SUSPENDED, &events_pb.EventData{
+		Value: &events_pb.EventData_AppSuspendedData{
+			AppSuspendedData: &events_pb.AppSuspendedEventData{AppId: "app-1"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+
+	// the acknowledgement was lost, the app is suspended again
+	err = eventBus.Redeliver(eventBus.Published()[0].Id)
+	if err != nil {
+		t.Fatalf("Redeliver: %v", err)
+	}
+
+	if replicas := deploymentReplicas(t, kubernetesClient); replicas != 0 {
+		t.Errorf("deployment has %d replicas, expected 0", replicas)
+	}
+	if deadLetters := eventBus.DeadLetters(); len(deadLetters) != 0 {
+		t.Errorf("%d dead letters, expected none", len(deadLetters))
+	}
+}
+
+// fakeDeploymentRepository keeps the deployments in memory, it fails the first deployments created with
+// failure.
+type fakeDeploymentRepository struct {
+	repositories.DeploymentRepositoryInterface
+
+	failures    int
+	failure     error
+	deployments []*models.Deployment
+}
+
+func (r *fakeDeploymentRepository) CreateDeployment(ctx context.Context, buildId, appId string, createDeploymentParams repositories.CreateDeploymentParams) (*models.Deployment, error) {
+	if r.failures > 0 {
+		r.failures--
+		return nil, r.failure
+	}
+
+	deployment := &models.Deployment{
+		Id:       fmt.Sprintf("deployment-%d", len(r.deployments)+1),
+		AppId:    appId,
+		BuildId:  buildId,
+		ImageURL: createDeploymentParams.ImageURL,
+		Status:   createDeploymentParams.Status,
+		Kind:     createDeploymentParams.Kind,
+	}
+	r.deployments = append(r.deployments, deployment)
+	return deployment, nil
+}
+
+func (r *fakeDeploymentRepository) GetLatestDeployment(ctx context.Context, appId string, status models.DeploymentStatus) (*models.Deployment, error) {
+	for i := len(r.deployments) - 1; i >= 0; i-- {
+		if r.deployments[i].AppId == appId && r.deployments[i].Status == status {
+			return r.deployments[i], nil
+		}
+	}
+
+	return nil, repositories.ErrDeploymentNotFound
+}
+
+func (r *fakeDeploymentRepository) UpdateDeploymentById(ctx context.Context, deploymentId string, updateDeploymentParams repositories.UpdateDeploymentParams) (*models.Deployment, error) {
+	for _, deployment := range r.deployments {
+		if deployment.Id == deploymentId {
+			deployment.Status = updateDeploymentParams.Status
+			return deployment, nil
+		}
+	}
+
+	return nil, repositories.ErrDeploymentNotFound
+}
+
+func (r *fakeDeploymentRepository) UpdateDeploymentsByStatus(ctx context.Context, appId string, status models.DeploymentStatus, updateDeploymentParams repositories.UpdateDeploymentParams) error {
+	for _, deployment := range r.deployments {
+		if deployment.AppId == appId && deployment.Status == status {
+			deployment.Status = updateDeploymentParams.Status
+		}
+	}
+
+	return nil
+}
+
+// fakeAppServiceClient serves the configuration of a web service, or NotFound once the app is deleted.
+type fakeAppServiceClient struct {
+	app_service_pb.AppServiceClient
+
+	deleted bool
+}
+
+func (c fakeAppServiceClient) GetAppDeploymentConfig(ctx context.Context, in *app_service_pb.GetAppDeploymentConfigRequest, opts ...grpc.CallOption) (*app_service_pb.GetAppDeploymentConfigResponse, error) {
+	if c.deleted {
+		return nil, status.Error(codes.NotFound, "app not found")
+	}
+
+	return &app_service_pb.GetAppDeploymentConfigResponse{
+		Config: &app_service_pb.AppDeploymentConfig{
+			AppId:      in.AppId,
+			AppName:    "web",
+			DomainName: "web.apps-hosting.com",
+			Type:       string(deployer.AppTypeWebService),
+		},
+	}, nil
+}
+
+func (c fakeAppServiceClient) ResolveEnvironmentVariables(ctx context.Context, in *app_service_pb.ResolveEnvironmentVariablesRequest, opts ...grpc.CallOption) (*app_service_pb.ResolveEnvironmentVariablesResponse, error) {
+	if c.deleted {
+		return nil, status.Error(codes.NotFound, "app not found")
+	}
+
+	return &app_service_pb.ResolveEnvironmentVariablesResponse{
+		EnvironmentVariables: map[string]string{"PORT": "8080"},
+	}, nil
+}
+
+type fakeProjectServiceClient struct {
+	project_service_pb.ProjectServiceClient
+}
+
+func (c fakeProjectServiceClient) GetAppAddOns(ctx context.Context, in *project_service_pb.GetAppAddOnsRequest, opts ...grpc.CallOption) (*project_service_pb.GetAppAddOnsResponse, error) {
+	return &project_service_pb.GetAppAddOnsResponse{}, nil
+}
+
+func TestHandleBuildCompletedEvent(t *testing.T) {
+	buildCompletedData := &events_pb.EventData{
+		Value: &events_pb.EventData_BuildCompletedData{
+			BuildCompletedData: &events_pb.BuildCompletedData{
+				AppId:    "app-1",
+				AppName:  "web",
+				BuildId:  "build-1",
+				ImageUrl: "registry.apps-hosting.com/web:build-1",
+			},
+		},
+	}
+	transientFailure := errors.New("connection reset by peer")
+
+	tests := []struct {
+		name                 string
+		data                 *events_pb.EventData
+		appDeleted           bool
+		failedDeployments    int
+		clusterFails         bool
+		expectedImage        string
+		expectedStatus       models.DeploymentStatus
+		expectedEvents       []events_pb.EventName
+		expectedDeadLetterOf uint64
+	}{
+		{
+			name:           "deployed",
+			data:           buildCompletedData,
+			expectedImage:  "registry.apps-hosting.com/web:build-1",
+			expectedStatus: models.DeploymentStatusSuccessed,
+			expectedEvents: []events_pb.EventName{events_pb.EventName_DEPLOY_COMPLETED},
+		},
+		{
+			name:              "delivered again while the database fails",
+			data:              buildCompletedData,
+			failedDeployments: 2,
+			expectedImage:     "registry.apps-hosting.com/web:build-1",
+			expectedStatus:    models.DeploymentStatusSuccessed,
+			expectedEvents:    []events_pb.EventName{events_pb.EventName_DEPLOY_COMPLETED},
+		},
+		{
+			name:           "failure of the cluster reported with deploy.failed",
+			data:           buildCompletedData,
+			clusterFails:   true,
+			expectedStatus: models.DeploymentStatusFailed,
+			expectedEvents: []events_pb.EventName{events_pb.EventName_DEPLOY_FAILED},
+		},
+		{
+			name:           "app deleted since",
+			data:           buildCompletedData,
+			appDeleted:     true,
+			expectedStatus: models.DeploymentStatusFailed,
+		},
+		{
+			name:                 "dead-lettered once every delivery failed",
+			data:                 buildCompletedData,
+			failedDeployments:    messaging.DefaultMaxDeliver,
+			expectedDeadLetterOf: messaging.DefaultMaxDeliver,
+		},
+		{
+			name: "invalid message dead-lettered right away",
+			data: &events_pb.EventData{
+				Value: &events_pb.EventData_BuildFailedData{
+					BuildFailedData: &events_pb.BuildFailedData{AppId: "app-1", BuildId: "build-1"},
+				},
+			},
+			expectedDeadLetterOf: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kubernetesClient := fake.NewClientset()
+			if test.clusterFails {
+				kubernetesClient.PrependReactor("create", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, errors.New("etcdserver: request timed out")
+				})
+			}
+
+			deploymentRepository := &fakeDeploymentRepository{failures: test.failedDeployments, failure: transientFailure}
+			eventBus := messaging.NewMemoryBus("deploy-service")
+			eventsHandlers := NewEventsHandlers(
+				eventBus,
+				fakeAppServiceClient{deleted: test.appDeleted},
+				fakeProjectServiceClient{},
+				deploymentRepository,
+				logging.NewServiceLogger(logging.ServiceDeploy),
+			)
+			eventsHandlers.newKubernetesClient = func() (kubernetes.Interface, error) {
+				return kubernetesClient, nil
+			}
+
+			err := eventBus.Subscribe(events_pb.EventName_BUILD_COMPLETED, eventsHandlers.HandleBuildCompletedEvent)
+			if err != nil {
+				t.Fatalf("Subscribe: %v", err)
+			}
+
+			err = eventBus.Publish(context.Background(), events_pb.EventName_BUILD_COMPLETED, test.data)
+			if err != nil {
+				t.Fatalf("Publish: %v", err)
+			}
+
+			deadLetters := eventBus.DeadLetters()
+			if test.expectedDeadLetterOf != 0 {
+				if len(deadLetters) != 1 || deadLetters[0].Deliveries != test.expectedDeadLetterOf {
+					t.Fatalf("dead letters %+v, expected one after %d deliveries", deadLetters, test.expectedDeadLetterOf)
+				}
+				if len(deploymentRepository.deployments) != 0 {
+					t.Errorf("%d deployments recorded, expected none", len(deploymentRepository.deployments))
+				}
+				return
+			}
+			if len(deadLetters) != 0 {
+				t.Fatalf("%d dead letters, expected none", len(deadLetters))
+			}
+
+			if len(deploymentRepository.deployments) != 1 {
+				t.Fatalf("%d deployments recorded, expected 1", len(deploymentRepository.deployments))
+			}
+			deployment := deploymentRepository.deployments[0]
+			if deployment.Status != test.expectedStatus || deployment.Kind != models.DeploymentKindBuild || deployment.BuildId != "build-1" {
+				t.Errorf("deployment %+v, expected a %s build deployment of build-1", deployment, test.expectedStatus)
+			}
+
+			publishedEvents := []events_pb.EventName{}
+			for _, message := range eventBus.Published(events_pb.EventName_DEPLOY_COMPLETED, events_pb.EventName_DEPLOY_FAILED) {
+				publishedEvents = append(publishedEvents, message.EventName)
+			}
+			if !slices.Equal(publishedEvents, test.expectedEvents) {
+				t.Errorf("published %v, expected %v", publishedEvents, test.expectedEvents)
+			}
+
+			if test.expectedImage == "" {
+				return
+			}
+
+			kubernetesDeployment, err := kubernetesClient.AppsV1().Deployments(deployer.NAMESPACE).Get(context.Background(), deployer.ToK8sDeploymentName("web"), metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("failed to get deployment: %v", err)
+			}
+			if image := kubernetesDeployment.Spec.Template.Spec.Containers[0].Image; image != test.expectedImage {
+				t.Errorf("deployment runs %q, expected %q", image, test.expectedImage)
+			}
+		})
+	}
+}
+
+func TestHandleAppEnvUpdatedEvent(t *testing.T) {
+	replicas := int32(1)
+	kubernetesClient := fake.NewClientset(&v1Apps.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deployer.ToK8sDeploymentName("web"),
+			Namespace: deployer.NAMESPACE,
+			Labels:    map[string]string{"app_id": "app-1", "app_name": "web"},
+		},
+		Spec: v1Apps.DeploymentSpec{Replicas: &replicas},
+		// the fake cluster does not roll the pods, the deployment is already available
+		Status: v1Apps.DeploymentStatus{Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1},
+	})
+
+	deploymentRepository := &fakeDeploymentRepository{}
+	deploymentRepository.CreateDeployment(context.Background(), "build-1", "app-1", repositories.CreateDeploymentParams{
+		ImageURL: "registry.apps-hosting.com/web:build-1",
+		Status:   models.DeploymentStatusSuccessed,
+		Kind:     models.DeploymentKindBuild,
+	})
+
+	eventBus := messaging.NewMemoryBus("deploy-service")
+	eventsHandlers := NewEventsHandlers(
+		eventBus,
+		fakeAppServiceClient{},
+		fakeProjectServiceClient{},
+		deploymentRepository,
+		logging.NewServiceLogger(logging.ServiceDeploy),
+	)
+	eventsHandlers.newKubernetesClient = func() (kubernetes.Interface, error) {
+		return kubernetesClient, nil
+	}
+
+	err := eventBus.Subscribe(events_pb.EventName_APP_ENV_UPDATED, eventsHandlers.HandleAppEnvUpdatedEvent)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	for _, appId := range []string{"app-1", "app-2"} {
+		err = eventBus.Publish(context.Background(), events_pb.EventName_APP_ENV_UPDATED, &events_pb.EventData{
+			Value: &events_pb.EventData_AppEnvUpdatedData{
+				AppEnvUpdatedData: &events_pb.AppEnvUpdatedEventData{AppId: appId},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Publish: %v", err)
+		}
+	}
+
+	// app-2 is not deployed yet, its first deployment uses the new environment
+	if len(deploymentRepository.deployments) != 2 {
+		t.Fatalf("%d deployments recorded, expected 2", len(deploymentRepository.deployments))
+	}
+
+	deployment := deploymentRepository.deployments[1]
+	expected := models.Deployment{
+		Id:       deployment.Id,
+		AppId:    "app-1",
+		BuildId:  "build-1",
+		ImageURL: "registry.apps-hosting.com/web:build-1",
+		Status:   models.DeploymentStatusSuccessed,
+		Kind:     models.DeploymentKindEnvUpdate,
+	}
+	if *deployment != expected {
+		t.Errorf("deployment %+v, expected %+v", *deployment, expected)
+	}
+
+	published := eventBus.Published(events_pb.EventName_DEPLOY_COMPLETED, events_pb.EventName_DEPLOY_FAILED)
+	if len(published) != 1 || published[0].Data.GetDeployCompletedData().GetDeployId() != deployment.Id {
+		t.Errorf("published %v, expected deploy.completed of %s", published, deployment.Id)
+	}
+
+	kubernetesDeployment, err := kubernetesClient.AppsV1().Deployments(deployer.NAMESPACE).Get(context.Background(), deployer.ToK8sDeploymentName("web"), metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get deployment: %v", err)
+	}
+	if _, ok := kubernetesDeployment.Spec.Template.Annotations[deployer.EnvChecksumAnnotation]; !ok {
+		t.Error("the pods of the deployment were not restarted with the new environment")
+	}
+	if deadLetters := eventBus.DeadLetters(); len(deadLetters) != 0 {
+		t.Errorf("%d dead letters, expected none", len(deadLetters))
+	}
+}
@@ -0,0 +1,55 @@
+package idler
+
+import (
+	"context"
+	"time"
+
+	"apps-hosting.com/deployservice/internal/deployer"
+	"apps-hosting.com/deployservice/internal/eventshandlers"
+	"apps-hosting.com/logging"
+)
+
+// Idler periodically scales to zero the apps which stopped receiving requests.
+type Idler struct {
+	interval time.Duration
+	logger   logging.ServiceLogger
+}
+
+func NewIdler(interval time.Duration, logger logging.ServiceLogger) Idler {
+	return Idler{
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+func (i *Idler) Run(ctx context.Context) {
+	ticker := time.NewTicker(i.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			i.scaleIdleAppsToZero(now)
+		}
+	}
+}
+
+func (i *Idler) scaleIdleAppsToZero(now time.Time) {
+	kubernetesClient, err := eventshandlers.NewKubernetesClient()
+	if err != nil {
+		i.logger.LogError(err.Error())
+		return
+	}
+
+	deployer := deployer.NewDeployer(kubernetesClient)
+	scaled, err := deployer.ScaleIdleAppsToZero(now)
+	if err != nil {
+		i.logger.LogError(err.Error())
+	}
+
+	if scaled > 0 {
+		i.logger.LogInfoF("Scaled %d idle apps to zero", scaled)
+	}
+}
@@ -0,0 +1,112 @@
+package janitor
+
+import (
+	"context"
+	"time"
+
+	"apps-hosting.com/deployservice/internal/deployer"
+	"apps-hosting.com/deployservice/internal/eventshandlers"
+	"apps-hosting.com/deployservice/proto/app_service_pb"
+	"apps-hosting.com/logging"
+)
+
+// Janitor periodically removes the cluster resources whose grace period is over,
+// and the resources left behind by apps that no longer exist.
+type Janitor struct {
+	interval         time.Duration
+	appServiceClient app_service_pb.AppServiceClient
+	logger           logging.ServiceLogger
+}
+
+func NewJanitor(interval time.Duration, appServiceClient app_service_pb.AppServiceClient, logger logging.ServiceLogger) Janitor {
+	return Janitor{
+		interval:         interval,
+		appServiceClient: appServiceClient,
+		logger:           logger,
+	}
+}
+
+func (j *Janitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			j.deleteOrphanedResources(ctx)
+			j.deleteExpiredDisks(now)
+		}
+	}
+}
+
+func (j *Janitor) deleteExpiredDisks(now time.Time) {
+	kubernetesClient, err := eventshandlers.NewKubernetesClient()
+	if err != nil {
+		j.logger.LogError(err.Error())
+		return
+	}
+
+	deployer := deployer.NewDeployer(kubernetesClient)
+	deleted, err := deployer.DeleteExpiredDisks(now)
+	if err != nil {
+		j.logger.LogError(err.Error())
+	}
+
+	if deleted > 0 {
+		j.logger.LogInfoF("Deleted %d expired disks", deleted)
+	}
+}
+
+// deleteOrphanedResources destroys the resources labelled with the id of an app the app service
+// does not know, which happens when the handling of its deletion failed for good.
+func (j *Janitor) deleteOrphanedResources(ctx context.Context) {
+	kubernetesClient, err := eventshandlers.NewKubernetesClient()
+	if err != nil {
+		j.logger.LogError(err.Error())
+		return
+	}
+
+	deployer := deployer.NewDeployer(kubernetesClient)
+	appIds, err := deployer.AppIds()
+	if err != nil {
+		j.logger.LogError(err.Error())
+		return
+	}
+
+	if len(appIds) == 0 {
+		return
+	}
+
+	// nothing is deleted when the app service can not tell which apps exist
+	response, err := j.appServiceClient.GetExistingAppIds(ctx, &app_service_pb.GetExistingAppIdsRequest{AppIds: appIds})
+	if err != nil {
+		j.logger.LogError(err.Error())
+		return
+	}
+
+	existingAppIds := map[string]bool{}
+	for _, appId := range response.AppIds {
+		existingAppIds[appId] = true
+	}
+
+	destroyed := 0
+	for _, appId := range appIds {
+		if existingAppIds[appId] {
+			continue
+		}
+
+		err := deployer.Destroy(appId)
+		if err != nil {
+			j.logger.LogErrorF("failed to destroy the resources of deleted app %q: %v", appId, err)
+			continue
+		}
+
+		destroyed++
+	}
+
+	if destroyed > 0 {
+		j.logger.LogInfoF("Destroyed the resources of %d deleted apps", destroyed)
+	}
+}
@@ -8,12 +8,32 @@ const (
 	DeploymentStatusPending   DeploymentStatus = "pending"
 	DeploymentStatusSuccessed DeploymentStatus = "successed"
 	DeploymentStatusFailed    DeploymentStatus = "failed"
+	// DeploymentStatusCanary is a deployment serving part of the requests until it is promoted or aborted.
+	DeploymentStatusCanary  DeploymentStatus = "canary"
+	DeploymentStatusAborted DeploymentStatus = "aborted"
+)
+
+type DeploymentKind string
+
+const (
+	DeploymentKindBuild DeploymentKind = "build"
+	// DeploymentKindRestart replaces every instance of the app with the image it already runs,
+	// restarts record the user who asked for them in TriggeredBy.
+	DeploymentKindRestart DeploymentKind = "restart"
+	// DeploymentKindInstanceRestart replaces the single instance named by InstanceName.
+	DeploymentKindInstanceRestart DeploymentKind = "instance_restart"
+	// DeploymentKindEnvUpdate restarts the app with the image it already runs once its environment changed.
+	DeploymentKindEnvUpdate DeploymentKind = "env_update"
 )
 
 type Deployment struct {
-	Id        string           `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
-	BuildId   string           `bun:"build_id" json:"build_id"`
-	AppId     string           `bun:"app_id" json:"app_id"`
-	Status    DeploymentStatus `bun:"status" json:"status"`
-	CreatedAt time.Time        `bun:"created_at,default:now()" json:"created_at"`
+	Id           string           `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	BuildId      string           `bun:"build_id" json:"build_id"`
+	AppId        string           `bun:"app_id" json:"app_id"`
+	ImageURL     string           `bun:"image_url" json:"image_url"`
+	Status       DeploymentStatus `bun:"status" json:"status"`
+	Kind         DeploymentKind   `bun:"kind" json:"kind"`
+	TriggeredBy  string           `bun:"triggered_by" json:"triggered_by"`
+	InstanceName string           `bun:"instance_name" json:"instance_name"`
+	CreatedAt    time.Time        `bun:"created_at,default:now()" json:"created_at"`
 }
@@ -0,0 +1,176 @@
+package reconciler
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"apps-hosting.com/deployservice/internal/deployer"
+	"apps-hosting.com/deployservice/internal/eventshandlers"
+	"apps-hosting.com/deployservice/internal/models"
+	"apps-hosting.com/deployservice/internal/repositories"
+	"apps-hosting.com/logging"
+	"apps-hosting.com/messaging"
+	"apps-hosting.com/messaging/proto/events_pb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/kubernetes"
+)
+
+const DefaultReconcileInterval = 5 * time.Minute
+
+// a pending deployment older than this was interrupted, it no longer holds the reconciliation back
+const deploymentInProgressTimeout = time.Hour
+
+// ReconcileInterval is how often the apps in the cluster are compared with their last successful deployment.
+func ReconcileInterval() time.Duration {
+	interval, err := time.ParseDuration(os.Getenv("RECONCILE_INTERVAL"))
+	if err != nil || interval <= 0 {
+		return DefaultReconcileInterval
+	}
+
+	return interval
+}
+
+// Reconciler periodically compares the resources of every app with its last successful deployment,
+// deploys it again when they drifted apart and reports what it found on the event bus.
+type Reconciler struct {
+	interval             time.Duration
+	eventsHandlers       eventshandlers.EventsHandlers
+	deploymentRepository repositories.DeploymentRepository
+	eventBus             messaging.Bus
+	logger               logging.ServiceLogger
+}
+
+func NewReconciler(
+	interval time.Duration,
+	eventsHandlers eventshandlers.EventsHandlers,
+	deploymentRepository repositories.DeploymentRepository,
+	eventBus messaging.Bus,
+	logger logging.ServiceLogger,
+) Reconciler {
+	return Reconciler{
+		interval:             interval,
+		eventsHandlers:       eventsHandlers,
+		deploymentRepository: deploymentRepository,
+		eventBus:             eventBus,
+		logger:               logger,
+	}
+}
+
+func (r *Reconciler) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.reconcile(ctx)
+		}
+	}
+}
+
+func (r *Reconciler) reconcile(ctx context.Context) {
+	deployments, err := r.deploymentRepository.GetLatestSuccessfulDeployments(ctx)
+	if err != nil {
+		r.logger.LogError(err.Error())
+		return
+	}
+
+	kubernetesClient, err := eventshandlers.NewKubernetesClient()
+	if err != nil {
+		r.logger.LogError(err.Error())
+		return
+	}
+
+	repaired := 0
+	for _, deployment := range deployments {
+		if r.reconcileApp(ctx, kubernetesClient, deployment) {
+			repaired++
+		}
+	}
+
+	if repaired > 0 {
+		r.logger.LogInfoF("Repaired the resources of %d apps", repaired)
+	}
+}
+
+// reconcileApp returns whether the resources of the app were repaired.
+func (r *Reconciler) reconcileApp(ctx context.Context, kubernetesClient kubernetes.Interface, deployment models.Deployment) bool {
+	// a deployment in progress changes the resources on its own
+	pendingDeployment, err := r.deploymentRepository.GetLatestDeployment(ctx, deployment.AppId, models.DeploymentStatusPending)
+	if err != nil && err != repositories.ErrDeploymentNotFound {
+		r.logger.LogError(err.Error())
+		return false
+	}
+	if pendingDeployment != nil && pendingDeployment.CreatedAt.After(deployment.CreatedAt) && time.Since(pendingDeployment.CreatedAt) < deploymentInProgressTimeout {
+		return false
+	}
+
+	appDeployer := deployer.NewDeployer(kubernetesClient)
+
+	// so does a canary waiting to be promoted or aborted
+	hasCanary, err := appDeployer.HasCanary(deployment.AppId)
+	if err != nil {
+		r.logger.LogError(err.Error())
+		return false
+	}
+	if hasCanary {
+		return false
+	}
+
+	deployParams, err := r.eventsHandlers.GetDeployParams(ctx, deployment.AppId, deployment.ImageURL, kubernetesClient)
+	if status.Code(err) == codes.NotFound {
+		// the app was deleted, the janitor removes what is left of it
+		return false
+	}
+	if err != nil {
+		r.logger.LogError(err.Error())
+		return false
+	}
+
+	discrepancies, err := appDeployer.Drift(*deployParams)
+	if err != nil {
+		r.logger.LogError(err.Error())
+		return false
+	}
+
+	if len(discrepancies) == 0 {
+		return false
+	}
+
+	r.logger.LogInfoF("App %q drifted from deployment %q: %v", deployParams.AppName, deployment.Id, discrepancies)
+
+	// a repair puts the last successful version back without running its deploy commands again,
+	// and it takes every request at once
+	deployParams.PreDeployCommand = ""
+	deployParams.PostDeployCommand = ""
+	if deployParams.Strategy.Type == deployer.DeploymentStrategyCanary {
+		deployParams.Strategy.Type = deployer.DeploymentStrategyBlueGreen
+	}
+
+	reason := ""
+	err = appDeployer.Deploy(*deployParams)
+	if err != nil {
+		r.logger.LogError(err.Error())
+		reason = err.Error()
+	}
+
+	r.eventBus.Publish(ctx, events_pb.EventName_DEPLOY_DRIFT_DETECTED, &events_pb.EventData{
+		Value: &events_pb.EventData_DeployDriftDetectedData{
+			DeployDriftDetectedData: &events_pb.DeployDriftDetectedData{
+				AppId:         deployment.AppId,
+				AppName:       deployParams.AppName,
+				DeploymentId:  deployment.Id,
+				Discrepancies: discrepancies,
+				Repaired:      err == nil,
+				Reason:        reason,
+			},
+		},
+	})
+
+	return err == nil
+}
@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 
 	"apps-hosting.com/deployservice/internal/models"
 	"apps-hosting.com/logging"
@@ -11,13 +12,28 @@ import (
 )
 
 type CreateDeploymentParams struct {
-	Status models.DeploymentStatus
+	ImageURL     string
+	Status       models.DeploymentStatus
+	Kind         models.DeploymentKind
+	TriggeredBy  string
+	InstanceName string
 }
 
 type UpdateDeploymentParams struct {
 	Status models.DeploymentStatus
 }
 
+type DeploymentRepositoryInterface interface {
+	CreateDeploymentsTable() (sql.Result, error)
+	CreateDeployment(ctx context.Context, buildId, appId string, createDeploymentParams CreateDeploymentParams) (*models.Deployment, error)
+	GetDeployments(ctx context.Context, appId string) ([]models.Deployment, error)
+	GetLatestDeployment(ctx context.Context, appId string, status models.DeploymentStatus) (*models.Deployment, error)
+	GetLatestSuccessfulDeployments(ctx context.Context) ([]models.Deployment, error)
+	DeleteDeployments(ctx context.Context, appId string) error
+	UpdateDeploymentById(ctx context.Context, deploymentId string, updateDeploymentParams UpdateDeploymentParams) (*models.Deployment, error)
+	UpdateDeploymentsByStatus(ctx context.Context, appId string, status models.DeploymentStatus, updateDeploymentParams UpdateDeploymentParams) error
+}
+
 type DeploymentRepository struct {
 	Database *bun.DB
 	Logger   logging.ServiceLogger
@@ -32,14 +48,34 @@ func NewDeploymentRepository(database *bun.DB, logger logging.ServiceLogger) Dep
 
 func (repository *DeploymentRepository) CreateDeploymentsTable() (sql.Result, error) {
 	repository.Logger.LogInfo("Creating deployments table.")
-	return repository.Database.NewCreateTable().Model((*models.Deployment)(nil)).IfNotExists().Exec(context.Background())
+	result, err := repository.Database.NewCreateTable().Model((*models.Deployment)(nil)).IfNotExists().Exec(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	// Columns added after the table was first released.
+	err = addColumnsIfNotExists(repository.Database, (*models.Deployment)(nil),
+		"image_url VARCHAR NOT NULL DEFAULT ''",
+		"kind VARCHAR NOT NULL DEFAULT 'build'",
+		"triggered_by VARCHAR NOT NULL DEFAULT ''",
+		"instance_name VARCHAR NOT NULL DEFAULT ''",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
 
 func (repository *DeploymentRepository) CreateDeployment(ctx context.Context, buildId, appId string, createDeploymentParams CreateDeploymentParams) (*models.Deployment, error) {
 	deployment := models.Deployment{
-		BuildId: buildId,
-		AppId:   appId,
-		Status:  createDeploymentParams.Status,
+		BuildId:      buildId,
+		AppId:        appId,
+		ImageURL:     createDeploymentParams.ImageURL,
+		Status:       createDeploymentParams.Status,
+		Kind:         createDeploymentParams.Kind,
+		TriggeredBy:  createDeploymentParams.TriggeredBy,
+		InstanceName: createDeploymentParams.InstanceName,
 	}
 	_, err := repository.Database.NewInsert().Model(&deployment).Exec(ctx)
 	if err != nil {
@@ -69,19 +105,62 @@ func (repository *DeploymentRepository) GetDeployments(ctx context.Context, appI
 	return deployments, nil
 }
 
+func (repository *DeploymentRepository) GetLatestDeployment(ctx context.Context, appId string, status models.DeploymentStatus) (*models.Deployment, error) {
+	deployment := models.Deployment{}
+	err := repository.Database.
+		NewSelect().
+		Model(&deployment).
+		Where("app_id = ? AND status = ?", appId, status).
+		Order("created_at DESC").
+		Limit(1).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrDeploymentNotFound
+		}
+		return nil, err
+	}
+
+	return &deployment, nil
+}
+
+// GetLatestSuccessfulDeployments returns the last successful deployment of every app, the one
+// its resources in the cluster are expected to match. Deployments recorded before the image
+// was stored are left out.
+func (repository *DeploymentRepository) GetLatestSuccessfulDeployments(ctx context.Context) ([]models.Deployment, error) {
+	deployments := []models.Deployment{}
+	err := repository.Database.
+		NewSelect().
+		Model(&deployments).
+		DistinctOn("app_id").
+		Where("status = ? AND image_url != ''", models.DeploymentStatusSuccessed).
+		Order("app_id", "created_at DESC").
+		Scan(ctx)
+
+	if err != nil {
+		return []models.Deployment{}, err
+	}
+
+	return deployments, nil
+}
+
 func (repository *DeploymentRepository) DeleteDeployments(ctx context.Context, appId string) error {
 	result, err := repository.Database.
 		NewDelete().
 		Model(&models.Deployment{AppId: appId}).
 		Where("app_id = ?", appId).
 		Exec(ctx)
+	if err != nil {
+		return err
+	}
 
 	rowsAffected, _ := result.RowsAffected()
 	if rowsAffected == 0 {
 		return ErrDeploymentNotFound
 	}
 
-	return err
+	return nil
 }
 
 func (repository *DeploymentRepository) UpdateDeploymentById(ctx context.Context, deploymentId string, updateDeploymentParams UpdateDeploymentParams) (*models.Deployment, error) {
@@ -108,3 +187,15 @@ func (repository *DeploymentRepository) UpdateDeploymentById(ctx context.Context
 
 	return &deployment, nil
 }
+
+// UpdateDeploymentsByStatus moves every deployment of the app in the given status to another one.
+func (repository *DeploymentRepository) UpdateDeploymentsByStatus(ctx context.Context, appId string, status models.DeploymentStatus, updateDeploymentParams UpdateDeploymentParams) error {
+	_, err := repository.Database.
+		NewUpdate().
+		Model(&models.Deployment{Status: updateDeploymentParams.Status}).
+		Column("status").
+		Where("app_id = ? AND status = ?", appId, status).
+		Exec(ctx)
+
+	return err
+}
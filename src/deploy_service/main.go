@@ -4,16 +4,22 @@ import (
 	"context"
 	"net"
 	"os"
+	"time"
 
 	"apps-hosting.com/deployservice/internal/core"
 	"apps-hosting.com/deployservice/internal/database"
 	"apps-hosting.com/deployservice/internal/eventshandlers"
+	"apps-hosting.com/deployservice/internal/idler"
+	"apps-hosting.com/deployservice/internal/janitor"
+	"apps-hosting.com/deployservice/internal/reconciler"
 	"apps-hosting.com/deployservice/internal/repositories"
 	"apps-hosting.com/deployservice/internal/tracer"
 	"apps-hosting.com/deployservice/proto/app_service_pb"
 	"apps-hosting.com/deployservice/proto/deploy_service_pb"
+	"apps-hosting.com/deployservice/proto/project_service_pb"
 	"apps-hosting.com/logging"
 	"apps-hosting.com/messaging"
+	"apps-hosting.com/messaging/dedup"
 	"apps-hosting.com/messaging/proto/events_pb"
 
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
@@ -53,33 +59,74 @@ func main() {
 	}
 	appServiceClient := app_service_pb.NewAppServiceClient(_appServiceClient)
 
+	_projectServiceClient, err := grpc.NewClient(
+		os.Getenv("PROJECT_SERVICE"),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff: backoff.DefaultConfig,
+		}))
+	if err != nil {
+		panic(err)
+	}
+	projectServiceClient := project_service_pb.NewProjectServiceClient(_projectServiceClient)
+
 	natsURL := os.Getenv("NATS_URL")
-	eventBus, err := messaging.NewEventBus(
-		serviceName,
-		natsURL,
-		events_pb.StreamName_DEPLOY_STREAM,
-		[]events_pb.EventName{
-			events_pb.EventName_DEPLOY_COMPLETED,
-			events_pb.EventName_DEPLOY_FAILED,
-		},
-	)
+	eventBus, err := messaging.NewEventBus(serviceName, natsURL, messaging.DeployStream)
+	if err != nil {
+		panic(err)
+	}
+
+	deduplicator := dedup.NewDeduplicator(database)
+	err = deduplicator.CreateProcessedMessagesTable(ctx)
 	if err != nil {
 		panic(err)
 	}
+	go deduplicator.Run(ctx)
 
-	eventsHandlers := eventshandlers.NewEventsHandlers(*eventBus, appServiceClient, deploymentRepository, logger)
+	eventsHandlers := eventshandlers.NewEventsHandlers(eventBus, appServiceClient, projectServiceClient, &deploymentRepository, logger)
 
-	err = eventBus.Subscribe(events_pb.EventName_BUILD_COMPLETED, eventsHandlers.HandleBuildCompletedEvent)
+	err = eventBus.Subscribe(events_pb.EventName_BUILD_COMPLETED, deduplicator.Idempotent(eventsHandlers.HandleBuildCompletedEvent))
 	if err != nil {
 		logger.LogErrorF("failed to subscribe to '%s': %v", events_pb.EventName_name[int32(events_pb.EventName_BUILD_COMPLETED)], err)
 	}
-	err = eventBus.Subscribe(events_pb.EventName_APP_DELETED, eventsHandlers.HandleAppDeletedEvent)
+	// a deletion failing after every attempt is finished by the orphans sweep of the janitor
+	err = eventBus.SubscribeWithMaxDeliver(events_pb.EventName_APP_DELETED, deduplicator.Idempotent(eventsHandlers.HandleAppDeletedEvent), 10)
 	if err != nil {
 		logger.LogErrorF("failed to subscribe to '%s': %v", events_pb.EventName_name[int32(events_pb.EventName_APP_DELETED)], err)
 	}
+	err = eventBus.Subscribe(events_pb.EventName_APP_ENV_UPDATED, deduplicator.Idempotent(eventsHandlers.HandleAppEnvUpdatedEvent))
+	if err != nil {
+		logger.LogErrorF("failed to subscribe to '%s': %v", events_pb.EventName_name[int32(events_pb.EventName_APP_ENV_UPDATED)], err)
+	}
+	err = eventBus.Subscribe(events_pb.EventName_APP_SUSPENDED, deduplicator.Idempotent(eventsHandlers.HandleAppSuspendedEvent))
+	if err != nil {
+		logger.LogErrorF("failed to subscribe to '%s': %v", events_pb.EventName_name[int32(events_pb.EventName_APP_SUSPENDED)], err)
+	}
+	err = eventBus.Subscribe(events_pb.EventName_APP_RESUMED, deduplicator.Idempotent(eventsHandlers.HandleAppResumedEvent))
+	if err != nil {
+		logger.LogErrorF("failed to subscribe to '%s': %v", events_pb.EventName_name[int32(events_pb.EventName_APP_RESUMED)], err)
+	}
+
+	err = eventBus.Subscribe(events_pb.EventName_ADDON_CREATED, deduplicator.Idempotent(eventsHandlers.HandleAddOnCreatedEvent))
+	if err != nil {
+		logger.LogErrorF("failed to subscribe to '%s': %v", events_pb.EventName_name[int32(events_pb.EventName_ADDON_CREATED)], err)
+	}
+	err = eventBus.Subscribe(events_pb.EventName_ADDON_DELETED, deduplicator.Idempotent(eventsHandlers.HandleAddOnDeletedEvent))
+	if err != nil {
+		logger.LogErrorF("failed to subscribe to '%s': %v", events_pb.EventName_name[int32(events_pb.EventName_ADDON_DELETED)], err)
+	}
+
+	resourcesJanitor := janitor.NewJanitor(10*time.Minute, appServiceClient, logger)
+	go resourcesJanitor.Run(ctx)
+
+	appIdler := idler.NewIdler(time.Minute, logger)
+	go appIdler.Run(ctx)
+
+	driftReconciler := reconciler.NewReconciler(reconciler.ReconcileInterval(), eventsHandlers, deploymentRepository, eventBus, logger)
+	go driftReconciler.Run(ctx)
 
 	grpcServer := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
-	grpcDeployServiceServer := core.NewGRPCDeployServiceServer(deploymentRepository)
+	grpcDeployServiceServer := core.NewGRPCDeployServiceServer(deploymentRepository, eventBus)
 	deploy_service_pb.RegisterDeployServiceServer(grpcServer, grpcDeployServiceServer)
 
 	PORT := os.Getenv("PORT")
@@ -13,13 +13,8 @@ export default function useEnvironmentVariables(appID: string) {
             return;
         }
 
-        try {
-            const value = getEnvironmentVariablesResult.value!.value;
-            const envVars = JSON.parse(value);
-            setEnvVars(Object.getOwnPropertyNames(envVars).map(key => ({ key: key, value: envVars[key] })));
-        } catch (err) {
-
-        }
+        // the values of the secrets are masked, they are kept when sent back unchanged
+        setEnvVars(getEnvironmentVariablesResult.value!.map(variable => ({ key: variable.key, value: variable.value })));
 
 
         setIsLoading(false);
@@ -65,7 +65,7 @@ export async function reDeployAppById(projectId: string, appId: string): Promise
 
 }
 
-export async function getEnvironmentVariables(projectId: string, appId: string): Promise<Result<string, EnvironmentVariables>> {
+export async function getEnvironmentVariables(projectId: string, appId: string): Promise<Result<string, EnvironmentVariable[]>> {
     try {
         const response = await axios.get(`/projects/${projectId}/apps/${appId}/environment_variables`);
         if (response.data.status == "error") {
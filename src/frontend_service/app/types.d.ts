@@ -51,6 +51,16 @@ interface EnvironmentVariables {
     created_at: string;
 }
 
+interface EnvironmentVariable {
+    id: string;
+    app_id: string;
+    key: string;
+    value: string;
+    is_secret?: boolean;
+    created_at: string;
+    updated_at: string;
+}
+
 type GitProvider = "github"
 interface GitRepository {
     id: string;
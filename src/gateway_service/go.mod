@@ -3,8 +3,8 @@ module gateway
 go 1.23.5
 
 require (
-	apps-hosting.com/logging v0.0.1-20251127192047-9a4b3aa8018d
-	apps-hosting.com/messaging v0.0.1-20251127192047-9a4b3aa8018d
+	apps-hosting.com/logging v0.0.1-20261019033112-43aa3e925870
+	apps-hosting.com/messaging v0.0.1-20261019040005-85cad14baaac
 	github.com/gorilla/mux v1.8.1
 	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.63.0
 	go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp v0.63.0
@@ -2,8 +2,10 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"gateway/proto/app_service_pb"
 	"gateway/utils"
+	"io"
 	"net/http"
 
 	"apps-hosting.com/messaging"
@@ -234,14 +236,14 @@ func (handler *AppHandler) GetEnvironmentVariablesHandler(w http.ResponseWriter,
 		return
 	}
 
-	if getEnvironmentVariablesResponse.EnvironmentVariable == nil {
-		messaging.WriteSuccess(w, "Environment Variables Fetched Successfully", []*app_service_pb.EnvironmentVariables{})
+	span.SetAttributes(attribute.Int("environment_variables.count", len(getEnvironmentVariablesResponse.EnvironmentVariables)))
+
+	if getEnvironmentVariablesResponse.EnvironmentVariables == nil {
+		messaging.WriteSuccess(w, "Environment Variables Fetched Successfully", []*app_service_pb.EnvironmentVariable{})
 		return
 	}
 
-	span.SetAttributes(attribute.String("environment_variable.id", getEnvironmentVariablesResponse.EnvironmentVariable.Id))
-
-	messaging.WriteSuccess(w, "Environment Variables Fetched Successfully", getEnvironmentVariablesResponse.EnvironmentVariable)
+	messaging.WriteSuccess(w, "Environment Variables Fetched Successfully", getEnvironmentVariablesResponse.EnvironmentVariables)
 }
 
 func (handler *AppHandler) CreateEnvironmentVariablesHandler(w http.ResponseWriter, r *http.Request) {
@@ -308,7 +310,8 @@ func (handler *AppHandler) DeleteEnvironmentVariablesHandler(w http.ResponseWrit
 	span.SetAttributes(attribute.String("app.id", appId))
 
 	_, err := handler.AppServiceClient.DeleteEnvironmentVariables(r.Context(), &app_service_pb.DeleteEnvironmentVariablesRequest{
-		AppId: appId,
+		AppId:       appId,
+		SkipRestart: r.URL.Query().Get("skip_restart") == "true",
 	})
 	if err != nil {
 		status, _ := status.FromError(err)
@@ -319,3 +322,517 @@ func (handler *AppHandler) DeleteEnvironmentVariablesHandler(w http.ResponseWrit
 
 	messaging.WriteSuccess(w, "Environment Variables Deleted Successfully", nil)
 }
+
+func (handler *AppHandler) SetEnvironmentVariableHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	appId := params["app_id"]
+	key := params["key"]
+	span.SetAttributes(
+		attribute.String("app.id", appId),
+		attribute.String("environment_variable.key", key),
+	)
+
+	setEnvironmentVariableRequest := app_service_pb.SetEnvironmentVariableRequest{}
+
+	err := json.NewDecoder(r.Body).Decode(&setEnvironmentVariableRequest)
+	if err != nil {
+		messaging.WriteError(w, http.StatusBadRequest, err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	setEnvironmentVariableRequest.AppId = appId
+	setEnvironmentVariableRequest.Key = key
+	setEnvironmentVariableResponse, err := handler.AppServiceClient.SetEnvironmentVariable(r.Context(), &setEnvironmentVariableRequest)
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "Environment Variable Saved Successfully", setEnvironmentVariableResponse.EnvironmentVariable)
+}
+
+func (handler *AppHandler) DeleteEnvironmentVariableHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	appId := params["app_id"]
+	key := params["key"]
+	span.SetAttributes(
+		attribute.String("app.id", appId),
+		attribute.String("environment_variable.key", key),
+	)
+
+	_, err := handler.AppServiceClient.DeleteEnvironmentVariable(r.Context(), &app_service_pb.DeleteEnvironmentVariableRequest{
+		AppId:       appId,
+		Key:         key,
+		SkipRestart: r.URL.Query().Get("skip_restart") == "true",
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "Environment Variable Deleted Successfully", nil)
+}
+
+// maxEnvFileSize is the size of the largest .env file ImportEnvironmentVariablesHandler accepts.
+const maxEnvFileSize = 1 << 20
+
+// ImportEnvironmentVariablesHandler reads a .env file from the request body.
+func (handler *AppHandler) ImportEnvironmentVariablesHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	appId := params["app_id"]
+	span.SetAttributes(attribute.String("app.id", appId))
+
+	content, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxEnvFileSize))
+	if err != nil {
+		statusCode := http.StatusBadRequest
+		if maxBytesError := (*http.MaxBytesError)(nil); errors.As(err, &maxBytesError) {
+			statusCode = http.StatusRequestEntityTooLarge
+		}
+		messaging.WriteError(w, statusCode, err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	importEnvironmentVariablesResponse, err := handler.AppServiceClient.ImportEnvironmentVariables(r.Context(), &app_service_pb.ImportEnvironmentVariablesRequest{
+		AppId:       appId,
+		Content:     string(content),
+		Replace:     r.URL.Query().Get("replace") == "true",
+		SkipRestart: r.URL.Query().Get("skip_restart") == "true",
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	span.SetAttributes(attribute.Int("environment_variables.count", len(importEnvironmentVariablesResponse.EnvironmentVariables)))
+
+	if importEnvironmentVariablesResponse.EnvironmentVariables == nil {
+		messaging.WriteSuccess(w, "Environment Variables Imported Successfully", []*app_service_pb.EnvironmentVariable{})
+		return
+	}
+
+	messaging.WriteSuccess(w, "Environment Variables Imported Successfully", importEnvironmentVariablesResponse.EnvironmentVariables)
+}
+
+// ExportEnvironmentVariablesHandler responds with the .env file itself instead of the JSON envelope.
+func (handler *AppHandler) ExportEnvironmentVariablesHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	appId := params["app_id"]
+	span.SetAttributes(attribute.String("app.id", appId))
+
+	exportEnvironmentVariablesResponse, err := handler.AppServiceClient.ExportEnvironmentVariables(r.Context(), &app_service_pb.ExportEnvironmentVariablesRequest{
+		AppId: appId,
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename=".env"`)
+	io.WriteString(w, exportEnvironmentVariablesResponse.Content)
+}
+
+func (handler *AppHandler) CreateEnvironmentGroupHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	projectId := params["project_id"]
+	span.SetAttributes(attribute.String("project.id", projectId))
+
+	createEnvironmentGroupRequest := app_service_pb.CreateEnvironmentGroupRequest{}
+
+	err := json.NewDecoder(r.Body).Decode(&createEnvironmentGroupRequest)
+	if err != nil {
+		messaging.WriteError(w, http.StatusBadRequest, err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	createEnvironmentGroupRequest.ProjectId = projectId
+	createEnvironmentGroupResponse, err := handler.AppServiceClient.CreateEnvironmentGroup(r.Context(), &createEnvironmentGroupRequest)
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	span.SetAttributes(attribute.String("environment_group.id", createEnvironmentGroupResponse.EnvironmentGroup.Id))
+
+	messaging.WriteSuccess(w, "Environment Group Created Successfully", createEnvironmentGroupResponse.EnvironmentGroup)
+}
+
+func (handler *AppHandler) GetEnvironmentGroupsHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	projectId := params["project_id"]
+	span.SetAttributes(attribute.String("project.id", projectId))
+
+	getEnvironmentGroupsResponse, err := handler.AppServiceClient.GetEnvironmentGroups(r.Context(), &app_service_pb.GetEnvironmentGroupsRequest{
+		ProjectId: projectId,
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	if getEnvironmentGroupsResponse.EnvironmentGroups == nil {
+		messaging.WriteSuccess(w, "Environment Groups Fetched Successfully", []*app_service_pb.EnvironmentGroup{})
+		return
+	}
+
+	messaging.WriteSuccess(w, "Environment Groups Fetched Successfully", getEnvironmentGroupsResponse.EnvironmentGroups)
+}
+
+func (handler *AppHandler) GetEnvironmentGroupHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	projectId := params["project_id"]
+	groupId := params["group_id"]
+	span.SetAttributes(
+		attribute.String("project.id", projectId),
+		attribute.String("environment_group.id", groupId),
+	)
+
+	getEnvironmentGroupResponse, err := handler.AppServiceClient.GetEnvironmentGroup(r.Context(), &app_service_pb.GetEnvironmentGroupRequest{
+		ProjectId: projectId,
+		GroupId:   groupId,
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "Environment Group Fetched Successfully", getEnvironmentGroupResponse)
+}
+
+func (handler *AppHandler) DeleteEnvironmentGroupHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	projectId := params["project_id"]
+	groupId := params["group_id"]
+	span.SetAttributes(
+		attribute.String("project.id", projectId),
+		attribute.String("environment_group.id", groupId),
+	)
+
+	_, err := handler.AppServiceClient.DeleteEnvironmentGroup(r.Context(), &app_service_pb.DeleteEnvironmentGroupRequest{
+		ProjectId:   projectId,
+		GroupId:     groupId,
+		SkipRestart: r.URL.Query().Get("skip_restart") == "true",
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "Environment Group Deleted Successfully", nil)
+}
+
+func (handler *AppHandler) SetEnvironmentGroupVariableHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	projectId := params["project_id"]
+	groupId := params["group_id"]
+	key := params["key"]
+	span.SetAttributes(
+		attribute.String("project.id", projectId),
+		attribute.String("environment_group.id", groupId),
+		attribute.String("environment_variable.key", key),
+	)
+
+	setEnvironmentGroupVariableRequest := app_service_pb.SetEnvironmentGroupVariableRequest{}
+
+	err := json.NewDecoder(r.Body).Decode(&setEnvironmentGroupVariableRequest)
+	if err != nil {
+		messaging.WriteError(w, http.StatusBadRequest, err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	setEnvironmentGroupVariableRequest.ProjectId = projectId
+	setEnvironmentGroupVariableRequest.GroupId = groupId
+	setEnvironmentGroupVariableRequest.Key = key
+	setEnvironmentGroupVariableResponse, err := handler.AppServiceClient.SetEnvironmentGroupVariable(r.Context(), &setEnvironmentGroupVariableRequest)
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "Environment Group Variable Saved Successfully", setEnvironmentGroupVariableResponse.Variable)
+}
+
+func (handler *AppHandler) DeleteEnvironmentGroupVariableHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	projectId := params["project_id"]
+	groupId := params["group_id"]
+	key := params["key"]
+	span.SetAttributes(
+		attribute.String("project.id", projectId),
+		attribute.String("environment_group.id", groupId),
+		attribute.String("environment_variable.key", key),
+	)
+
+	_, err := handler.AppServiceClient.DeleteEnvironmentGroupVariable(r.Context(), &app_service_pb.DeleteEnvironmentGroupVariableRequest{
+		ProjectId:   projectId,
+		GroupId:     groupId,
+		Key:         key,
+		SkipRestart: r.URL.Query().Get("skip_restart") == "true",
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "Environment Group Variable Deleted Successfully", nil)
+}
+
+func (handler *AppHandler) LinkEnvironmentGroupHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	projectId := params["project_id"]
+	appId := params["app_id"]
+	groupId := params["group_id"]
+	span.SetAttributes(
+		attribute.String("project.id", projectId),
+		attribute.String("app.id", appId),
+		attribute.String("environment_group.id", groupId),
+	)
+
+	_, err := handler.AppServiceClient.LinkEnvironmentGroup(r.Context(), &app_service_pb.LinkEnvironmentGroupRequest{
+		ProjectId:   projectId,
+		GroupId:     groupId,
+		AppId:       appId,
+		SkipRestart: r.URL.Query().Get("skip_restart") == "true",
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "Environment Group Linked Successfully", nil)
+}
+
+func (handler *AppHandler) UnlinkEnvironmentGroupHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	projectId := params["project_id"]
+	appId := params["app_id"]
+	groupId := params["group_id"]
+	span.SetAttributes(
+		attribute.String("project.id", projectId),
+		attribute.String("app.id", appId),
+		attribute.String("environment_group.id", groupId),
+	)
+
+	_, err := handler.AppServiceClient.UnlinkEnvironmentGroup(r.Context(), &app_service_pb.UnlinkEnvironmentGroupRequest{
+		ProjectId:   projectId,
+		GroupId:     groupId,
+		AppId:       appId,
+		SkipRestart: r.URL.Query().Get("skip_restart") == "true",
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "Environment Group Unlinked Successfully", nil)
+}
+
+func (handler *AppHandler) GetAppDiskHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	projectId := params["project_id"]
+	appId := params["app_id"]
+	span.SetAttributes(
+		attribute.String("project.id", projectId),
+		attribute.String("app.id", appId),
+	)
+
+	getAppDiskResponse, err := handler.AppServiceClient.GetAppDisk(r.Context(), &app_service_pb.GetAppDiskRequest{
+		ProjectId: projectId,
+		AppId:     appId,
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "Disk Fetched Successfully", getAppDiskResponse.Disk)
+}
+
+func (handler *AppHandler) SuspendAppHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	projectId := params["project_id"]
+	appId := params["app_id"]
+	span.SetAttributes(
+		attribute.String("project.id", projectId),
+		attribute.String("app.id", appId),
+	)
+
+	suspendAppResponse, err := handler.AppServiceClient.SuspendApp(r.Context(), &app_service_pb.SuspendAppRequest{
+		ProjectId: projectId,
+		AppId:     appId,
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "App Suspended Successfully", suspendAppResponse.App)
+}
+
+func (handler *AppHandler) ResumeAppHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	projectId := params["project_id"]
+	appId := params["app_id"]
+	span.SetAttributes(
+		attribute.String("project.id", projectId),
+		attribute.String("app.id", appId),
+	)
+
+	resumeAppResponse, err := handler.AppServiceClient.ResumeApp(r.Context(), &app_service_pb.ResumeAppRequest{
+		ProjectId: projectId,
+		AppId:     appId,
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "App Resumed Successfully", resumeAppResponse.App)
+}
+
+func (handler *AppHandler) GetAppPreviewsHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	projectId := params["project_id"]
+	appId := params["app_id"]
+	span.SetAttributes(
+		attribute.String("project.id", projectId),
+		attribute.String("app.id", appId),
+	)
+
+	getAppPreviewsResponse, err := handler.AppServiceClient.GetAppPreviews(r.Context(), &app_service_pb.GetAppPreviewsRequest{
+		ProjectId: projectId,
+		AppId:     appId,
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "Previews Fetched Successfully", getAppPreviewsResponse.Apps)
+}
+
+func (handler *AppHandler) SetAppDiskHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	projectId := params["project_id"]
+	appId := params["app_id"]
+	span.SetAttributes(
+		attribute.String("project.id", projectId),
+		attribute.String("app.id", appId),
+	)
+
+	setAppDiskRequest := app_service_pb.SetAppDiskRequest{}
+
+	err := json.NewDecoder(r.Body).Decode(&setAppDiskRequest)
+	if err != nil {
+		messaging.WriteError(w, http.StatusBadRequest, err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	setAppDiskRequest.ProjectId = projectId
+	setAppDiskRequest.AppId = appId
+	setAppDiskResponse, err := handler.AppServiceClient.SetAppDisk(r.Context(), &setAppDiskRequest)
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "Disk Saved Successfully, it will be attached on the next deployment", setAppDiskResponse.Disk)
+}
+
+func (handler *AppHandler) DeleteAppDiskHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	projectId := params["project_id"]
+	appId := params["app_id"]
+	span.SetAttributes(
+		attribute.String("project.id", projectId),
+		attribute.String("app.id", appId),
+	)
+
+	_, err := handler.AppServiceClient.DeleteAppDisk(r.Context(), &app_service_pb.DeleteAppDiskRequest{
+		ProjectId: projectId,
+		AppId:     appId,
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "Disk Deleted Successfully, it will be detached on the next deployment", nil)
+}
@@ -1,7 +1,10 @@
 package handlers
 
 import (
+	"fmt"
+	"io"
 	"net/http"
+	"time"
 
 	"apps-hosting.com/messaging"
 	"go.opentelemetry.io/otel/attribute"
@@ -10,11 +13,15 @@ import (
 	"apps-hosting.com/logging"
 
 	"gateway/proto/deploy_service_pb"
+	"gateway/utils"
 
 	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const sseKeepAliveInterval = 30 * time.Second
+
 type DeployHandler struct {
 	DeployServiceClient deploy_service_pb.DeployServiceClient
 	Logger              logging.ServiceLogger
@@ -53,3 +60,227 @@ func (handler *DeployHandler) GetDeploymentsHandler(w http.ResponseWriter, r *ht
 
 	messaging.WriteSuccess(w, "Deployments Fetched Successfully", getDeploymentsResponse.Deployments)
 }
+
+// PromoteDeploymentHandler sends every request of the app to its canary deployment.
+func (handler *DeployHandler) PromoteDeploymentHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	appId := params["app_id"]
+	span.SetAttributes(attribute.String("app.id", appId))
+
+	promoteDeploymentResponse, err := handler.DeployServiceClient.PromoteDeployment(r.Context(), &deploy_service_pb.PromoteDeploymentRequest{
+		AppId: appId,
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "Deployment Promoted Successfully", promoteDeploymentResponse.Deployment)
+}
+
+// AbortDeploymentHandler removes the canary deployment of the app.
+func (handler *DeployHandler) AbortDeploymentHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	appId := params["app_id"]
+	span.SetAttributes(attribute.String("app.id", appId))
+
+	abortDeploymentResponse, err := handler.DeployServiceClient.AbortDeployment(r.Context(), &deploy_service_pb.AbortDeploymentRequest{
+		AppId: appId,
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "Deployment Aborted Successfully", abortDeploymentResponse.Deployment)
+}
+
+// GetAppRuntimeStatusHandler returns the replicas and the pods of the app in the cluster.
+func (handler *DeployHandler) GetAppRuntimeStatusHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	appId := params["app_id"]
+	span.SetAttributes(attribute.String("app.id", appId))
+
+	getAppRuntimeStatusResponse, err := handler.DeployServiceClient.GetAppRuntimeStatus(r.Context(), &deploy_service_pb.GetAppRuntimeStatusRequest{
+		AppId: appId,
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "Runtime Status Fetched Successfully", getAppRuntimeStatusResponse.Status)
+}
+
+// GetAppInstancesHandler returns the pods of the app and the recent Kubernetes events about it.
+func (handler *DeployHandler) GetAppInstancesHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	appId := params["app_id"]
+	span.SetAttributes(attribute.String("app.id", appId))
+
+	getAppInstancesResponse, err := handler.DeployServiceClient.GetAppInstances(r.Context(), &deploy_service_pb.GetAppInstancesRequest{
+		AppId: appId,
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	span.SetAttributes(
+		attribute.Int("instances.count", len(getAppInstancesResponse.Instances)),
+		attribute.Int("events.count", len(getAppInstancesResponse.Events)),
+	)
+
+	messaging.WriteSuccess(w, "Instances Fetched Successfully", getAppInstancesResponse)
+}
+
+// RestartAppHandler replaces the instances of the app one by one.
+func (handler *DeployHandler) RestartAppHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	appId := params["app_id"]
+	userId := r.URL.Query().Get("user_id")
+	span.SetAttributes(
+		attribute.String("app.id", appId),
+		attribute.String("user.id", userId),
+	)
+
+	restartAppResponse, err := handler.DeployServiceClient.RestartApp(r.Context(), &deploy_service_pb.RestartAppRequest{
+		AppId:  appId,
+		UserId: userId,
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "App Restarted Successfully", restartAppResponse.Deployment)
+}
+
+// RestartInstanceHandler replaces a single instance of the app.
+func (handler *DeployHandler) RestartInstanceHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	appId := params["app_id"]
+	instanceName := params["instance_name"]
+	userId := r.URL.Query().Get("user_id")
+	span.SetAttributes(
+		attribute.String("app.id", appId),
+		attribute.String("instance.name", instanceName),
+		attribute.String("user.id", userId),
+	)
+
+	restartInstanceResponse, err := handler.DeployServiceClient.RestartInstance(r.Context(), &deploy_service_pb.RestartInstanceRequest{
+		AppId:        appId,
+		InstanceName: instanceName,
+		UserId:       userId,
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "Instance Restarted Successfully", restartInstanceResponse.Deployment)
+}
+
+// WatchAppEventsHandler streams the events about the app to the browser as Server-Sent Events,
+// the event of each message is its name, e.g. deploy.completed, and its data the payload as JSON.
+func (handler *DeployHandler) WatchAppEventsHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	appId := params["app_id"]
+	userId := r.URL.Query().Get("user_id")
+	span.SetAttributes(
+		attribute.String("app.id", appId),
+		attribute.String("user.id", userId),
+	)
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		messaging.WriteError(w, http.StatusInternalServerError, "streaming is not supported")
+		return
+	}
+
+	stream, err := handler.DeployServiceClient.WatchAppEvents(r.Context(), &deploy_service_pb.WatchAppEventsRequest{
+		AppId:  appId,
+		UserId: userId,
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// keeps proxies like ingress-nginx from buffering the stream
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	events := make(chan *deploy_service_pb.WatchAppEventsResponse)
+	streamErr := make(chan error, 1)
+	go func() {
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				streamErr <- err
+				return
+			}
+
+			select {
+			case events <- event:
+			case <-r.Context().Done():
+				return
+			}
+		}
+	}()
+
+	// a comment line now and then keeps idle connections from being closed
+	keepAlive := time.NewTicker(sseKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-keepAlive.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+		case event := <-events:
+			fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.Id, event.EventName, event.Data)
+		case err := <-streamErr:
+			if err != io.EOF && status.Code(err) != codes.Canceled {
+				handler.Logger.LogError(err.Error())
+				span.SetAttributes(attribute.String("error", err.Error()))
+			}
+			return
+		}
+
+		flusher.Flush()
+	}
+}
@@ -185,6 +185,12 @@ func (handler *ProjectHandler) GetUserProjectsHandler(w http.ResponseWriter, r *
 		r.Context(),
 		&app_service_pb.BatchGetAppsCountRequest{ProjectIds: projectsIds},
 	)
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
 
 	for _, project := range getUserProjectsResponse.Projects {
 		response = append(response, GetUserProjectsResponseItem{
@@ -229,3 +235,178 @@ func (handler *ProjectHandler) UpdateProjectHandler(w http.ResponseWriter, r *ht
 
 	messaging.WriteSuccess(w, "Project Updated Successfully", updateAppResponse.Project)
 }
+
+func (handler *ProjectHandler) CreateAddOnHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	projectId := params["project_id"]
+	span.SetAttributes(attribute.String("project.id", projectId))
+
+	createAddOnRequest := project_service_pb.CreateAddOnRequest{}
+
+	err := json.NewDecoder(r.Body).Decode(&createAddOnRequest)
+	if err != nil {
+		messaging.WriteError(w, http.StatusBadRequest, "failed at decoding json request")
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	createAddOnRequest.ProjectId = projectId
+	createAddOnResponse, err := handler.ProjectServiceClient.CreateAddOn(r.Context(), &createAddOnRequest)
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	span.SetAttributes(attribute.String("add_on.id", createAddOnResponse.AddOn.Id))
+
+	messaging.WriteSuccess(w, "Add-on Created Successfully", createAddOnResponse.AddOn)
+}
+
+func (handler *ProjectHandler) GetAddOnsHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	projectId := params["project_id"]
+	span.SetAttributes(attribute.String("project.id", projectId))
+
+	getAddOnsResponse, err := handler.ProjectServiceClient.GetAddOns(r.Context(), &project_service_pb.GetAddOnsRequest{
+		ProjectId: projectId,
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	if getAddOnsResponse.AddOns == nil {
+		messaging.WriteSuccess(w, "Add-ons Fetched Successfully", []*project_service_pb.AddOn{})
+		return
+	}
+
+	messaging.WriteSuccess(w, "Add-ons Fetched Successfully", getAddOnsResponse.AddOns)
+}
+
+func (handler *ProjectHandler) GetAddOnHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	projectId := params["project_id"]
+	addOnId := params["add_on_id"]
+	span.SetAttributes(
+		attribute.String("project.id", projectId),
+		attribute.String("add_on.id", addOnId),
+	)
+
+	getAddOnResponse, err := handler.ProjectServiceClient.GetAddOn(r.Context(), &project_service_pb.GetAddOnRequest{
+		ProjectId: projectId,
+		AddOnId:   addOnId,
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "Add-on Fetched Successfully", getAddOnResponse)
+}
+
+func (handler *ProjectHandler) DeleteAddOnHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	projectId := params["project_id"]
+	addOnId := params["add_on_id"]
+	span.SetAttributes(
+		attribute.String("project.id", projectId),
+		attribute.String("add_on.id", addOnId),
+	)
+
+	_, err := handler.ProjectServiceClient.DeleteAddOn(r.Context(), &project_service_pb.DeleteAddOnRequest{
+		ProjectId:   projectId,
+		AddOnId:     addOnId,
+		SkipRestart: r.URL.Query().Get("skip_restart") == "true",
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "Add-on Deleted Successfully", nil)
+}
+
+func (handler *ProjectHandler) LinkAddOnHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	projectId := params["project_id"]
+	appId := params["app_id"]
+	addOnId := params["add_on_id"]
+	span.SetAttributes(
+		attribute.String("project.id", projectId),
+		attribute.String("app.id", appId),
+		attribute.String("add_on.id", addOnId),
+	)
+
+	linkAddOnRequest := project_service_pb.LinkAddOnRequest{}
+
+	// The body is optional, it only carries a custom variable name.
+	if r.ContentLength != 0 {
+		err := json.NewDecoder(r.Body).Decode(&linkAddOnRequest)
+		if err != nil {
+			messaging.WriteError(w, http.StatusBadRequest, "failed at decoding json request")
+			span.SetAttributes(attribute.String("error", err.Error()))
+			return
+		}
+	}
+
+	linkAddOnRequest.ProjectId = projectId
+	linkAddOnRequest.AppId = appId
+	linkAddOnRequest.AddOnId = addOnId
+	linkAddOnRequest.SkipRestart = r.URL.Query().Get("skip_restart") == "true"
+	linkAddOnResponse, err := handler.ProjectServiceClient.LinkAddOn(r.Context(), &linkAddOnRequest)
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "Add-on Linked Successfully", linkAddOnResponse.Link)
+}
+
+func (handler *ProjectHandler) UnlinkAddOnHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	params := mux.Vars(r)
+
+	projectId := params["project_id"]
+	appId := params["app_id"]
+	addOnId := params["add_on_id"]
+	span.SetAttributes(
+		attribute.String("project.id", projectId),
+		attribute.String("app.id", appId),
+		attribute.String("add_on.id", addOnId),
+	)
+
+	_, err := handler.ProjectServiceClient.UnlinkAddOn(r.Context(), &project_service_pb.UnlinkAddOnRequest{
+		ProjectId:   projectId,
+		AddOnId:     addOnId,
+		AppId:       appId,
+		SkipRestart: r.URL.Query().Get("skip_restart") == "true",
+	})
+	if err != nil {
+		status, _ := status.FromError(err)
+		messaging.WriteError(w, utils.GrpcCodeToHttpStatusCode(status.Code()), status.Message())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	messaging.WriteSuccess(w, "Add-on Unlinked Successfully", nil)
+}
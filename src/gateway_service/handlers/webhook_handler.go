@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"gateway/proto/app_service_pb"
+	"gateway/proto/project_service_pb"
+	"io"
+	"net/http"
+	"strings"
+
+	"apps-hosting.com/messaging"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"apps-hosting.com/logging"
+)
+
+// GitHub refuses to send payloads larger than 25MB.
+const maxWebhookPayloadSize = 25 << 20
+
+type githubPullRequestEvent struct {
+	Action      string `json:"action"`
+	Number      int32  `json:"number"`
+	PullRequest struct {
+		// Head.Repo is null when the fork the pull request comes from was deleted.
+		Head struct {
+			Repo *githubRepository `json:"repo"`
+		} `json:"head"`
+		Base struct {
+			Repo *githubRepository `json:"repo"`
+		} `json:"base"`
+	} `json:"pull_request"`
+	Repository struct {
+		CloneURL string `json:"clone_url"`
+	} `json:"repository"`
+}
+
+type githubRepository struct {
+	FullName string `json:"full_name"`
+}
+
+// fromFork tells whether the pull request comes from another repository than the one it is opened on.
+func (event githubPullRequestEvent) fromFork() bool {
+	head, base := event.PullRequest.Head.Repo, event.PullRequest.Base.Repo
+	return head == nil || base == nil || head.FullName != base.FullName
+}
+
+type WebhookHandler struct {
+	AppServiceClient     app_service_pb.AppServiceClient
+	ProjectServiceClient project_service_pb.ProjectServiceClient
+	GithubWebhookSecret  string
+	Logger               logging.ServiceLogger
+}
+
+func NewWebhookHandler(
+	appServiceClient app_service_pb.AppServiceClient,
+	projectServiceClient project_service_pb.ProjectServiceClient,
+	githubWebhookSecret string,
+	logger logging.ServiceLogger,
+) WebhookHandler {
+	return WebhookHandler{
+		AppServiceClient:     appServiceClient,
+		ProjectServiceClient: projectServiceClient,
+		GithubWebhookSecret:  githubWebhookSecret,
+		Logger:               logger,
+	}
+}
+
+// GithubWebhookHandler deploys a preview of the apps built from the repository when a pull
+// request is opened or updated, and deletes it when the pull request is closed.
+func (handler *WebhookHandler) GithubWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+
+	githubEvent := r.Header.Get("X-GitHub-Event")
+	span.SetAttributes(
+		attribute.String("github.event", githubEvent),
+		attribute.String("github.delivery", r.Header.Get("X-GitHub-Delivery")),
+	)
+
+	if len(handler.GithubWebhookSecret) == 0 {
+		messaging.WriteError(w, http.StatusServiceUnavailable, "github webhooks are not configured")
+		return
+	}
+
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookPayloadSize))
+	if err != nil {
+		messaging.WriteError(w, http.StatusBadRequest, "failed at reading request body")
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	if !verifyGithubSignature(handler.GithubWebhookSecret, payload, r.Header.Get("X-Hub-Signature-256")) {
+		messaging.WriteError(w, http.StatusUnauthorized, "invalid signature")
+		return
+	}
+
+	if githubEvent != "pull_request" {
+		messaging.WriteSuccess(w, "Event Ignored", nil)
+		return
+	}
+
+	event := githubPullRequestEvent{}
+	err = json.Unmarshal(payload, &event)
+	if err != nil {
+		messaging.WriteError(w, http.StatusBadRequest, "failed at decoding json request")
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("github.action", event.Action),
+		attribute.Int("github.pull_request.number", int(event.Number)),
+		attribute.String("git_repository.clone_url", event.Repository.CloneURL),
+		attribute.Bool("github.pull_request.from_fork", event.fromFork()),
+	)
+
+	switch event.Action {
+	case "opened", "reopened", "synchronize", "closed":
+	default:
+		messaging.WriteSuccess(w, "Event Ignored", nil)
+		return
+	}
+
+	// A preview runs with the environment variables of its parent app, secrets included, so the code
+	// of a fork, which anyone can open a pull request from, is never deployed.
+	if event.fromFork() && event.Action != "closed" {
+		messaging.WriteSuccess(w, "Event Ignored", nil)
+		return
+	}
+
+	getPreviewSourceAppsResponse, err := handler.AppServiceClient.GetPreviewSourceApps(r.Context(), &app_service_pb.GetPreviewSourceAppsRequest{
+		CloneUrl: event.Repository.CloneURL,
+	})
+	if err != nil {
+		handler.Logger.LogError(err.Error())
+		messaging.WriteError(w, http.StatusInternalServerError, "failed to get the apps of the repository")
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	// A failure is answered with an error so that the delivery can be retried from GitHub,
+	// both calls are safe to repeat.
+	failed := false
+	for _, app := range getPreviewSourceAppsResponse.Apps {
+		if event.Action == "closed" {
+			_, err = handler.AppServiceClient.DeletePullRequestPreview(r.Context(), &app_service_pb.DeletePullRequestPreviewRequest{
+				ParentAppId:       app.Id,
+				PullRequestNumber: event.Number,
+			})
+		} else {
+			err = handler.deployPreview(r, app, event.Number)
+		}
+
+		if err != nil {
+			handler.Logger.LogErrorF("failed to handle pull request #%d of app '%s': %v", event.Number, app.Id, err)
+			span.SetAttributes(attribute.String("error", err.Error()))
+			failed = true
+		}
+	}
+
+	if failed {
+		messaging.WriteError(w, http.StatusInternalServerError, "failed to update some previews")
+		return
+	}
+
+	messaging.WriteSuccess(w, "Previews Updated Successfully", nil)
+}
+
+func (handler *WebhookHandler) deployPreview(r *http.Request, app *app_service_pb.App, pullRequestNumber int32) error {
+	// the build clones private repositories with the token of the project owner
+	getProjectResponse, err := handler.ProjectServiceClient.GetProject(r.Context(), &project_service_pb.GetProjectRequest{
+		ProjectId: app.ProjectId,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = handler.AppServiceClient.DeployPullRequestPreview(r.Context(), &app_service_pb.DeployPullRequestPreviewRequest{
+		ParentAppId:       app.Id,
+		UserId:            getProjectResponse.Project.UserId,
+		PullRequestNumber: pullRequestNumber,
+	})
+	return err
+}
+
+// verifyGithubSignature checks the X-Hub-Signature-256 header, the HMAC of the payload
+// keyed with the secret of the webhook.
+func verifyGithubSignature(secret string, payload []byte, signature string) bool {
+	signature, found := strings.CutPrefix(signature, "sha256=")
+	if !found {
+		return false
+	}
+
+	expectedSignature, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hmac.Equal(mac.Sum(nil), expectedSignature)
+}
@@ -67,6 +67,7 @@ func main() {
 	deployHandler := handlers.NewDeployHandler(grpcClients.DeployServiceClient, logger)
 	logHandler := handlers.NewLogHandler(grpcClients.LogServiceClient, logger)
 	gatewayHandler := handlers.NewGatewayHandler(logger)
+	webhookHandler := handlers.NewWebhookHandler(grpcClients.AppServiceClient, grpcClients.ProjectServiceClient, os.Getenv("GITHUB_WEBHOOK_SECRET"), logger)
 
 	router := mux.NewRouter()
 	router.Use(gatewayHandler.LoggingMiddleware)
@@ -74,6 +75,9 @@ func main() {
 	// API Endpoints
 	router.Handle("/health", http.HandlerFunc(gatewayHandler.HealthCheckHandler)).Methods("GET")
 
+	// Webhooks are authenticated by their signature instead of a user session.
+	router.Handle("/webhooks/github", http.HandlerFunc(webhookHandler.GithubWebhookHandler)).Methods("POST")
+
 	userRouter := router.PathPrefix("/user").Subrouter()
 	userRouter.Handle("/auth", http.HandlerFunc(userHandler.AuthHandler)).Methods("GET")
 	userRouter.Handle("/sign_in", http.HandlerFunc(userHandler.SignInHandler)).Methods("POST")
@@ -100,6 +104,16 @@ func main() {
 	projectScoped.Handle("/delete", http.HandlerFunc(projectHandler.DeleteProjectHandler)).Methods("DELETE")
 	projectScoped.Handle("/apps", http.HandlerFunc(appHandler.GetAppsHandler)).Methods("GET")
 	projectScoped.Handle("/apps/create", http.HandlerFunc(appHandler.CreateAppHandler)).Methods("POST")
+	projectScoped.Handle("/environment_groups", http.HandlerFunc(appHandler.GetEnvironmentGroupsHandler)).Methods("GET")
+	projectScoped.Handle("/environment_groups/create", http.HandlerFunc(appHandler.CreateEnvironmentGroupHandler)).Methods("POST")
+	projectScoped.Handle("/environment_groups/{group_id}", http.HandlerFunc(appHandler.GetEnvironmentGroupHandler)).Methods("GET")
+	projectScoped.Handle("/environment_groups/{group_id}/delete", http.HandlerFunc(appHandler.DeleteEnvironmentGroupHandler)).Methods("DELETE")
+	projectScoped.Handle("/environment_groups/{group_id}/variables/{key}", http.HandlerFunc(appHandler.SetEnvironmentGroupVariableHandler)).Methods("PUT")
+	projectScoped.Handle("/environment_groups/{group_id}/variables/{key}", http.HandlerFunc(appHandler.DeleteEnvironmentGroupVariableHandler)).Methods("DELETE")
+	projectScoped.Handle("/addons", http.HandlerFunc(projectHandler.GetAddOnsHandler)).Methods("GET")
+	projectScoped.Handle("/addons/create", http.HandlerFunc(projectHandler.CreateAddOnHandler)).Methods("POST")
+	projectScoped.Handle("/addons/{add_on_id}", http.HandlerFunc(projectHandler.GetAddOnHandler)).Methods("GET")
+	projectScoped.Handle("/addons/{add_on_id}/delete", http.HandlerFunc(projectHandler.DeleteAddOnHandler)).Methods("DELETE")
 
 	appScoped := projectScoped.PathPrefix("/apps/{app_id}").Subrouter()
 	appScoped.Use(appHandler.OwnershipMiddleware)
@@ -107,12 +121,33 @@ func main() {
 	appScoped.Handle("/", http.HandlerFunc(appHandler.GetAppHandler)).Methods("GET")
 	appScoped.Handle("/update", http.HandlerFunc(appHandler.UpdateAppHandler)).Methods("PATCH")
 	appScoped.Handle("/delete", http.HandlerFunc(appHandler.DeleteAppHandler)).Methods("DELETE")
+	appScoped.Handle("/suspend", http.HandlerFunc(appHandler.SuspendAppHandler)).Methods("POST")
+	appScoped.Handle("/resume", http.HandlerFunc(appHandler.ResumeAppHandler)).Methods("POST")
 	appScoped.Handle("/environment_variables", http.HandlerFunc(appHandler.GetEnvironmentVariablesHandler)).Methods("GET")
 	appScoped.Handle("/environment_variables/create", http.HandlerFunc(appHandler.CreateEnvironmentVariablesHandler)).Methods("POST")
 	appScoped.Handle("/environment_variables/update", http.HandlerFunc(appHandler.UpdateEnvironmentVariablesHandler)).Methods("PATCH")
 	appScoped.Handle("/environment_variables/delete", http.HandlerFunc(appHandler.DeleteEnvironmentVariablesHandler)).Methods("DELETE")
+	appScoped.Handle("/environment_variables/import", http.HandlerFunc(appHandler.ImportEnvironmentVariablesHandler)).Methods("POST")
+	appScoped.Handle("/environment_variables/export", http.HandlerFunc(appHandler.ExportEnvironmentVariablesHandler)).Methods("GET")
+	appScoped.Handle("/environment_variables/keys/{key}", http.HandlerFunc(appHandler.SetEnvironmentVariableHandler)).Methods("PUT")
+	appScoped.Handle("/environment_variables/keys/{key}", http.HandlerFunc(appHandler.DeleteEnvironmentVariableHandler)).Methods("DELETE")
+	appScoped.Handle("/environment_groups/{group_id}", http.HandlerFunc(appHandler.LinkEnvironmentGroupHandler)).Methods("PUT")
+	appScoped.Handle("/environment_groups/{group_id}", http.HandlerFunc(appHandler.UnlinkEnvironmentGroupHandler)).Methods("DELETE")
+	appScoped.Handle("/addons/{add_on_id}", http.HandlerFunc(projectHandler.LinkAddOnHandler)).Methods("PUT")
+	appScoped.Handle("/addons/{add_on_id}", http.HandlerFunc(projectHandler.UnlinkAddOnHandler)).Methods("DELETE")
+	appScoped.Handle("/disk", http.HandlerFunc(appHandler.GetAppDiskHandler)).Methods("GET")
+	appScoped.Handle("/disk", http.HandlerFunc(appHandler.SetAppDiskHandler)).Methods("PUT")
+	appScoped.Handle("/disk", http.HandlerFunc(appHandler.DeleteAppDiskHandler)).Methods("DELETE")
+	appScoped.Handle("/previews", http.HandlerFunc(appHandler.GetAppPreviewsHandler)).Methods("GET")
 	appScoped.Handle("/builds", http.HandlerFunc(buildHandler.GetBuildsHandler)).Methods("GET")
 	appScoped.Handle("/deployments", http.HandlerFunc(deployHandler.GetDeploymentsHandler)).Methods("GET")
+	appScoped.Handle("/deployments/promote", http.HandlerFunc(deployHandler.PromoteDeploymentHandler)).Methods("POST")
+	appScoped.Handle("/deployments/abort", http.HandlerFunc(deployHandler.AbortDeploymentHandler)).Methods("POST")
+	appScoped.Handle("/runtime", http.HandlerFunc(deployHandler.GetAppRuntimeStatusHandler)).Methods("GET")
+	appScoped.Handle("/instances", http.HandlerFunc(deployHandler.GetAppInstancesHandler)).Methods("GET")
+	appScoped.Handle("/instances/{instance_name}/restart", http.HandlerFunc(deployHandler.RestartInstanceHandler)).Methods("POST")
+	appScoped.Handle("/restart", http.HandlerFunc(deployHandler.RestartAppHandler)).Methods("POST")
+	appScoped.Handle("/events/stream", http.HandlerFunc(deployHandler.WatchAppEventsHandler)).Methods("GET")
 	appScoped.Handle("/logs", http.HandlerFunc(logHandler.QueryLogsHandler)).Methods("GET")
 
 	// Start server
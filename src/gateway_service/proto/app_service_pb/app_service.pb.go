@@ -22,18 +22,47 @@ const (
 )
 
 type App struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	DomainName    string                 `protobuf:"bytes,4,opt,name=domain_name,json=domainName,proto3" json:"domain_name,omitempty"`
-	Runtime       string                 `protobuf:"bytes,5,opt,name=runtime,proto3" json:"runtime,omitempty"`
-	RepoUrl       string                 `protobuf:"bytes,6,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
-	BuildCmd      string                 `protobuf:"bytes,7,opt,name=build_cmd,json=buildCmd,proto3" json:"build_cmd,omitempty"`
-	StartCmd      string                 `protobuf:"bytes,8,opt,name=start_cmd,json=startCmd,proto3" json:"start_cmd,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId       string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	DomainName      string                 `protobuf:"bytes,4,opt,name=domain_name,json=domainName,proto3" json:"domain_name,omitempty"`
+	Runtime         string                 `protobuf:"bytes,5,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	RepoUrl         string                 `protobuf:"bytes,6,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	BuildCmd        string                 `protobuf:"bytes,7,opt,name=build_cmd,json=buildCmd,proto3" json:"build_cmd,omitempty"`
+	StartCmd        string                 `protobuf:"bytes,8,opt,name=start_cmd,json=startCmd,proto3" json:"start_cmd,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Type            string                 `protobuf:"bytes,10,opt,name=type,proto3" json:"type,omitempty"`
+	Schedule        string                 `protobuf:"bytes,11,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	PublishDir      string                 `protobuf:"bytes,12,opt,name=publish_dir,json=publishDir,proto3" json:"publish_dir,omitempty"`
+	PreviewsEnabled bool                   `protobuf:"varint,13,opt,name=previews_enabled,json=previewsEnabled,proto3" json:"previews_enabled,omitempty"`
+	// parent_app_id and pull_request_number are only set on pull request previews.
+	ParentAppId       string `protobuf:"bytes,14,opt,name=parent_app_id,json=parentAppId,proto3" json:"parent_app_id,omitempty"`
+	PullRequestNumber int32  `protobuf:"varint,15,opt,name=pull_request_number,json=pullRequestNumber,proto3" json:"pull_request_number,omitempty"`
+	Suspended         bool   `protobuf:"varint,16,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	// scale_to_zero_idle_minutes stops the app after that long without requests, 0 keeps it running.
+	ScaleToZeroIdleMinutes int32 `protobuf:"varint,17,opt,name=scale_to_zero_idle_minutes,json=scaleToZeroIdleMinutes,proto3" json:"scale_to_zero_idle_minutes,omitempty"`
+	// deployment_strategy is one of rolling, blue_green or canary.
+	DeploymentStrategy string `protobuf:"bytes,18,opt,name=deployment_strategy,json=deploymentStrategy,proto3" json:"deployment_strategy,omitempty"`
+	// rolling_max_surge and rolling_max_unavailable are a number of instances or a percentage, empty uses the Kubernetes defaults.
+	RollingMaxSurge       string `protobuf:"bytes,19,opt,name=rolling_max_surge,json=rollingMaxSurge,proto3" json:"rolling_max_surge,omitempty"`
+	RollingMaxUnavailable string `protobuf:"bytes,20,opt,name=rolling_max_unavailable,json=rollingMaxUnavailable,proto3" json:"rolling_max_unavailable,omitempty"`
+	// canary_weight is the percentage of the requests sent to a canary deployment until it is promoted.
+	CanaryWeight int32 `protobuf:"varint,21,opt,name=canary_weight,json=canaryWeight,proto3" json:"canary_weight,omitempty"`
+	// pre_deploy_cmd runs with the new image before it takes traffic, e.g. the database migrations,
+	// the deployment fails when it exits with an error. post_deploy_cmd runs once it takes traffic.
+	PreDeployCmd  string `protobuf:"bytes,22,opt,name=pre_deploy_cmd,json=preDeployCmd,proto3" json:"pre_deploy_cmd,omitempty"`
+	PostDeployCmd string `protobuf:"bytes,23,opt,name=post_deploy_cmd,json=postDeployCmd,proto3" json:"post_deploy_cmd,omitempty"`
+	// status is one of building, deploying, deployed, build_failed or deploy_failed, empty for the apps
+	// not built since it is tracked. last_build_id is the latest build, last_deployed_build_id the one running.
+	Status                 string `protobuf:"bytes,24,opt,name=status,proto3" json:"status,omitempty"`
+	StatusUpdatedAt        string `protobuf:"bytes,25,opt,name=status_updated_at,json=statusUpdatedAt,proto3" json:"status_updated_at,omitempty"`
+	LastBuildId            string `protobuf:"bytes,26,opt,name=last_build_id,json=lastBuildId,proto3" json:"last_build_id,omitempty"`
+	LastCommitHash         string `protobuf:"bytes,27,opt,name=last_commit_hash,json=lastCommitHash,proto3" json:"last_commit_hash,omitempty"`
+	LastDeployedBuildId    string `protobuf:"bytes,28,opt,name=last_deployed_build_id,json=lastDeployedBuildId,proto3" json:"last_deployed_build_id,omitempty"`
+	LastDeployedCommitHash string `protobuf:"bytes,29,opt,name=last_deployed_commit_hash,json=lastDeployedCommitHash,proto3" json:"last_deployed_commit_hash,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *App) Reset() {
@@ -129,29 +158,180 @@ func (x *App) GetCreatedAt() string {
 	return ""
 }
 
-type EnvironmentVariables struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	CreateAt      string                 `protobuf:"bytes,3,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *App) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-func (x *EnvironmentVariables) Reset() {
-	*x = EnvironmentVariables{}
+func (x *App) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *App) GetPublishDir() string {
+	if x != nil {
+		return x.PublishDir
+	}
+	return ""
+}
+
+func (x *App) GetPreviewsEnabled() bool {
+	if x != nil {
+		return x.PreviewsEnabled
+	}
+	return false
+}
+
+func (x *App) GetParentAppId() string {
+	if x != nil {
+		return x.ParentAppId
+	}
+	return ""
+}
+
+func (x *App) GetPullRequestNumber() int32 {
+	if x != nil {
+		return x.PullRequestNumber
+	}
+	return 0
+}
+
+func (x *App) GetSuspended() bool {
+	if x != nil {
+		return x.Suspended
+	}
+	return false
+}
+
+func (x *App) GetScaleToZeroIdleMinutes() int32 {
+	if x != nil {
+		return x.ScaleToZeroIdleMinutes
+	}
+	return 0
+}
+
+func (x *App) GetDeploymentStrategy() string {
+	if x != nil {
+		return x.DeploymentStrategy
+	}
+	return ""
+}
+
+func (x *App) GetRollingMaxSurge() string {
+	if x != nil {
+		return x.RollingMaxSurge
+	}
+	return ""
+}
+
+func (x *App) GetRollingMaxUnavailable() string {
+	if x != nil {
+		return x.RollingMaxUnavailable
+	}
+	return ""
+}
+
+func (x *App) GetCanaryWeight() int32 {
+	if x != nil {
+		return x.CanaryWeight
+	}
+	return 0
+}
+
+func (x *App) GetPreDeployCmd() string {
+	if x != nil {
+		return x.PreDeployCmd
+	}
+	return ""
+}
+
+func (x *App) GetPostDeployCmd() string {
+	if x != nil {
+		return x.PostDeployCmd
+	}
+	return ""
+}
+
+func (x *App) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *App) GetStatusUpdatedAt() string {
+	if x != nil {
+		return x.StatusUpdatedAt
+	}
+	return ""
+}
+
+func (x *App) GetLastBuildId() string {
+	if x != nil {
+		return x.LastBuildId
+	}
+	return ""
+}
+
+func (x *App) GetLastCommitHash() string {
+	if x != nil {
+		return x.LastCommitHash
+	}
+	return ""
+}
+
+func (x *App) GetLastDeployedBuildId() string {
+	if x != nil {
+		return x.LastDeployedBuildId
+	}
+	return ""
+}
+
+func (x *App) GetLastDeployedCommitHash() string {
+	if x != nil {
+		return x.LastDeployedCommitHash
+	}
+	return ""
+}
+
+type AppDeploymentConfig struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	AppId                  string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	AppName                string                 `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	DomainName             string                 `protobuf:"bytes,3,opt,name=domain_name,json=domainName,proto3" json:"domain_name,omitempty"`
+	Type                   string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Schedule               string                 `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Disk                   *Disk                  `protobuf:"bytes,6,opt,name=disk,proto3,oneof" json:"disk,omitempty"`
+	Suspended              bool                   `protobuf:"varint,7,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	ScaleToZeroIdleMinutes int32                  `protobuf:"varint,8,opt,name=scale_to_zero_idle_minutes,json=scaleToZeroIdleMinutes,proto3" json:"scale_to_zero_idle_minutes,omitempty"`
+	DeploymentStrategy     string                 `protobuf:"bytes,9,opt,name=deployment_strategy,json=deploymentStrategy,proto3" json:"deployment_strategy,omitempty"`
+	RollingMaxSurge        string                 `protobuf:"bytes,10,opt,name=rolling_max_surge,json=rollingMaxSurge,proto3" json:"rolling_max_surge,omitempty"`
+	RollingMaxUnavailable  string                 `protobuf:"bytes,11,opt,name=rolling_max_unavailable,json=rollingMaxUnavailable,proto3" json:"rolling_max_unavailable,omitempty"`
+	CanaryWeight           int32                  `protobuf:"varint,12,opt,name=canary_weight,json=canaryWeight,proto3" json:"canary_weight,omitempty"`
+	PreDeployCmd           string                 `protobuf:"bytes,13,opt,name=pre_deploy_cmd,json=preDeployCmd,proto3" json:"pre_deploy_cmd,omitempty"`
+	PostDeployCmd          string                 `protobuf:"bytes,14,opt,name=post_deploy_cmd,json=postDeployCmd,proto3" json:"post_deploy_cmd,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AppDeploymentConfig) Reset() {
+	*x = AppDeploymentConfig{}
 	mi := &file_src_protos_app_service_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EnvironmentVariables) String() string {
+func (x *AppDeploymentConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnvironmentVariables) ProtoMessage() {}
+func (*AppDeploymentConfig) ProtoMessage() {}
 
-func (x *EnvironmentVariables) ProtoReflect() protoreflect.Message {
+func (x *AppDeploymentConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protos_app_service_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -163,58 +343,135 @@ func (x *EnvironmentVariables) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnvironmentVariables.ProtoReflect.Descriptor instead.
-func (*EnvironmentVariables) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppDeploymentConfig.ProtoReflect.Descriptor instead.
+func (*AppDeploymentConfig) Descriptor() ([]byte, []int) {
 	return file_src_protos_app_service_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *EnvironmentVariables) GetId() string {
+func (x *AppDeploymentConfig) GetAppId() string {
 	if x != nil {
-		return x.Id
+		return x.AppId
 	}
 	return ""
 }
 
-func (x *EnvironmentVariables) GetValue() string {
+func (x *AppDeploymentConfig) GetAppName() string {
 	if x != nil {
-		return x.Value
+		return x.AppName
 	}
 	return ""
 }
 
-func (x *EnvironmentVariables) GetCreateAt() string {
+func (x *AppDeploymentConfig) GetDomainName() string {
 	if x != nil {
-		return x.CreateAt
+		return x.DomainName
 	}
 	return ""
 }
 
-type GitRepository struct {
+func (x *AppDeploymentConfig) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AppDeploymentConfig) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *AppDeploymentConfig) GetDisk() *Disk {
+	if x != nil {
+		return x.Disk
+	}
+	return nil
+}
+
+func (x *AppDeploymentConfig) GetSuspended() bool {
+	if x != nil {
+		return x.Suspended
+	}
+	return false
+}
+
+func (x *AppDeploymentConfig) GetScaleToZeroIdleMinutes() int32 {
+	if x != nil {
+		return x.ScaleToZeroIdleMinutes
+	}
+	return 0
+}
+
+func (x *AppDeploymentConfig) GetDeploymentStrategy() string {
+	if x != nil {
+		return x.DeploymentStrategy
+	}
+	return ""
+}
+
+func (x *AppDeploymentConfig) GetRollingMaxSurge() string {
+	if x != nil {
+		return x.RollingMaxSurge
+	}
+	return ""
+}
+
+func (x *AppDeploymentConfig) GetRollingMaxUnavailable() string {
+	if x != nil {
+		return x.RollingMaxUnavailable
+	}
+	return ""
+}
+
+func (x *AppDeploymentConfig) GetCanaryWeight() int32 {
+	if x != nil {
+		return x.CanaryWeight
+	}
+	return 0
+}
+
+func (x *AppDeploymentConfig) GetPreDeployCmd() string {
+	if x != nil {
+		return x.PreDeployCmd
+	}
+	return ""
+}
+
+func (x *AppDeploymentConfig) GetPostDeployCmd() string {
+	if x != nil {
+		return x.PostDeployCmd
+	}
+	return ""
+}
+
+type Disk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	AppId         string                 `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	Provider      string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
-	CloneUrl      string                 `protobuf:"bytes,4,opt,name=clone_url,json=cloneUrl,proto3" json:"clone_url,omitempty"`
-	IsPrivate     bool                   `protobuf:"varint,5,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MountPath     string                 `protobuf:"bytes,3,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
+	SizeGb        int32                  `protobuf:"varint,4,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GitRepository) Reset() {
-	*x = GitRepository{}
+func (x *Disk) Reset() {
+	*x = Disk{}
 	mi := &file_src_protos_app_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GitRepository) String() string {
+func (x *Disk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitRepository) ProtoMessage() {}
+func (*Disk) ProtoMessage() {}
 
-func (x *GitRepository) ProtoReflect() protoreflect.Message {
+func (x *Disk) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protos_app_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -226,81 +483,76 @@ func (x *GitRepository) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitRepository.ProtoReflect.Descriptor instead.
-func (*GitRepository) Descriptor() ([]byte, []int) {
+// Deprecated: Use Disk.ProtoReflect.Descriptor instead.
+func (*Disk) Descriptor() ([]byte, []int) {
 	return file_src_protos_app_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GitRepository) GetId() string {
+func (x *Disk) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GitRepository) GetAppId() string {
+func (x *Disk) GetAppId() string {
 	if x != nil {
 		return x.AppId
 	}
 	return ""
 }
 
-func (x *GitRepository) GetProvider() string {
+func (x *Disk) GetMountPath() string {
 	if x != nil {
-		return x.Provider
+		return x.MountPath
 	}
 	return ""
 }
 
-func (x *GitRepository) GetCloneUrl() string {
+func (x *Disk) GetSizeGb() int32 {
 	if x != nil {
-		return x.CloneUrl
+		return x.SizeGb
 	}
-	return ""
+	return 0
 }
 
-func (x *GitRepository) GetIsPrivate() bool {
+func (x *Disk) GetCreatedAt() string {
 	if x != nil {
-		return x.IsPrivate
+		return x.CreatedAt
 	}
-	return false
+	return ""
 }
 
-func (x *GitRepository) GetCreatedAt() string {
+func (x *Disk) GetUpdatedAt() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.UpdatedAt
 	}
 	return ""
 }
 
-type CreateAppRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId            string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	UserId               string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name                 string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Runtime              string                 `protobuf:"bytes,4,opt,name=runtime,proto3" json:"runtime,omitempty"`
-	GitRepository        *GitRepository         `protobuf:"bytes,5,opt,name=git_repository,json=gitRepository,proto3" json:"git_repository,omitempty"`
-	BuildCmd             string                 `protobuf:"bytes,6,opt,name=build_cmd,json=buildCmd,proto3" json:"build_cmd,omitempty"`
-	StartCmd             string                 `protobuf:"bytes,7,opt,name=start_cmd,json=startCmd,proto3" json:"start_cmd,omitempty"`
-	EnvironmentVariables *string                `protobuf:"bytes,8,opt,name=environment_variables,json=environmentVariables,proto3,oneof" json:"environment_variables,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type EnvironmentVariables struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	CreateAt      string                 `protobuf:"bytes,3,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAppRequest) Reset() {
-	*x = CreateAppRequest{}
+func (x *EnvironmentVariables) Reset() {
+	*x = EnvironmentVariables{}
 	mi := &file_src_protos_app_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAppRequest) String() string {
+func (x *EnvironmentVariables) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAppRequest) ProtoMessage() {}
+func (*EnvironmentVariables) ProtoMessage() {}
 
-func (x *CreateAppRequest) ProtoReflect() protoreflect.Message {
+func (x *EnvironmentVariables) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protos_app_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -312,89 +564,3044 @@ func (x *CreateAppRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAppRequest.ProtoReflect.Descriptor instead.
-func (*CreateAppRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnvironmentVariables.ProtoReflect.Descriptor instead.
+func (*EnvironmentVariables) Descriptor() ([]byte, []int) {
 	return file_src_protos_app_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateAppRequest) GetProjectId() string {
+func (x *EnvironmentVariables) GetId() string {
 	if x != nil {
-		return x.ProjectId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateAppRequest) GetUserId() string {
+func (x *EnvironmentVariables) GetValue() string {
 	if x != nil {
-		return x.UserId
+		return x.Value
 	}
 	return ""
 }
 
-func (x *CreateAppRequest) GetName() string {
+func (x *EnvironmentVariables) GetCreateAt() string {
 	if x != nil {
-		return x.Name
+		return x.CreateAt
 	}
 	return ""
 }
 
-func (x *CreateAppRequest) GetRuntime() string {
-	if x != nil {
-		return x.Runtime
-	}
-	return ""
+type EnvironmentVariable struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AppId         string                 `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	IsSecret      bool                   `protobuf:"varint,5,opt,name=is_secret,json=isSecret,proto3" json:"is_secret,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAppRequest) GetGitRepository() *GitRepository {
-	if x != nil {
-		return x.GitRepository
-	}
-	return nil
+func (x *EnvironmentVariable) Reset() {
+	*x = EnvironmentVariable{}
+	mi := &file_src_protos_app_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnvironmentVariable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvironmentVariable) ProtoMessage() {}
+
+func (x *EnvironmentVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvironmentVariable.ProtoReflect.Descriptor instead.
+func (*EnvironmentVariable) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EnvironmentVariable) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EnvironmentVariable) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *EnvironmentVariable) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EnvironmentVariable) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *EnvironmentVariable) GetIsSecret() bool {
+	if x != nil {
+		return x.IsSecret
+	}
+	return false
+}
+
+func (x *EnvironmentVariable) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *EnvironmentVariable) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type EnvironmentGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnvironmentGroup) Reset() {
+	*x = EnvironmentGroup{}
+	mi := &file_src_protos_app_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnvironmentGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvironmentGroup) ProtoMessage() {}
+
+func (x *EnvironmentGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvironmentGroup.ProtoReflect.Descriptor instead.
+func (*EnvironmentGroup) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EnvironmentGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EnvironmentGroup) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *EnvironmentGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EnvironmentGroup) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type EnvironmentGroupVariable struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	IsSecret      bool                   `protobuf:"varint,5,opt,name=is_secret,json=isSecret,proto3" json:"is_secret,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnvironmentGroupVariable) Reset() {
+	*x = EnvironmentGroupVariable{}
+	mi := &file_src_protos_app_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnvironmentGroupVariable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvironmentGroupVariable) ProtoMessage() {}
+
+func (x *EnvironmentGroupVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvironmentGroupVariable.ProtoReflect.Descriptor instead.
+func (*EnvironmentGroupVariable) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EnvironmentGroupVariable) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EnvironmentGroupVariable) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *EnvironmentGroupVariable) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EnvironmentGroupVariable) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *EnvironmentGroupVariable) GetIsSecret() bool {
+	if x != nil {
+		return x.IsSecret
+	}
+	return false
+}
+
+func (x *EnvironmentGroupVariable) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *EnvironmentGroupVariable) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GitRepository struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AppId         string                 `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Provider      string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	CloneUrl      string                 `protobuf:"bytes,4,opt,name=clone_url,json=cloneUrl,proto3" json:"clone_url,omitempty"`
+	IsPrivate     bool                   `protobuf:"varint,5,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitRepository) Reset() {
+	*x = GitRepository{}
+	mi := &file_src_protos_app_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitRepository) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitRepository) ProtoMessage() {}
+
+func (x *GitRepository) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitRepository.ProtoReflect.Descriptor instead.
+func (*GitRepository) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GitRepository) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GitRepository) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *GitRepository) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *GitRepository) GetCloneUrl() string {
+	if x != nil {
+		return x.CloneUrl
+	}
+	return ""
+}
+
+func (x *GitRepository) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
+}
+
+func (x *GitRepository) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateAppRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId              string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UserId                 string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name                   string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Runtime                string                 `protobuf:"bytes,4,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	GitRepository          *GitRepository         `protobuf:"bytes,5,opt,name=git_repository,json=gitRepository,proto3" json:"git_repository,omitempty"`
+	BuildCmd               string                 `protobuf:"bytes,6,opt,name=build_cmd,json=buildCmd,proto3" json:"build_cmd,omitempty"`
+	StartCmd               string                 `protobuf:"bytes,7,opt,name=start_cmd,json=startCmd,proto3" json:"start_cmd,omitempty"`
+	EnvironmentVariables   *string                `protobuf:"bytes,8,opt,name=environment_variables,json=environmentVariables,proto3,oneof" json:"environment_variables,omitempty"`
+	Type                   string                 `protobuf:"bytes,9,opt,name=type,proto3" json:"type,omitempty"`
+	Schedule               string                 `protobuf:"bytes,10,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	PublishDir             string                 `protobuf:"bytes,11,opt,name=publish_dir,json=publishDir,proto3" json:"publish_dir,omitempty"`
+	PreviewsEnabled        bool                   `protobuf:"varint,12,opt,name=previews_enabled,json=previewsEnabled,proto3" json:"previews_enabled,omitempty"`
+	ScaleToZeroIdleMinutes int32                  `protobuf:"varint,13,opt,name=scale_to_zero_idle_minutes,json=scaleToZeroIdleMinutes,proto3" json:"scale_to_zero_idle_minutes,omitempty"`
+	DeploymentStrategy     string                 `protobuf:"bytes,14,opt,name=deployment_strategy,json=deploymentStrategy,proto3" json:"deployment_strategy,omitempty"`
+	RollingMaxSurge        string                 `protobuf:"bytes,15,opt,name=rolling_max_surge,json=rollingMaxSurge,proto3" json:"rolling_max_surge,omitempty"`
+	RollingMaxUnavailable  string                 `protobuf:"bytes,16,opt,name=rolling_max_unavailable,json=rollingMaxUnavailable,proto3" json:"rolling_max_unavailable,omitempty"`
+	CanaryWeight           int32                  `protobuf:"varint,17,opt,name=canary_weight,json=canaryWeight,proto3" json:"canary_weight,omitempty"`
+	PreDeployCmd           string                 `protobuf:"bytes,18,opt,name=pre_deploy_cmd,json=preDeployCmd,proto3" json:"pre_deploy_cmd,omitempty"`
+	PostDeployCmd          string                 `protobuf:"bytes,19,opt,name=post_deploy_cmd,json=postDeployCmd,proto3" json:"post_deploy_cmd,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateAppRequest) Reset() {
+	*x = CreateAppRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAppRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAppRequest) ProtoMessage() {}
+
+func (x *CreateAppRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAppRequest.ProtoReflect.Descriptor instead.
+func (*CreateAppRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateAppRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateAppRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateAppRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAppRequest) GetRuntime() string {
+	if x != nil {
+		return x.Runtime
+	}
+	return ""
+}
+
+func (x *CreateAppRequest) GetGitRepository() *GitRepository {
+	if x != nil {
+		return x.GitRepository
+	}
+	return nil
+}
+
+func (x *CreateAppRequest) GetBuildCmd() string {
+	if x != nil {
+		return x.BuildCmd
+	}
+	return ""
+}
+
+func (x *CreateAppRequest) GetStartCmd() string {
+	if x != nil {
+		return x.StartCmd
+	}
+	return ""
+}
+
+func (x *CreateAppRequest) GetEnvironmentVariables() string {
+	if x != nil && x.EnvironmentVariables != nil {
+		return *x.EnvironmentVariables
+	}
+	return ""
+}
+
+func (x *CreateAppRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateAppRequest) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *CreateAppRequest) GetPublishDir() string {
+	if x != nil {
+		return x.PublishDir
+	}
+	return ""
+}
+
+func (x *CreateAppRequest) GetPreviewsEnabled() bool {
+	if x != nil {
+		return x.PreviewsEnabled
+	}
+	return false
+}
+
+func (x *CreateAppRequest) GetScaleToZeroIdleMinutes() int32 {
+	if x != nil {
+		return x.ScaleToZeroIdleMinutes
+	}
+	return 0
+}
+
+func (x *CreateAppRequest) GetDeploymentStrategy() string {
+	if x != nil {
+		return x.DeploymentStrategy
+	}
+	return ""
+}
+
+func (x *CreateAppRequest) GetRollingMaxSurge() string {
+	if x != nil {
+		return x.RollingMaxSurge
+	}
+	return ""
+}
+
+func (x *CreateAppRequest) GetRollingMaxUnavailable() string {
+	if x != nil {
+		return x.RollingMaxUnavailable
+	}
+	return ""
+}
+
+func (x *CreateAppRequest) GetCanaryWeight() int32 {
+	if x != nil {
+		return x.CanaryWeight
+	}
+	return 0
+}
+
+func (x *CreateAppRequest) GetPreDeployCmd() string {
+	if x != nil {
+		return x.PreDeployCmd
+	}
+	return ""
+}
+
+func (x *CreateAppRequest) GetPostDeployCmd() string {
+	if x != nil {
+		return x.PostDeployCmd
+	}
+	return ""
+}
+
+type CreateAppResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	App           *App                   `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAppResponse) Reset() {
+	*x = CreateAppResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAppResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAppResponse) ProtoMessage() {}
+
+func (x *CreateAppResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAppResponse.ProtoReflect.Descriptor instead.
+func (*CreateAppResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateAppResponse) GetApp() *App {
+	if x != nil {
+		return x.App
+	}
+	return nil
+}
+
+type GetAppRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppRequest) Reset() {
+	*x = GetAppRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppRequest) ProtoMessage() {}
+
+func (x *GetAppRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppRequest.ProtoReflect.Descriptor instead.
+func (*GetAppRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetAppRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *GetAppRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GetAppResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	App           *App                   `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppResponse) Reset() {
+	*x = GetAppResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppResponse) ProtoMessage() {}
+
+func (x *GetAppResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppResponse.ProtoReflect.Descriptor instead.
+func (*GetAppResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetAppResponse) GetApp() *App {
+	if x != nil {
+		return x.App
+	}
+	return nil
+}
+
+type GetAppsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppsRequest) Reset() {
+	*x = GetAppsRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppsRequest) ProtoMessage() {}
+
+func (x *GetAppsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppsRequest.ProtoReflect.Descriptor instead.
+func (*GetAppsRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetAppsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GetAppsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Apps          []*App                 `protobuf:"bytes,1,rep,name=apps,proto3" json:"apps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppsResponse) Reset() {
+	*x = GetAppsResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppsResponse) ProtoMessage() {}
+
+func (x *GetAppsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppsResponse.ProtoReflect.Descriptor instead.
+func (*GetAppsResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetAppsResponse) GetApps() []*App {
+	if x != nil {
+		return x.Apps
+	}
+	return nil
+}
+
+type UpdateAppRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId       string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AppId           string                 `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Name            *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	BuildCmd        *string                `protobuf:"bytes,4,opt,name=build_cmd,json=buildCmd,proto3,oneof" json:"build_cmd,omitempty"`
+	StartCmd        *string                `protobuf:"bytes,5,opt,name=start_cmd,json=startCmd,proto3,oneof" json:"start_cmd,omitempty"`
+	Schedule        *string                `protobuf:"bytes,6,opt,name=schedule,proto3,oneof" json:"schedule,omitempty"`
+	PreviewsEnabled *bool                  `protobuf:"varint,7,opt,name=previews_enabled,json=previewsEnabled,proto3,oneof" json:"previews_enabled,omitempty"`
+	// scale_to_zero_idle_minutes is applied by the next deployment of the app.
+	ScaleToZeroIdleMinutes *int32 `protobuf:"varint,8,opt,name=scale_to_zero_idle_minutes,json=scaleToZeroIdleMinutes,proto3,oneof" json:"scale_to_zero_idle_minutes,omitempty"`
+	// the deployment strategy settings are applied by the next deployment of the app.
+	DeploymentStrategy    *string `protobuf:"bytes,9,opt,name=deployment_strategy,json=deploymentStrategy,proto3,oneof" json:"deployment_strategy,omitempty"`
+	RollingMaxSurge       *string `protobuf:"bytes,10,opt,name=rolling_max_surge,json=rollingMaxSurge,proto3,oneof" json:"rolling_max_surge,omitempty"`
+	RollingMaxUnavailable *string `protobuf:"bytes,11,opt,name=rolling_max_unavailable,json=rollingMaxUnavailable,proto3,oneof" json:"rolling_max_unavailable,omitempty"`
+	CanaryWeight          *int32  `protobuf:"varint,12,opt,name=canary_weight,json=canaryWeight,proto3,oneof" json:"canary_weight,omitempty"`
+	PreDeployCmd          *string `protobuf:"bytes,13,opt,name=pre_deploy_cmd,json=preDeployCmd,proto3,oneof" json:"pre_deploy_cmd,omitempty"`
+	PostDeployCmd         *string `protobuf:"bytes,14,opt,name=post_deploy_cmd,json=postDeployCmd,proto3,oneof" json:"post_deploy_cmd,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateAppRequest) Reset() {
+	*x = UpdateAppRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAppRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppRequest) ProtoMessage() {}
+
+func (x *UpdateAppRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAppRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateAppRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *UpdateAppRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *UpdateAppRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateAppRequest) GetBuildCmd() string {
+	if x != nil && x.BuildCmd != nil {
+		return *x.BuildCmd
+	}
+	return ""
+}
+
+func (x *UpdateAppRequest) GetStartCmd() string {
+	if x != nil && x.StartCmd != nil {
+		return *x.StartCmd
+	}
+	return ""
+}
+
+func (x *UpdateAppRequest) GetSchedule() string {
+	if x != nil && x.Schedule != nil {
+		return *x.Schedule
+	}
+	return ""
+}
+
+func (x *UpdateAppRequest) GetPreviewsEnabled() bool {
+	if x != nil && x.PreviewsEnabled != nil {
+		return *x.PreviewsEnabled
+	}
+	return false
+}
+
+func (x *UpdateAppRequest) GetScaleToZeroIdleMinutes() int32 {
+	if x != nil && x.ScaleToZeroIdleMinutes != nil {
+		return *x.ScaleToZeroIdleMinutes
+	}
+	return 0
+}
+
+func (x *UpdateAppRequest) GetDeploymentStrategy() string {
+	if x != nil && x.DeploymentStrategy != nil {
+		return *x.DeploymentStrategy
+	}
+	return ""
+}
+
+func (x *UpdateAppRequest) GetRollingMaxSurge() string {
+	if x != nil && x.RollingMaxSurge != nil {
+		return *x.RollingMaxSurge
+	}
+	return ""
+}
+
+func (x *UpdateAppRequest) GetRollingMaxUnavailable() string {
+	if x != nil && x.RollingMaxUnavailable != nil {
+		return *x.RollingMaxUnavailable
+	}
+	return ""
+}
+
+func (x *UpdateAppRequest) GetCanaryWeight() int32 {
+	if x != nil && x.CanaryWeight != nil {
+		return *x.CanaryWeight
+	}
+	return 0
+}
+
+func (x *UpdateAppRequest) GetPreDeployCmd() string {
+	if x != nil && x.PreDeployCmd != nil {
+		return *x.PreDeployCmd
+	}
+	return ""
+}
+
+func (x *UpdateAppRequest) GetPostDeployCmd() string {
+	if x != nil && x.PostDeployCmd != nil {
+		return *x.PostDeployCmd
+	}
+	return ""
+}
+
+type UpdateAppResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	App           *App                   `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAppResponse) Reset() {
+	*x = UpdateAppResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAppResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppResponse) ProtoMessage() {}
+
+func (x *UpdateAppResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAppResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateAppResponse) GetApp() *App {
+	if x != nil {
+		return x.App
+	}
+	return nil
+}
+
+type DeleteAppRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AppId         string                 `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAppRequest) Reset() {
+	*x = DeleteAppRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAppRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAppRequest) ProtoMessage() {}
+
+func (x *DeleteAppRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAppRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAppRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteAppRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *DeleteAppRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type DeleteAppResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAppResponse) Reset() {
+	*x = DeleteAppResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAppResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAppResponse) ProtoMessage() {}
+
+func (x *DeleteAppResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAppResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAppResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{17}
+}
+
+// A suspended app keeps its resources but runs no instance, its domain serves a placeholder page.
+type SuspendAppRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AppId         string                 `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendAppRequest) Reset() {
+	*x = SuspendAppRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendAppRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendAppRequest) ProtoMessage() {}
+
+func (x *SuspendAppRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendAppRequest.ProtoReflect.Descriptor instead.
+func (*SuspendAppRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SuspendAppRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *SuspendAppRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type SuspendAppResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	App           *App                   `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendAppResponse) Reset() {
+	*x = SuspendAppResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendAppResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendAppResponse) ProtoMessage() {}
+
+func (x *SuspendAppResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendAppResponse.ProtoReflect.Descriptor instead.
+func (*SuspendAppResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SuspendAppResponse) GetApp() *App {
+	if x != nil {
+		return x.App
+	}
+	return nil
+}
+
+type ResumeAppRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AppId         string                 `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeAppRequest) Reset() {
+	*x = ResumeAppRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeAppRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeAppRequest) ProtoMessage() {}
+
+func (x *ResumeAppRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeAppRequest.ProtoReflect.Descriptor instead.
+func (*ResumeAppRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ResumeAppRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ResumeAppRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type ResumeAppResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	App           *App                   `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeAppResponse) Reset() {
+	*x = ResumeAppResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeAppResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeAppResponse) ProtoMessage() {}
+
+func (x *ResumeAppResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeAppResponse.ProtoReflect.Descriptor instead.
+func (*ResumeAppResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ResumeAppResponse) GetApp() *App {
+	if x != nil {
+		return x.App
+	}
+	return nil
+}
+
+type GetAppDeploymentConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppDeploymentConfigRequest) Reset() {
+	*x = GetAppDeploymentConfigRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppDeploymentConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppDeploymentConfigRequest) ProtoMessage() {}
+
+func (x *GetAppDeploymentConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppDeploymentConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetAppDeploymentConfigRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetAppDeploymentConfigRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type GetAppDeploymentConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *AppDeploymentConfig   `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppDeploymentConfigResponse) Reset() {
+	*x = GetAppDeploymentConfigResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppDeploymentConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppDeploymentConfigResponse) ProtoMessage() {}
+
+func (x *GetAppDeploymentConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppDeploymentConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetAppDeploymentConfigResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetAppDeploymentConfigResponse) GetConfig() *AppDeploymentConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type GetAppDiskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AppId         string                 `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppDiskRequest) Reset() {
+	*x = GetAppDiskRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppDiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppDiskRequest) ProtoMessage() {}
+
+func (x *GetAppDiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppDiskRequest.ProtoReflect.Descriptor instead.
+func (*GetAppDiskRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetAppDiskRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GetAppDiskRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type GetAppDiskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Disk          *Disk                  `protobuf:"bytes,1,opt,name=disk,proto3" json:"disk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppDiskResponse) Reset() {
+	*x = GetAppDiskResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppDiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppDiskResponse) ProtoMessage() {}
+
+func (x *GetAppDiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppDiskResponse.ProtoReflect.Descriptor instead.
+func (*GetAppDiskResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetAppDiskResponse) GetDisk() *Disk {
+	if x != nil {
+		return x.Disk
+	}
+	return nil
+}
+
+// Disk changes are applied by the next deployment of the app.
+type SetAppDiskRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AppId     string                 `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	MountPath string                 `protobuf:"bytes,3,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
+	// size_gb can grow but never shrink.
+	SizeGb        int32 `protobuf:"varint,4,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAppDiskRequest) Reset() {
+	*x = SetAppDiskRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAppDiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAppDiskRequest) ProtoMessage() {}
+
+func (x *SetAppDiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAppDiskRequest.ProtoReflect.Descriptor instead.
+func (*SetAppDiskRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetAppDiskRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *SetAppDiskRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *SetAppDiskRequest) GetMountPath() string {
+	if x != nil {
+		return x.MountPath
+	}
+	return ""
+}
+
+func (x *SetAppDiskRequest) GetSizeGb() int32 {
+	if x != nil {
+		return x.SizeGb
+	}
+	return 0
+}
+
+type SetAppDiskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Disk          *Disk                  `protobuf:"bytes,1,opt,name=disk,proto3" json:"disk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAppDiskResponse) Reset() {
+	*x = SetAppDiskResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAppDiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAppDiskResponse) ProtoMessage() {}
+
+func (x *SetAppDiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAppDiskResponse.ProtoReflect.Descriptor instead.
+func (*SetAppDiskResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetAppDiskResponse) GetDisk() *Disk {
+	if x != nil {
+		return x.Disk
+	}
+	return nil
+}
+
+type DeleteAppDiskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AppId         string                 `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAppDiskRequest) Reset() {
+	*x = DeleteAppDiskRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAppDiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAppDiskRequest) ProtoMessage() {}
+
+func (x *DeleteAppDiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAppDiskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAppDiskRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteAppDiskRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *DeleteAppDiskRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type DeleteAppDiskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAppDiskResponse) Reset() {
+	*x = DeleteAppDiskResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAppDiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAppDiskResponse) ProtoMessage() {}
+
+func (x *DeleteAppDiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAppDiskResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAppDiskResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{29}
+}
+
+type GetEnvironmentVariablesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEnvironmentVariablesRequest) Reset() {
+	*x = GetEnvironmentVariablesRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnvironmentVariablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnvironmentVariablesRequest) ProtoMessage() {}
+
+func (x *GetEnvironmentVariablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnvironmentVariablesRequest.ProtoReflect.Descriptor instead.
+func (*GetEnvironmentVariablesRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetEnvironmentVariablesRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type GetEnvironmentVariablesResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	EnvironmentVariable  *EnvironmentVariables  `protobuf:"bytes,1,opt,name=environment_variable,json=environmentVariable,proto3" json:"environment_variable,omitempty"`
+	EnvironmentVariables []*EnvironmentVariable `protobuf:"bytes,2,rep,name=environment_variables,json=environmentVariables,proto3" json:"environment_variables,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetEnvironmentVariablesResponse) Reset() {
+	*x = GetEnvironmentVariablesResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnvironmentVariablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnvironmentVariablesResponse) ProtoMessage() {}
+
+func (x *GetEnvironmentVariablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnvironmentVariablesResponse.ProtoReflect.Descriptor instead.
+func (*GetEnvironmentVariablesResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetEnvironmentVariablesResponse) GetEnvironmentVariable() *EnvironmentVariables {
+	if x != nil {
+		return x.EnvironmentVariable
+	}
+	return nil
+}
+
+func (x *GetEnvironmentVariablesResponse) GetEnvironmentVariables() []*EnvironmentVariable {
+	if x != nil {
+		return x.EnvironmentVariables
+	}
+	return nil
+}
+
+type CreateEnvironmentVariablesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	SkipRestart   bool                   `protobuf:"varint,3,opt,name=skip_restart,json=skipRestart,proto3" json:"skip_restart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEnvironmentVariablesRequest) Reset() {
+	*x = CreateEnvironmentVariablesRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEnvironmentVariablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEnvironmentVariablesRequest) ProtoMessage() {}
+
+func (x *CreateEnvironmentVariablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEnvironmentVariablesRequest.ProtoReflect.Descriptor instead.
+func (*CreateEnvironmentVariablesRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateEnvironmentVariablesRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *CreateEnvironmentVariablesRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *CreateEnvironmentVariablesRequest) GetSkipRestart() bool {
+	if x != nil {
+		return x.SkipRestart
+	}
+	return false
+}
+
+type CreateEnvironmentVariablesResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	EnvironmentVariable *EnvironmentVariables  `protobuf:"bytes,1,opt,name=environment_variable,json=environmentVariable,proto3" json:"environment_variable,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateEnvironmentVariablesResponse) Reset() {
+	*x = CreateEnvironmentVariablesResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEnvironmentVariablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEnvironmentVariablesResponse) ProtoMessage() {}
+
+func (x *CreateEnvironmentVariablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEnvironmentVariablesResponse.ProtoReflect.Descriptor instead.
+func (*CreateEnvironmentVariablesResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateEnvironmentVariablesResponse) GetEnvironmentVariable() *EnvironmentVariables {
+	if x != nil {
+		return x.EnvironmentVariable
+	}
+	return nil
+}
+
+type UpdateEnvironmentVariablesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	SkipRestart   bool                   `protobuf:"varint,3,opt,name=skip_restart,json=skipRestart,proto3" json:"skip_restart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEnvironmentVariablesRequest) Reset() {
+	*x = UpdateEnvironmentVariablesRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEnvironmentVariablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEnvironmentVariablesRequest) ProtoMessage() {}
+
+func (x *UpdateEnvironmentVariablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEnvironmentVariablesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEnvironmentVariablesRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateEnvironmentVariablesRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *UpdateEnvironmentVariablesRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *UpdateEnvironmentVariablesRequest) GetSkipRestart() bool {
+	if x != nil {
+		return x.SkipRestart
+	}
+	return false
+}
+
+type UpdateEnvironmentVariablesResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	EnvironmentVariable *EnvironmentVariables  `protobuf:"bytes,1,opt,name=environment_variable,json=environmentVariable,proto3" json:"environment_variable,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateEnvironmentVariablesResponse) Reset() {
+	*x = UpdateEnvironmentVariablesResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEnvironmentVariablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEnvironmentVariablesResponse) ProtoMessage() {}
+
+func (x *UpdateEnvironmentVariablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEnvironmentVariablesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEnvironmentVariablesResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateEnvironmentVariablesResponse) GetEnvironmentVariable() *EnvironmentVariables {
+	if x != nil {
+		return x.EnvironmentVariable
+	}
+	return nil
+}
+
+type DeleteEnvironmentVariablesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	SkipRestart   bool                   `protobuf:"varint,2,opt,name=skip_restart,json=skipRestart,proto3" json:"skip_restart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEnvironmentVariablesRequest) Reset() {
+	*x = DeleteEnvironmentVariablesRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEnvironmentVariablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEnvironmentVariablesRequest) ProtoMessage() {}
+
+func (x *DeleteEnvironmentVariablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEnvironmentVariablesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEnvironmentVariablesRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteEnvironmentVariablesRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *DeleteEnvironmentVariablesRequest) GetSkipRestart() bool {
+	if x != nil {
+		return x.SkipRestart
+	}
+	return false
+}
+
+type DeleteEnvironmentVariablesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEnvironmentVariablesResponse) Reset() {
+	*x = DeleteEnvironmentVariablesResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEnvironmentVariablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEnvironmentVariablesResponse) ProtoMessage() {}
+
+func (x *DeleteEnvironmentVariablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEnvironmentVariablesResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEnvironmentVariablesResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{37}
+}
+
+type SetEnvironmentVariableRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	AppId string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Key   string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// an existing variable keeps is_secret when it is not set.
+	IsSecret      *bool `protobuf:"varint,4,opt,name=is_secret,json=isSecret,proto3,oneof" json:"is_secret,omitempty"`
+	SkipRestart   bool  `protobuf:"varint,5,opt,name=skip_restart,json=skipRestart,proto3" json:"skip_restart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEnvironmentVariableRequest) Reset() {
+	*x = SetEnvironmentVariableRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEnvironmentVariableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEnvironmentVariableRequest) ProtoMessage() {}
+
+func (x *SetEnvironmentVariableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEnvironmentVariableRequest.ProtoReflect.Descriptor instead.
+func (*SetEnvironmentVariableRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SetEnvironmentVariableRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *SetEnvironmentVariableRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetEnvironmentVariableRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SetEnvironmentVariableRequest) GetIsSecret() bool {
+	if x != nil && x.IsSecret != nil {
+		return *x.IsSecret
+	}
+	return false
+}
+
+func (x *SetEnvironmentVariableRequest) GetSkipRestart() bool {
+	if x != nil {
+		return x.SkipRestart
+	}
+	return false
+}
+
+type SetEnvironmentVariableResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	EnvironmentVariable *EnvironmentVariable   `protobuf:"bytes,1,opt,name=environment_variable,json=environmentVariable,proto3" json:"environment_variable,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SetEnvironmentVariableResponse) Reset() {
+	*x = SetEnvironmentVariableResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEnvironmentVariableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEnvironmentVariableResponse) ProtoMessage() {}
+
+func (x *SetEnvironmentVariableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEnvironmentVariableResponse.ProtoReflect.Descriptor instead.
+func (*SetEnvironmentVariableResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetEnvironmentVariableResponse) GetEnvironmentVariable() *EnvironmentVariable {
+	if x != nil {
+		return x.EnvironmentVariable
+	}
+	return nil
+}
+
+type DeleteEnvironmentVariableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	SkipRestart   bool                   `protobuf:"varint,3,opt,name=skip_restart,json=skipRestart,proto3" json:"skip_restart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEnvironmentVariableRequest) Reset() {
+	*x = DeleteEnvironmentVariableRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEnvironmentVariableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEnvironmentVariableRequest) ProtoMessage() {}
+
+func (x *DeleteEnvironmentVariableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEnvironmentVariableRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEnvironmentVariableRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteEnvironmentVariableRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *DeleteEnvironmentVariableRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DeleteEnvironmentVariableRequest) GetSkipRestart() bool {
+	if x != nil {
+		return x.SkipRestart
+	}
+	return false
+}
+
+type DeleteEnvironmentVariableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEnvironmentVariableResponse) Reset() {
+	*x = DeleteEnvironmentVariableResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEnvironmentVariableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEnvironmentVariableResponse) ProtoMessage() {}
+
+func (x *DeleteEnvironmentVariableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEnvironmentVariableResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEnvironmentVariableResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{41}
+}
+
+type ResolveEnvironmentVariablesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveEnvironmentVariablesRequest) Reset() {
+	*x = ResolveEnvironmentVariablesRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveEnvironmentVariablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveEnvironmentVariablesRequest) ProtoMessage() {}
+
+func (x *ResolveEnvironmentVariablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveEnvironmentVariablesRequest.ProtoReflect.Descriptor instead.
+func (*ResolveEnvironmentVariablesRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ResolveEnvironmentVariablesRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type ResolveEnvironmentVariablesResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	EnvironmentVariables map[string]string      `protobuf:"bytes,1,rep,name=environment_variables,json=environmentVariables,proto3" json:"environment_variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ResolveEnvironmentVariablesResponse) Reset() {
+	*x = ResolveEnvironmentVariablesResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveEnvironmentVariablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveEnvironmentVariablesResponse) ProtoMessage() {}
+
+func (x *ResolveEnvironmentVariablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveEnvironmentVariablesResponse.ProtoReflect.Descriptor instead.
+func (*ResolveEnvironmentVariablesResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ResolveEnvironmentVariablesResponse) GetEnvironmentVariables() map[string]string {
+	if x != nil {
+		return x.EnvironmentVariables
+	}
+	return nil
+}
+
+type ImportEnvironmentVariablesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	AppId string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	// content is a .env file.
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// replace deletes the keys missing from the file instead of keeping them.
+	Replace       bool `protobuf:"varint,3,opt,name=replace,proto3" json:"replace,omitempty"`
+	SkipRestart   bool `protobuf:"varint,4,opt,name=skip_restart,json=skipRestart,proto3" json:"skip_restart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportEnvironmentVariablesRequest) Reset() {
+	*x = ImportEnvironmentVariablesRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEnvironmentVariablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEnvironmentVariablesRequest) ProtoMessage() {}
+
+func (x *ImportEnvironmentVariablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEnvironmentVariablesRequest.ProtoReflect.Descriptor instead.
+func (*ImportEnvironmentVariablesRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ImportEnvironmentVariablesRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *ImportEnvironmentVariablesRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ImportEnvironmentVariablesRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+func (x *ImportEnvironmentVariablesRequest) GetSkipRestart() bool {
+	if x != nil {
+		return x.SkipRestart
+	}
+	return false
+}
+
+type ImportEnvironmentVariablesResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	EnvironmentVariables []*EnvironmentVariable `protobuf:"bytes,1,rep,name=environment_variables,json=environmentVariables,proto3" json:"environment_variables,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ImportEnvironmentVariablesResponse) Reset() {
+	*x = ImportEnvironmentVariablesResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEnvironmentVariablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEnvironmentVariablesResponse) ProtoMessage() {}
+
+func (x *ImportEnvironmentVariablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEnvironmentVariablesResponse.ProtoReflect.Descriptor instead.
+func (*ImportEnvironmentVariablesResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ImportEnvironmentVariablesResponse) GetEnvironmentVariables() []*EnvironmentVariable {
+	if x != nil {
+		return x.EnvironmentVariables
+	}
+	return nil
+}
+
+type ExportEnvironmentVariablesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportEnvironmentVariablesRequest) Reset() {
+	*x = ExportEnvironmentVariablesRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportEnvironmentVariablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEnvironmentVariablesRequest) ProtoMessage() {}
+
+func (x *ExportEnvironmentVariablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEnvironmentVariablesRequest.ProtoReflect.Descriptor instead.
+func (*ExportEnvironmentVariablesRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ExportEnvironmentVariablesRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type ExportEnvironmentVariablesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// content is a .env file, secret values are masked.
+	Content       string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportEnvironmentVariablesResponse) Reset() {
+	*x = ExportEnvironmentVariablesResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportEnvironmentVariablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEnvironmentVariablesResponse) ProtoMessage() {}
+
+func (x *ExportEnvironmentVariablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEnvironmentVariablesResponse.ProtoReflect.Descriptor instead.
+func (*ExportEnvironmentVariablesResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ExportEnvironmentVariablesResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type CreateEnvironmentGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEnvironmentGroupRequest) Reset() {
+	*x = CreateEnvironmentGroupRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEnvironmentGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEnvironmentGroupRequest) ProtoMessage() {}
+
+func (x *CreateEnvironmentGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEnvironmentGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateEnvironmentGroupRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateEnvironmentGroupRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateEnvironmentGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateEnvironmentGroupResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EnvironmentGroup *EnvironmentGroup      `protobuf:"bytes,1,opt,name=environment_group,json=environmentGroup,proto3" json:"environment_group,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateEnvironmentGroupResponse) Reset() {
+	*x = CreateEnvironmentGroupResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEnvironmentGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEnvironmentGroupResponse) ProtoMessage() {}
+
+func (x *CreateEnvironmentGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEnvironmentGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateEnvironmentGroupResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateEnvironmentGroupResponse) GetEnvironmentGroup() *EnvironmentGroup {
+	if x != nil {
+		return x.EnvironmentGroup
+	}
+	return nil
+}
+
+type GetEnvironmentGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEnvironmentGroupRequest) Reset() {
+	*x = GetEnvironmentGroupRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnvironmentGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnvironmentGroupRequest) ProtoMessage() {}
+
+func (x *GetEnvironmentGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnvironmentGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetEnvironmentGroupRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetEnvironmentGroupRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GetEnvironmentGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GetEnvironmentGroupResponse struct {
+	state            protoimpl.MessageState      `protogen:"open.v1"`
+	EnvironmentGroup *EnvironmentGroup           `protobuf:"bytes,1,opt,name=environment_group,json=environmentGroup,proto3" json:"environment_group,omitempty"`
+	Variables        []*EnvironmentGroupVariable `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty"`
+	AppIds           []string                    `protobuf:"bytes,3,rep,name=app_ids,json=appIds,proto3" json:"app_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetEnvironmentGroupResponse) Reset() {
+	*x = GetEnvironmentGroupResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnvironmentGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnvironmentGroupResponse) ProtoMessage() {}
+
+func (x *GetEnvironmentGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnvironmentGroupResponse.ProtoReflect.Descriptor instead.
+func (*GetEnvironmentGroupResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetEnvironmentGroupResponse) GetEnvironmentGroup() *EnvironmentGroup {
+	if x != nil {
+		return x.EnvironmentGroup
+	}
+	return nil
+}
+
+func (x *GetEnvironmentGroupResponse) GetVariables() []*EnvironmentGroupVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *GetEnvironmentGroupResponse) GetAppIds() []string {
+	if x != nil {
+		return x.AppIds
+	}
+	return nil
+}
+
+type GetEnvironmentGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEnvironmentGroupsRequest) Reset() {
+	*x = GetEnvironmentGroupsRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnvironmentGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnvironmentGroupsRequest) ProtoMessage() {}
+
+func (x *GetEnvironmentGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnvironmentGroupsRequest.ProtoReflect.Descriptor instead.
+func (*GetEnvironmentGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetEnvironmentGroupsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GetEnvironmentGroupsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	EnvironmentGroups []*EnvironmentGroup    `protobuf:"bytes,1,rep,name=environment_groups,json=environmentGroups,proto3" json:"environment_groups,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetEnvironmentGroupsResponse) Reset() {
+	*x = GetEnvironmentGroupsResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnvironmentGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnvironmentGroupsResponse) ProtoMessage() {}
+
+func (x *GetEnvironmentGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnvironmentGroupsResponse.ProtoReflect.Descriptor instead.
+func (*GetEnvironmentGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetEnvironmentGroupsResponse) GetEnvironmentGroups() []*EnvironmentGroup {
+	if x != nil {
+		return x.EnvironmentGroups
+	}
+	return nil
+}
+
+type DeleteEnvironmentGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	SkipRestart   bool                   `protobuf:"varint,3,opt,name=skip_restart,json=skipRestart,proto3" json:"skip_restart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEnvironmentGroupRequest) Reset() {
+	*x = DeleteEnvironmentGroupRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEnvironmentGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEnvironmentGroupRequest) ProtoMessage() {}
+
+func (x *DeleteEnvironmentGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEnvironmentGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEnvironmentGroupRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteEnvironmentGroupRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *DeleteEnvironmentGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DeleteEnvironmentGroupRequest) GetSkipRestart() bool {
+	if x != nil {
+		return x.SkipRestart
+	}
+	return false
+}
+
+type DeleteEnvironmentGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEnvironmentGroupResponse) Reset() {
+	*x = DeleteEnvironmentGroupResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEnvironmentGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEnvironmentGroupResponse) ProtoMessage() {}
+
+func (x *DeleteEnvironmentGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEnvironmentGroupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEnvironmentGroupResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{55}
+}
+
+type SetEnvironmentGroupVariableRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	GroupId   string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Key       string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Value     string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	// an existing variable keeps is_secret when it is not set.
+	IsSecret      *bool `protobuf:"varint,5,opt,name=is_secret,json=isSecret,proto3,oneof" json:"is_secret,omitempty"`
+	SkipRestart   bool  `protobuf:"varint,6,opt,name=skip_restart,json=skipRestart,proto3" json:"skip_restart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEnvironmentGroupVariableRequest) Reset() {
+	*x = SetEnvironmentGroupVariableRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEnvironmentGroupVariableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEnvironmentGroupVariableRequest) ProtoMessage() {}
+
+func (x *SetEnvironmentGroupVariableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEnvironmentGroupVariableRequest.ProtoReflect.Descriptor instead.
+func (*SetEnvironmentGroupVariableRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SetEnvironmentGroupVariableRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *SetEnvironmentGroupVariableRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
 }
 
-func (x *CreateAppRequest) GetBuildCmd() string {
+func (x *SetEnvironmentGroupVariableRequest) GetKey() string {
 	if x != nil {
-		return x.BuildCmd
+		return x.Key
 	}
 	return ""
 }
 
-func (x *CreateAppRequest) GetStartCmd() string {
+func (x *SetEnvironmentGroupVariableRequest) GetValue() string {
 	if x != nil {
-		return x.StartCmd
+		return x.Value
 	}
 	return ""
 }
 
-func (x *CreateAppRequest) GetEnvironmentVariables() string {
-	if x != nil && x.EnvironmentVariables != nil {
-		return *x.EnvironmentVariables
+func (x *SetEnvironmentGroupVariableRequest) GetIsSecret() bool {
+	if x != nil && x.IsSecret != nil {
+		return *x.IsSecret
 	}
-	return ""
+	return false
 }
 
-type CreateAppResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	App           *App                   `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
+func (x *SetEnvironmentGroupVariableRequest) GetSkipRestart() bool {
+	if x != nil {
+		return x.SkipRestart
+	}
+	return false
+}
+
+type SetEnvironmentGroupVariableResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Variable      *EnvironmentGroupVariable `protobuf:"bytes,1,opt,name=variable,proto3" json:"variable,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAppResponse) Reset() {
-	*x = CreateAppResponse{}
-	mi := &file_src_protos_app_service_proto_msgTypes[4]
+func (x *SetEnvironmentGroupVariableResponse) Reset() {
+	*x = SetEnvironmentGroupVariableResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAppResponse) String() string {
+func (x *SetEnvironmentGroupVariableResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAppResponse) ProtoMessage() {}
+func (*SetEnvironmentGroupVariableResponse) ProtoMessage() {}
 
-func (x *CreateAppResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[4]
+func (x *SetEnvironmentGroupVariableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -405,41 +3612,43 @@ func (x *CreateAppResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAppResponse.ProtoReflect.Descriptor instead.
-func (*CreateAppResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SetEnvironmentGroupVariableResponse.ProtoReflect.Descriptor instead.
+func (*SetEnvironmentGroupVariableResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *CreateAppResponse) GetApp() *App {
+func (x *SetEnvironmentGroupVariableResponse) GetVariable() *EnvironmentGroupVariable {
 	if x != nil {
-		return x.App
+		return x.Variable
 	}
 	return nil
 }
 
-type GetAppRequest struct {
+type DeleteEnvironmentGroupVariableRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	SkipRestart   bool                   `protobuf:"varint,4,opt,name=skip_restart,json=skipRestart,proto3" json:"skip_restart,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAppRequest) Reset() {
-	*x = GetAppRequest{}
-	mi := &file_src_protos_app_service_proto_msgTypes[5]
+func (x *DeleteEnvironmentGroupVariableRequest) Reset() {
+	*x = DeleteEnvironmentGroupVariableRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAppRequest) String() string {
+func (x *DeleteEnvironmentGroupVariableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAppRequest) ProtoMessage() {}
+func (*DeleteEnvironmentGroupVariableRequest) ProtoMessage() {}
 
-func (x *GetAppRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[5]
+func (x *DeleteEnvironmentGroupVariableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,47 +3659,60 @@ func (x *GetAppRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAppRequest.ProtoReflect.Descriptor instead.
-func (*GetAppRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use DeleteEnvironmentGroupVariableRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEnvironmentGroupVariableRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetAppRequest) GetAppId() string {
+func (x *DeleteEnvironmentGroupVariableRequest) GetProjectId() string {
 	if x != nil {
-		return x.AppId
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *GetAppRequest) GetProjectId() string {
+func (x *DeleteEnvironmentGroupVariableRequest) GetGroupId() string {
 	if x != nil {
-		return x.ProjectId
+		return x.GroupId
 	}
 	return ""
 }
 
-type GetAppResponse struct {
+func (x *DeleteEnvironmentGroupVariableRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DeleteEnvironmentGroupVariableRequest) GetSkipRestart() bool {
+	if x != nil {
+		return x.SkipRestart
+	}
+	return false
+}
+
+type DeleteEnvironmentGroupVariableResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	App           *App                   `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAppResponse) Reset() {
-	*x = GetAppResponse{}
-	mi := &file_src_protos_app_service_proto_msgTypes[6]
+func (x *DeleteEnvironmentGroupVariableResponse) Reset() {
+	*x = DeleteEnvironmentGroupVariableResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAppResponse) String() string {
+func (x *DeleteEnvironmentGroupVariableResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAppResponse) ProtoMessage() {}
+func (*DeleteEnvironmentGroupVariableResponse) ProtoMessage() {}
 
-func (x *GetAppResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[6]
+func (x *DeleteEnvironmentGroupVariableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -501,40 +3723,36 @@ func (x *GetAppResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAppResponse.ProtoReflect.Descriptor instead.
-func (*GetAppResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *GetAppResponse) GetApp() *App {
-	if x != nil {
-		return x.App
-	}
-	return nil
+// Deprecated: Use DeleteEnvironmentGroupVariableResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEnvironmentGroupVariableResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{59}
 }
 
-type GetAppsRequest struct {
+type LinkEnvironmentGroupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	SkipRestart   bool                   `protobuf:"varint,4,opt,name=skip_restart,json=skipRestart,proto3" json:"skip_restart,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAppsRequest) Reset() {
-	*x = GetAppsRequest{}
-	mi := &file_src_protos_app_service_proto_msgTypes[7]
+func (x *LinkEnvironmentGroupRequest) Reset() {
+	*x = LinkEnvironmentGroupRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAppsRequest) String() string {
+func (x *LinkEnvironmentGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAppsRequest) ProtoMessage() {}
+func (*LinkEnvironmentGroupRequest) ProtoMessage() {}
 
-func (x *GetAppsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[7]
+func (x *LinkEnvironmentGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,40 +3763,60 @@ func (x *GetAppsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAppsRequest.ProtoReflect.Descriptor instead.
-func (*GetAppsRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use LinkEnvironmentGroupRequest.ProtoReflect.Descriptor instead.
+func (*LinkEnvironmentGroupRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *GetAppsRequest) GetProjectId() string {
+func (x *LinkEnvironmentGroupRequest) GetProjectId() string {
 	if x != nil {
 		return x.ProjectId
 	}
 	return ""
 }
 
-type GetAppsResponse struct {
+func (x *LinkEnvironmentGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *LinkEnvironmentGroupRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *LinkEnvironmentGroupRequest) GetSkipRestart() bool {
+	if x != nil {
+		return x.SkipRestart
+	}
+	return false
+}
+
+type LinkEnvironmentGroupResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Apps          []*App                 `protobuf:"bytes,1,rep,name=apps,proto3" json:"apps,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAppsResponse) Reset() {
-	*x = GetAppsResponse{}
-	mi := &file_src_protos_app_service_proto_msgTypes[8]
+func (x *LinkEnvironmentGroupResponse) Reset() {
+	*x = LinkEnvironmentGroupResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAppsResponse) String() string {
+func (x *LinkEnvironmentGroupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAppsResponse) ProtoMessage() {}
+func (*LinkEnvironmentGroupResponse) ProtoMessage() {}
 
-func (x *GetAppsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[8]
+func (x *LinkEnvironmentGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,44 +3827,36 @@ func (x *GetAppsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAppsResponse.ProtoReflect.Descriptor instead.
-func (*GetAppsResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *GetAppsResponse) GetApps() []*App {
-	if x != nil {
-		return x.Apps
-	}
-	return nil
+// Deprecated: Use LinkEnvironmentGroupResponse.ProtoReflect.Descriptor instead.
+func (*LinkEnvironmentGroupResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{61}
 }
 
-type UpdateAppRequest struct {
+type UnlinkEnvironmentGroupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	AppId         string                 `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	BuildCmd      *string                `protobuf:"bytes,4,opt,name=build_cmd,json=buildCmd,proto3,oneof" json:"build_cmd,omitempty"`
-	StartCmd      *string                `protobuf:"bytes,5,opt,name=start_cmd,json=startCmd,proto3,oneof" json:"start_cmd,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	AppId         string                 `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	SkipRestart   bool                   `protobuf:"varint,4,opt,name=skip_restart,json=skipRestart,proto3" json:"skip_restart,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAppRequest) Reset() {
-	*x = UpdateAppRequest{}
-	mi := &file_src_protos_app_service_proto_msgTypes[9]
+func (x *UnlinkEnvironmentGroupRequest) Reset() {
+	*x = UnlinkEnvironmentGroupRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAppRequest) String() string {
+func (x *UnlinkEnvironmentGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAppRequest) ProtoMessage() {}
+func (*UnlinkEnvironmentGroupRequest) ProtoMessage() {}
 
-func (x *UpdateAppRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[9]
+func (x *UnlinkEnvironmentGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -637,68 +3867,60 @@ func (x *UpdateAppRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAppRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAppRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use UnlinkEnvironmentGroupRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkEnvironmentGroupRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *UpdateAppRequest) GetProjectId() string {
+func (x *UnlinkEnvironmentGroupRequest) GetProjectId() string {
 	if x != nil {
 		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *UpdateAppRequest) GetAppId() string {
+func (x *UnlinkEnvironmentGroupRequest) GetGroupId() string {
 	if x != nil {
-		return x.AppId
-	}
-	return ""
-}
-
-func (x *UpdateAppRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *UpdateAppRequest) GetBuildCmd() string {
-	if x != nil && x.BuildCmd != nil {
-		return *x.BuildCmd
+func (x *UnlinkEnvironmentGroupRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
 	}
 	return ""
 }
 
-func (x *UpdateAppRequest) GetStartCmd() string {
-	if x != nil && x.StartCmd != nil {
-		return *x.StartCmd
+func (x *UnlinkEnvironmentGroupRequest) GetSkipRestart() bool {
+	if x != nil {
+		return x.SkipRestart
 	}
-	return ""
+	return false
 }
 
-type UpdateAppResponse struct {
+type UnlinkEnvironmentGroupResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	App           *App                   `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAppResponse) Reset() {
-	*x = UpdateAppResponse{}
-	mi := &file_src_protos_app_service_proto_msgTypes[10]
+func (x *UnlinkEnvironmentGroupResponse) Reset() {
+	*x = UnlinkEnvironmentGroupResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAppResponse) String() string {
+func (x *UnlinkEnvironmentGroupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAppResponse) ProtoMessage() {}
+func (*UnlinkEnvironmentGroupResponse) ProtoMessage() {}
 
-func (x *UpdateAppResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[10]
+func (x *UnlinkEnvironmentGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -709,41 +3931,33 @@ func (x *UpdateAppResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAppResponse.ProtoReflect.Descriptor instead.
-func (*UpdateAppResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *UpdateAppResponse) GetApp() *App {
-	if x != nil {
-		return x.App
-	}
-	return nil
+// Deprecated: Use UnlinkEnvironmentGroupResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkEnvironmentGroupResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{63}
 }
 
-type DeleteAppRequest struct {
+type BatchGetAppsCountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	AppId         string                 `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	ProjectIds    []string               `protobuf:"bytes,1,rep,name=project_ids,json=projectIds,proto3" json:"project_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAppRequest) Reset() {
-	*x = DeleteAppRequest{}
-	mi := &file_src_protos_app_service_proto_msgTypes[11]
+func (x *BatchGetAppsCountRequest) Reset() {
+	*x = BatchGetAppsCountRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAppRequest) String() string {
+func (x *BatchGetAppsCountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAppRequest) ProtoMessage() {}
+func (*BatchGetAppsCountRequest) ProtoMessage() {}
 
-func (x *DeleteAppRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[11]
+func (x *BatchGetAppsCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,46 +3968,40 @@ func (x *DeleteAppRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAppRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAppRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *DeleteAppRequest) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
+// Deprecated: Use BatchGetAppsCountRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetAppsCountRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *DeleteAppRequest) GetAppId() string {
+func (x *BatchGetAppsCountRequest) GetProjectIds() []string {
 	if x != nil {
-		return x.AppId
+		return x.ProjectIds
 	}
-	return ""
+	return nil
 }
 
-type DeleteAppResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type BatchGetAppsCountResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProjectAppsCount map[string]int32       `protobuf:"bytes,1,rep,name=project_apps_count,json=projectAppsCount,proto3" json:"project_apps_count,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *DeleteAppResponse) Reset() {
-	*x = DeleteAppResponse{}
-	mi := &file_src_protos_app_service_proto_msgTypes[12]
+func (x *BatchGetAppsCountResponse) Reset() {
+	*x = BatchGetAppsCountResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAppResponse) String() string {
+func (x *BatchGetAppsCountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAppResponse) ProtoMessage() {}
+func (*BatchGetAppsCountResponse) ProtoMessage() {}
 
-func (x *DeleteAppResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[12]
+func (x *BatchGetAppsCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -804,33 +4012,41 @@ func (x *DeleteAppResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAppResponse.ProtoReflect.Descriptor instead.
-func (*DeleteAppResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use BatchGetAppsCountResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetAppsCountResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{65}
 }
 
-type GetEnvironmentVariablesRequest struct {
+func (x *BatchGetAppsCountResponse) GetProjectAppsCount() map[string]int32 {
+	if x != nil {
+		return x.ProjectAppsCount
+	}
+	return nil
+}
+
+// GetExistingAppIds returns the ids among app_ids that belong to an app which was not deleted.
+type GetExistingAppIdsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	AppIds        []string               `protobuf:"bytes,1,rep,name=app_ids,json=appIds,proto3" json:"app_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEnvironmentVariablesRequest) Reset() {
-	*x = GetEnvironmentVariablesRequest{}
-	mi := &file_src_protos_app_service_proto_msgTypes[13]
+func (x *GetExistingAppIdsRequest) Reset() {
+	*x = GetExistingAppIdsRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEnvironmentVariablesRequest) String() string {
+func (x *GetExistingAppIdsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEnvironmentVariablesRequest) ProtoMessage() {}
-
-func (x *GetEnvironmentVariablesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[13]
+func (*GetExistingAppIdsRequest) ProtoMessage() {}
+
+func (x *GetExistingAppIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -841,40 +4057,40 @@ func (x *GetEnvironmentVariablesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEnvironmentVariablesRequest.ProtoReflect.Descriptor instead.
-func (*GetEnvironmentVariablesRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetExistingAppIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetExistingAppIdsRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GetEnvironmentVariablesRequest) GetAppId() string {
+func (x *GetExistingAppIdsRequest) GetAppIds() []string {
 	if x != nil {
-		return x.AppId
+		return x.AppIds
 	}
-	return ""
+	return nil
 }
 
-type GetEnvironmentVariablesResponse struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	EnvironmentVariable *EnvironmentVariables  `protobuf:"bytes,1,opt,name=environment_variable,json=environmentVariable,proto3" json:"environment_variable,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type GetExistingAppIdsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppIds        []string               `protobuf:"bytes,1,rep,name=app_ids,json=appIds,proto3" json:"app_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEnvironmentVariablesResponse) Reset() {
-	*x = GetEnvironmentVariablesResponse{}
-	mi := &file_src_protos_app_service_proto_msgTypes[14]
+func (x *GetExistingAppIdsResponse) Reset() {
+	*x = GetExistingAppIdsResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEnvironmentVariablesResponse) String() string {
+func (x *GetExistingAppIdsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEnvironmentVariablesResponse) ProtoMessage() {}
+func (*GetExistingAppIdsResponse) ProtoMessage() {}
 
-func (x *GetEnvironmentVariablesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[14]
+func (x *GetExistingAppIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -885,41 +4101,41 @@ func (x *GetEnvironmentVariablesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEnvironmentVariablesResponse.ProtoReflect.Descriptor instead.
-func (*GetEnvironmentVariablesResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetExistingAppIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetExistingAppIdsResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *GetEnvironmentVariablesResponse) GetEnvironmentVariable() *EnvironmentVariables {
+func (x *GetExistingAppIdsResponse) GetAppIds() []string {
 	if x != nil {
-		return x.EnvironmentVariable
+		return x.AppIds
 	}
 	return nil
 }
 
-type CreateEnvironmentVariablesRequest struct {
+type GetAppPreviewsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AppId         string                 `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEnvironmentVariablesRequest) Reset() {
-	*x = CreateEnvironmentVariablesRequest{}
-	mi := &file_src_protos_app_service_proto_msgTypes[15]
+func (x *GetAppPreviewsRequest) Reset() {
+	*x = GetAppPreviewsRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEnvironmentVariablesRequest) String() string {
+func (x *GetAppPreviewsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEnvironmentVariablesRequest) ProtoMessage() {}
+func (*GetAppPreviewsRequest) ProtoMessage() {}
 
-func (x *CreateEnvironmentVariablesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[15]
+func (x *GetAppPreviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -930,47 +4146,47 @@ func (x *CreateEnvironmentVariablesRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEnvironmentVariablesRequest.ProtoReflect.Descriptor instead.
-func (*CreateEnvironmentVariablesRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetAppPreviewsRequest.ProtoReflect.Descriptor instead.
+func (*GetAppPreviewsRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *CreateEnvironmentVariablesRequest) GetAppId() string {
+func (x *GetAppPreviewsRequest) GetProjectId() string {
 	if x != nil {
-		return x.AppId
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *CreateEnvironmentVariablesRequest) GetValue() string {
+func (x *GetAppPreviewsRequest) GetAppId() string {
 	if x != nil {
-		return x.Value
+		return x.AppId
 	}
 	return ""
 }
 
-type CreateEnvironmentVariablesResponse struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	EnvironmentVariable *EnvironmentVariables  `protobuf:"bytes,1,opt,name=environment_variable,json=environmentVariable,proto3" json:"environment_variable,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type GetAppPreviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Apps          []*App                 `protobuf:"bytes,1,rep,name=apps,proto3" json:"apps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEnvironmentVariablesResponse) Reset() {
-	*x = CreateEnvironmentVariablesResponse{}
-	mi := &file_src_protos_app_service_proto_msgTypes[16]
+func (x *GetAppPreviewsResponse) Reset() {
+	*x = GetAppPreviewsResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEnvironmentVariablesResponse) String() string {
+func (x *GetAppPreviewsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEnvironmentVariablesResponse) ProtoMessage() {}
+func (*GetAppPreviewsResponse) ProtoMessage() {}
 
-func (x *CreateEnvironmentVariablesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[16]
+func (x *GetAppPreviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,41 +4197,41 @@ func (x *CreateEnvironmentVariablesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEnvironmentVariablesResponse.ProtoReflect.Descriptor instead.
-func (*CreateEnvironmentVariablesResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetAppPreviewsResponse.ProtoReflect.Descriptor instead.
+func (*GetAppPreviewsResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *CreateEnvironmentVariablesResponse) GetEnvironmentVariable() *EnvironmentVariables {
+func (x *GetAppPreviewsResponse) GetApps() []*App {
 	if x != nil {
-		return x.EnvironmentVariable
+		return x.Apps
 	}
 	return nil
 }
 
-type UpdateEnvironmentVariablesRequest struct {
+// GetPreviewSourceApps returns the apps with previews enabled that are built from the repository.
+type GetPreviewSourceAppsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	CloneUrl      string                 `protobuf:"bytes,1,opt,name=clone_url,json=cloneUrl,proto3" json:"clone_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEnvironmentVariablesRequest) Reset() {
-	*x = UpdateEnvironmentVariablesRequest{}
-	mi := &file_src_protos_app_service_proto_msgTypes[17]
+func (x *GetPreviewSourceAppsRequest) Reset() {
+	*x = GetPreviewSourceAppsRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEnvironmentVariablesRequest) String() string {
+func (x *GetPreviewSourceAppsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEnvironmentVariablesRequest) ProtoMessage() {}
+func (*GetPreviewSourceAppsRequest) ProtoMessage() {}
 
-func (x *UpdateEnvironmentVariablesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[17]
+func (x *GetPreviewSourceAppsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1026,47 +4242,40 @@ func (x *UpdateEnvironmentVariablesRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEnvironmentVariablesRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEnvironmentVariablesRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *UpdateEnvironmentVariablesRequest) GetAppId() string {
-	if x != nil {
-		return x.AppId
-	}
-	return ""
+// Deprecated: Use GetPreviewSourceAppsRequest.ProtoReflect.Descriptor instead.
+func (*GetPreviewSourceAppsRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *UpdateEnvironmentVariablesRequest) GetValue() string {
+func (x *GetPreviewSourceAppsRequest) GetCloneUrl() string {
 	if x != nil {
-		return x.Value
+		return x.CloneUrl
 	}
 	return ""
 }
 
-type UpdateEnvironmentVariablesResponse struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	EnvironmentVariable *EnvironmentVariables  `protobuf:"bytes,1,opt,name=environment_variable,json=environmentVariable,proto3" json:"environment_variable,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type GetPreviewSourceAppsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Apps          []*App                 `protobuf:"bytes,1,rep,name=apps,proto3" json:"apps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEnvironmentVariablesResponse) Reset() {
-	*x = UpdateEnvironmentVariablesResponse{}
-	mi := &file_src_protos_app_service_proto_msgTypes[18]
+func (x *GetPreviewSourceAppsResponse) Reset() {
+	*x = GetPreviewSourceAppsResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEnvironmentVariablesResponse) String() string {
+func (x *GetPreviewSourceAppsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEnvironmentVariablesResponse) ProtoMessage() {}
+func (*GetPreviewSourceAppsResponse) ProtoMessage() {}
 
-func (x *UpdateEnvironmentVariablesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[18]
+func (x *GetPreviewSourceAppsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1077,40 +4286,45 @@ func (x *UpdateEnvironmentVariablesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEnvironmentVariablesResponse.ProtoReflect.Descriptor instead.
-func (*UpdateEnvironmentVariablesResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetPreviewSourceAppsResponse.ProtoReflect.Descriptor instead.
+func (*GetPreviewSourceAppsResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *UpdateEnvironmentVariablesResponse) GetEnvironmentVariable() *EnvironmentVariables {
+func (x *GetPreviewSourceAppsResponse) GetApps() []*App {
 	if x != nil {
-		return x.EnvironmentVariable
+		return x.Apps
 	}
 	return nil
 }
 
-type DeleteEnvironmentVariablesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// DeployPullRequestPreview creates the preview of the pull request on its first call
+// and rebuilds it on the next ones.
+type DeployPullRequestPreviewRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ParentAppId string                 `protobuf:"bytes,1,opt,name=parent_app_id,json=parentAppId,proto3" json:"parent_app_id,omitempty"`
+	// user_id is the owner of the project, whose GitHub token clones private repositories.
+	UserId            string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PullRequestNumber int32  `protobuf:"varint,3,opt,name=pull_request_number,json=pullRequestNumber,proto3" json:"pull_request_number,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *DeleteEnvironmentVariablesRequest) Reset() {
-	*x = DeleteEnvironmentVariablesRequest{}
-	mi := &file_src_protos_app_service_proto_msgTypes[19]
+func (x *DeployPullRequestPreviewRequest) Reset() {
+	*x = DeployPullRequestPreviewRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEnvironmentVariablesRequest) String() string {
+func (x *DeployPullRequestPreviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEnvironmentVariablesRequest) ProtoMessage() {}
+func (*DeployPullRequestPreviewRequest) ProtoMessage() {}
 
-func (x *DeleteEnvironmentVariablesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[19]
+func (x *DeployPullRequestPreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1121,39 +4335,54 @@ func (x *DeleteEnvironmentVariablesRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEnvironmentVariablesRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEnvironmentVariablesRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use DeployPullRequestPreviewRequest.ProtoReflect.Descriptor instead.
+func (*DeployPullRequestPreviewRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *DeleteEnvironmentVariablesRequest) GetAppId() string {
+func (x *DeployPullRequestPreviewRequest) GetParentAppId() string {
 	if x != nil {
-		return x.AppId
+		return x.ParentAppId
 	}
 	return ""
 }
 
-type DeleteEnvironmentVariablesResponse struct {
+func (x *DeployPullRequestPreviewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeployPullRequestPreviewRequest) GetPullRequestNumber() int32 {
+	if x != nil {
+		return x.PullRequestNumber
+	}
+	return 0
+}
+
+type DeployPullRequestPreviewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	App           *App                   `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEnvironmentVariablesResponse) Reset() {
-	*x = DeleteEnvironmentVariablesResponse{}
-	mi := &file_src_protos_app_service_proto_msgTypes[20]
+func (x *DeployPullRequestPreviewResponse) Reset() {
+	*x = DeployPullRequestPreviewResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEnvironmentVariablesResponse) String() string {
+func (x *DeployPullRequestPreviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEnvironmentVariablesResponse) ProtoMessage() {}
+func (*DeployPullRequestPreviewResponse) ProtoMessage() {}
 
-func (x *DeleteEnvironmentVariablesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[20]
+func (x *DeployPullRequestPreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1164,33 +4393,41 @@ func (x *DeleteEnvironmentVariablesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEnvironmentVariablesResponse.ProtoReflect.Descriptor instead.
-func (*DeleteEnvironmentVariablesResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use DeployPullRequestPreviewResponse.ProtoReflect.Descriptor instead.
+func (*DeployPullRequestPreviewResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{73}
 }
 
-type BatchGetAppsCountRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectIds    []string               `protobuf:"bytes,1,rep,name=project_ids,json=projectIds,proto3" json:"project_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DeployPullRequestPreviewResponse) GetApp() *App {
+	if x != nil {
+		return x.App
+	}
+	return nil
 }
 
-func (x *BatchGetAppsCountRequest) Reset() {
-	*x = BatchGetAppsCountRequest{}
-	mi := &file_src_protos_app_service_proto_msgTypes[21]
+type DeletePullRequestPreviewRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ParentAppId       string                 `protobuf:"bytes,1,opt,name=parent_app_id,json=parentAppId,proto3" json:"parent_app_id,omitempty"`
+	PullRequestNumber int32                  `protobuf:"varint,2,opt,name=pull_request_number,json=pullRequestNumber,proto3" json:"pull_request_number,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DeletePullRequestPreviewRequest) Reset() {
+	*x = DeletePullRequestPreviewRequest{}
+	mi := &file_src_protos_app_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchGetAppsCountRequest) String() string {
+func (x *DeletePullRequestPreviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchGetAppsCountRequest) ProtoMessage() {}
+func (*DeletePullRequestPreviewRequest) ProtoMessage() {}
 
-func (x *BatchGetAppsCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[21]
+func (x *DeletePullRequestPreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1201,40 +4438,46 @@ func (x *BatchGetAppsCountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchGetAppsCountRequest.ProtoReflect.Descriptor instead.
-func (*BatchGetAppsCountRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use DeletePullRequestPreviewRequest.ProtoReflect.Descriptor instead.
+func (*DeletePullRequestPreviewRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *BatchGetAppsCountRequest) GetProjectIds() []string {
+func (x *DeletePullRequestPreviewRequest) GetParentAppId() string {
 	if x != nil {
-		return x.ProjectIds
+		return x.ParentAppId
 	}
-	return nil
+	return ""
 }
 
-type BatchGetAppsCountResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ProjectAppsCount map[string]int32       `protobuf:"bytes,1,rep,name=project_apps_count,json=projectAppsCount,proto3" json:"project_apps_count,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *DeletePullRequestPreviewRequest) GetPullRequestNumber() int32 {
+	if x != nil {
+		return x.PullRequestNumber
+	}
+	return 0
 }
 
-func (x *BatchGetAppsCountResponse) Reset() {
-	*x = BatchGetAppsCountResponse{}
-	mi := &file_src_protos_app_service_proto_msgTypes[22]
+type DeletePullRequestPreviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePullRequestPreviewResponse) Reset() {
+	*x = DeletePullRequestPreviewResponse{}
+	mi := &file_src_protos_app_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchGetAppsCountResponse) String() string {
+func (x *DeletePullRequestPreviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchGetAppsCountResponse) ProtoMessage() {}
+func (*DeletePullRequestPreviewResponse) ProtoMessage() {}
 
-func (x *BatchGetAppsCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[22]
+func (x *DeletePullRequestPreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_app_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1245,16 +4488,9 @@ func (x *BatchGetAppsCountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchGetAppsCountResponse.ProtoReflect.Descriptor instead.
-func (*BatchGetAppsCountResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *BatchGetAppsCountResponse) GetProjectAppsCount() map[string]int32 {
-	if x != nil {
-		return x.ProjectAppsCount
-	}
-	return nil
+// Deprecated: Use DeletePullRequestPreviewResponse.ProtoReflect.Descriptor instead.
+func (*DeletePullRequestPreviewResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{75}
 }
 
 type HealthRequest struct {
@@ -1265,7 +4501,7 @@ type HealthRequest struct {
 
 func (x *HealthRequest) Reset() {
 	*x = HealthRequest{}
-	mi := &file_src_protos_app_service_proto_msgTypes[23]
+	mi := &file_src_protos_app_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1277,7 +4513,7 @@ func (x *HealthRequest) String() string {
 func (*HealthRequest) ProtoMessage() {}
 
 func (x *HealthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[23]
+	mi := &file_src_protos_app_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1290,7 +4526,7 @@ func (x *HealthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthRequest.ProtoReflect.Descriptor instead.
 func (*HealthRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{23}
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{76}
 }
 
 type HealthResponse struct {
@@ -1303,7 +4539,7 @@ type HealthResponse struct {
 
 func (x *HealthResponse) Reset() {
 	*x = HealthResponse{}
-	mi := &file_src_protos_app_service_proto_msgTypes[24]
+	mi := &file_src_protos_app_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1315,7 +4551,7 @@ func (x *HealthResponse) String() string {
 func (*HealthResponse) ProtoMessage() {}
 
 func (x *HealthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_app_service_proto_msgTypes[24]
+	mi := &file_src_protos_app_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1328,7 +4564,7 @@ func (x *HealthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
 func (*HealthResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_app_service_proto_rawDescGZIP(), []int{24}
+	return file_src_protos_app_service_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *HealthResponse) GetStatus() string {
@@ -1349,7 +4585,7 @@ var File_src_protos_app_service_proto protoreflect.FileDescriptor
 
 const file_src_protos_app_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1csrc/protos/app_service.proto\x12\vapp_service\"\xf7\x01\n" +
+	"\x1csrc/protos/app_service.proto\x12\vapp_service\"\xab\b\n" +
 	"\x03App\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -1362,11 +4598,88 @@ const file_src_protos_app_service_proto_rawDesc = "" +
 	"\tbuild_cmd\x18\a \x01(\tR\bbuildCmd\x12\x1b\n" +
 	"\tstart_cmd\x18\b \x01(\tR\bstartCmd\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\t \x01(\tR\tcreatedAt\"Y\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x12\n" +
+	"\x04type\x18\n" +
+	" \x01(\tR\x04type\x12\x1a\n" +
+	"\bschedule\x18\v \x01(\tR\bschedule\x12\x1f\n" +
+	"\vpublish_dir\x18\f \x01(\tR\n" +
+	"publishDir\x12)\n" +
+	"\x10previews_enabled\x18\r \x01(\bR\x0fpreviewsEnabled\x12\"\n" +
+	"\rparent_app_id\x18\x0e \x01(\tR\vparentAppId\x12.\n" +
+	"\x13pull_request_number\x18\x0f \x01(\x05R\x11pullRequestNumber\x12\x1c\n" +
+	"\tsuspended\x18\x10 \x01(\bR\tsuspended\x12:\n" +
+	"\x1ascale_to_zero_idle_minutes\x18\x11 \x01(\x05R\x16scaleToZeroIdleMinutes\x12/\n" +
+	"\x13deployment_strategy\x18\x12 \x01(\tR\x12deploymentStrategy\x12*\n" +
+	"\x11rolling_max_surge\x18\x13 \x01(\tR\x0frollingMaxSurge\x126\n" +
+	"\x17rolling_max_unavailable\x18\x14 \x01(\tR\x15rollingMaxUnavailable\x12#\n" +
+	"\rcanary_weight\x18\x15 \x01(\x05R\fcanaryWeight\x12$\n" +
+	"\x0epre_deploy_cmd\x18\x16 \x01(\tR\fpreDeployCmd\x12&\n" +
+	"\x0fpost_deploy_cmd\x18\x17 \x01(\tR\rpostDeployCmd\x12\x16\n" +
+	"\x06status\x18\x18 \x01(\tR\x06status\x12*\n" +
+	"\x11status_updated_at\x18\x19 \x01(\tR\x0fstatusUpdatedAt\x12\"\n" +
+	"\rlast_build_id\x18\x1a \x01(\tR\vlastBuildId\x12(\n" +
+	"\x10last_commit_hash\x18\x1b \x01(\tR\x0elastCommitHash\x123\n" +
+	"\x16last_deployed_build_id\x18\x1c \x01(\tR\x13lastDeployedBuildId\x129\n" +
+	"\x19last_deployed_commit_hash\x18\x1d \x01(\tR\x16lastDeployedCommitHash\"\xaf\x04\n" +
+	"\x13AppDeploymentConfig\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x19\n" +
+	"\bapp_name\x18\x02 \x01(\tR\aappName\x12\x1f\n" +
+	"\vdomain_name\x18\x03 \x01(\tR\n" +
+	"domainName\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1a\n" +
+	"\bschedule\x18\x05 \x01(\tR\bschedule\x12*\n" +
+	"\x04disk\x18\x06 \x01(\v2\x11.app_service.DiskH\x00R\x04disk\x88\x01\x01\x12\x1c\n" +
+	"\tsuspended\x18\a \x01(\bR\tsuspended\x12:\n" +
+	"\x1ascale_to_zero_idle_minutes\x18\b \x01(\x05R\x16scaleToZeroIdleMinutes\x12/\n" +
+	"\x13deployment_strategy\x18\t \x01(\tR\x12deploymentStrategy\x12*\n" +
+	"\x11rolling_max_surge\x18\n" +
+	" \x01(\tR\x0frollingMaxSurge\x126\n" +
+	"\x17rolling_max_unavailable\x18\v \x01(\tR\x15rollingMaxUnavailable\x12#\n" +
+	"\rcanary_weight\x18\f \x01(\x05R\fcanaryWeight\x12$\n" +
+	"\x0epre_deploy_cmd\x18\r \x01(\tR\fpreDeployCmd\x12&\n" +
+	"\x0fpost_deploy_cmd\x18\x0e \x01(\tR\rpostDeployCmdB\a\n" +
+	"\x05_disk\"\xa3\x01\n" +
+	"\x04Disk\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06app_id\x18\x02 \x01(\tR\x05appId\x12\x1d\n" +
+	"\n" +
+	"mount_path\x18\x03 \x01(\tR\tmountPath\x12\x17\n" +
+	"\asize_gb\x18\x04 \x01(\x05R\x06sizeGb\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"Y\n" +
 	"\x14EnvironmentVariables\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1b\n" +
-	"\tcreate_at\x18\x03 \x01(\tR\bcreateAt\"\xad\x01\n" +
+	"\tcreate_at\x18\x03 \x01(\tR\bcreateAt\"\xbf\x01\n" +
+	"\x13EnvironmentVariable\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06app_id\x18\x02 \x01(\tR\x05appId\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\x12\x1b\n" +
+	"\tis_secret\x18\x05 \x01(\bR\bisSecret\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"t\n" +
+	"\x10EnvironmentGroup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"\xc8\x01\n" +
+	"\x18EnvironmentGroupVariable\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\x12\x1b\n" +
+	"\tis_secret\x18\x05 \x01(\bR\bisSecret\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"\xad\x01\n" +
 	"\rGitRepository\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
 	"\x06app_id\x18\x02 \x01(\tR\x05appId\x12\x1a\n" +
@@ -1375,7 +4688,7 @@ const file_src_protos_app_service_proto_rawDesc = "" +
 	"\n" +
 	"is_private\x18\x05 \x01(\bR\tisPrivate\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\tR\tcreatedAt\"\xc9\x02\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"\x89\x06\n" +
 	"\x10CreateAppRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x17\n" +
@@ -1385,7 +4698,20 @@ const file_src_protos_app_service_proto_rawDesc = "" +
 	"\x0egit_repository\x18\x05 \x01(\v2\x1a.app_service.GitRepositoryR\rgitRepository\x12\x1b\n" +
 	"\tbuild_cmd\x18\x06 \x01(\tR\bbuildCmd\x12\x1b\n" +
 	"\tstart_cmd\x18\a \x01(\tR\bstartCmd\x128\n" +
-	"\x15environment_variables\x18\b \x01(\tH\x00R\x14environmentVariables\x88\x01\x01B\x18\n" +
+	"\x15environment_variables\x18\b \x01(\tH\x00R\x14environmentVariables\x88\x01\x01\x12\x12\n" +
+	"\x04type\x18\t \x01(\tR\x04type\x12\x1a\n" +
+	"\bschedule\x18\n" +
+	" \x01(\tR\bschedule\x12\x1f\n" +
+	"\vpublish_dir\x18\v \x01(\tR\n" +
+	"publishDir\x12)\n" +
+	"\x10previews_enabled\x18\f \x01(\bR\x0fpreviewsEnabled\x12:\n" +
+	"\x1ascale_to_zero_idle_minutes\x18\r \x01(\x05R\x16scaleToZeroIdleMinutes\x12/\n" +
+	"\x13deployment_strategy\x18\x0e \x01(\tR\x12deploymentStrategy\x12*\n" +
+	"\x11rolling_max_surge\x18\x0f \x01(\tR\x0frollingMaxSurge\x126\n" +
+	"\x17rolling_max_unavailable\x18\x10 \x01(\tR\x15rollingMaxUnavailable\x12#\n" +
+	"\rcanary_weight\x18\x11 \x01(\x05R\fcanaryWeight\x12$\n" +
+	"\x0epre_deploy_cmd\x18\x12 \x01(\tR\fpreDeployCmd\x12&\n" +
+	"\x0fpost_deploy_cmd\x18\x13 \x01(\tR\rpostDeployCmdB\x18\n" +
 	"\x16_environment_variables\"7\n" +
 	"\x11CreateAppResponse\x12\"\n" +
 	"\x03app\x18\x01 \x01(\v2\x10.app_service.AppR\x03app\"E\n" +
@@ -1399,43 +4725,194 @@ const file_src_protos_app_service_proto_rawDesc = "" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\"7\n" +
 	"\x0fGetAppsResponse\x12$\n" +
-	"\x04apps\x18\x01 \x03(\v2\x10.app_service.AppR\x04apps\"\xca\x01\n" +
+	"\x04apps\x18\x01 \x03(\v2\x10.app_service.AppR\x04apps\"\xc6\x06\n" +
 	"\x10UpdateAppRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x15\n" +
 	"\x06app_id\x18\x02 \x01(\tR\x05appId\x12\x17\n" +
 	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12 \n" +
 	"\tbuild_cmd\x18\x04 \x01(\tH\x01R\bbuildCmd\x88\x01\x01\x12 \n" +
-	"\tstart_cmd\x18\x05 \x01(\tH\x02R\bstartCmd\x88\x01\x01B\a\n" +
+	"\tstart_cmd\x18\x05 \x01(\tH\x02R\bstartCmd\x88\x01\x01\x12\x1f\n" +
+	"\bschedule\x18\x06 \x01(\tH\x03R\bschedule\x88\x01\x01\x12.\n" +
+	"\x10previews_enabled\x18\a \x01(\bH\x04R\x0fpreviewsEnabled\x88\x01\x01\x12?\n" +
+	"\x1ascale_to_zero_idle_minutes\x18\b \x01(\x05H\x05R\x16scaleToZeroIdleMinutes\x88\x01\x01\x124\n" +
+	"\x13deployment_strategy\x18\t \x01(\tH\x06R\x12deploymentStrategy\x88\x01\x01\x12/\n" +
+	"\x11rolling_max_surge\x18\n" +
+	" \x01(\tH\aR\x0frollingMaxSurge\x88\x01\x01\x12;\n" +
+	"\x17rolling_max_unavailable\x18\v \x01(\tH\bR\x15rollingMaxUnavailable\x88\x01\x01\x12(\n" +
+	"\rcanary_weight\x18\f \x01(\x05H\tR\fcanaryWeight\x88\x01\x01\x12)\n" +
+	"\x0epre_deploy_cmd\x18\r \x01(\tH\n" +
+	"R\fpreDeployCmd\x88\x01\x01\x12+\n" +
+	"\x0fpost_deploy_cmd\x18\x0e \x01(\tH\vR\rpostDeployCmd\x88\x01\x01B\a\n" +
 	"\x05_nameB\f\n" +
 	"\n" +
 	"_build_cmdB\f\n" +
 	"\n" +
-	"_start_cmd\"7\n" +
+	"_start_cmdB\v\n" +
+	"\t_scheduleB\x13\n" +
+	"\x11_previews_enabledB\x1d\n" +
+	"\x1b_scale_to_zero_idle_minutesB\x16\n" +
+	"\x14_deployment_strategyB\x14\n" +
+	"\x12_rolling_max_surgeB\x1a\n" +
+	"\x18_rolling_max_unavailableB\x10\n" +
+	"\x0e_canary_weightB\x11\n" +
+	"\x0f_pre_deploy_cmdB\x12\n" +
+	"\x10_post_deploy_cmd\"7\n" +
 	"\x11UpdateAppResponse\x12\"\n" +
 	"\x03app\x18\x01 \x01(\v2\x10.app_service.AppR\x03app\"H\n" +
 	"\x10DeleteAppRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x15\n" +
 	"\x06app_id\x18\x02 \x01(\tR\x05appId\"\x13\n" +
-	"\x11DeleteAppResponse\"7\n" +
+	"\x11DeleteAppResponse\"I\n" +
+	"\x11SuspendAppRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x15\n" +
+	"\x06app_id\x18\x02 \x01(\tR\x05appId\"8\n" +
+	"\x12SuspendAppResponse\x12\"\n" +
+	"\x03app\x18\x01 \x01(\v2\x10.app_service.AppR\x03app\"H\n" +
+	"\x10ResumeAppRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x15\n" +
+	"\x06app_id\x18\x02 \x01(\tR\x05appId\"7\n" +
+	"\x11ResumeAppResponse\x12\"\n" +
+	"\x03app\x18\x01 \x01(\v2\x10.app_service.AppR\x03app\"6\n" +
+	"\x1dGetAppDeploymentConfigRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\"Z\n" +
+	"\x1eGetAppDeploymentConfigResponse\x128\n" +
+	"\x06config\x18\x01 \x01(\v2 .app_service.AppDeploymentConfigR\x06config\"I\n" +
+	"\x11GetAppDiskRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x15\n" +
+	"\x06app_id\x18\x02 \x01(\tR\x05appId\";\n" +
+	"\x12GetAppDiskResponse\x12%\n" +
+	"\x04disk\x18\x01 \x01(\v2\x11.app_service.DiskR\x04disk\"\x81\x01\n" +
+	"\x11SetAppDiskRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x15\n" +
+	"\x06app_id\x18\x02 \x01(\tR\x05appId\x12\x1d\n" +
+	"\n" +
+	"mount_path\x18\x03 \x01(\tR\tmountPath\x12\x17\n" +
+	"\asize_gb\x18\x04 \x01(\x05R\x06sizeGb\";\n" +
+	"\x12SetAppDiskResponse\x12%\n" +
+	"\x04disk\x18\x01 \x01(\v2\x11.app_service.DiskR\x04disk\"L\n" +
+	"\x14DeleteAppDiskRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x15\n" +
+	"\x06app_id\x18\x02 \x01(\tR\x05appId\"\x17\n" +
+	"\x15DeleteAppDiskResponse\"7\n" +
 	"\x1eGetEnvironmentVariablesRequest\x12\x15\n" +
-	"\x06app_id\x18\x01 \x01(\tR\x05appId\"w\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\"\xce\x01\n" +
 	"\x1fGetEnvironmentVariablesResponse\x12T\n" +
-	"\x14environment_variable\x18\x01 \x01(\v2!.app_service.EnvironmentVariablesR\x13environmentVariable\"P\n" +
+	"\x14environment_variable\x18\x01 \x01(\v2!.app_service.EnvironmentVariablesR\x13environmentVariable\x12U\n" +
+	"\x15environment_variables\x18\x02 \x03(\v2 .app_service.EnvironmentVariableR\x14environmentVariables\"s\n" +
 	"!CreateEnvironmentVariablesRequest\x12\x15\n" +
 	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value\"z\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12!\n" +
+	"\fskip_restart\x18\x03 \x01(\bR\vskipRestart\"z\n" +
 	"\"CreateEnvironmentVariablesResponse\x12T\n" +
-	"\x14environment_variable\x18\x01 \x01(\v2!.app_service.EnvironmentVariablesR\x13environmentVariable\"P\n" +
+	"\x14environment_variable\x18\x01 \x01(\v2!.app_service.EnvironmentVariablesR\x13environmentVariable\"s\n" +
 	"!UpdateEnvironmentVariablesRequest\x12\x15\n" +
 	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value\"z\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12!\n" +
+	"\fskip_restart\x18\x03 \x01(\bR\vskipRestart\"z\n" +
 	"\"UpdateEnvironmentVariablesResponse\x12T\n" +
-	"\x14environment_variable\x18\x01 \x01(\v2!.app_service.EnvironmentVariablesR\x13environmentVariable\":\n" +
+	"\x14environment_variable\x18\x01 \x01(\v2!.app_service.EnvironmentVariablesR\x13environmentVariable\"]\n" +
 	"!DeleteEnvironmentVariablesRequest\x12\x15\n" +
-	"\x06app_id\x18\x01 \x01(\tR\x05appId\"$\n" +
-	"\"DeleteEnvironmentVariablesResponse\";\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12!\n" +
+	"\fskip_restart\x18\x02 \x01(\bR\vskipRestart\"$\n" +
+	"\"DeleteEnvironmentVariablesResponse\"\xb1\x01\n" +
+	"\x1dSetEnvironmentVariableRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12 \n" +
+	"\tis_secret\x18\x04 \x01(\bH\x00R\bisSecret\x88\x01\x01\x12!\n" +
+	"\fskip_restart\x18\x05 \x01(\bR\vskipRestartB\f\n" +
+	"\n" +
+	"_is_secret\"u\n" +
+	"\x1eSetEnvironmentVariableResponse\x12S\n" +
+	"\x14environment_variable\x18\x01 \x01(\v2 .app_service.EnvironmentVariableR\x13environmentVariable\"n\n" +
+	" DeleteEnvironmentVariableRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12!\n" +
+	"\fskip_restart\x18\x03 \x01(\bR\vskipRestart\"#\n" +
+	"!DeleteEnvironmentVariableResponse\";\n" +
+	"\"ResolveEnvironmentVariablesRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\"\xef\x01\n" +
+	"#ResolveEnvironmentVariablesResponse\x12\x7f\n" +
+	"\x15environment_variables\x18\x01 \x03(\v2J.app_service.ResolveEnvironmentVariablesResponse.EnvironmentVariablesEntryR\x14environmentVariables\x1aG\n" +
+	"\x19EnvironmentVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x91\x01\n" +
+	"!ImportEnvironmentVariablesRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x18\n" +
+	"\areplace\x18\x03 \x01(\bR\areplace\x12!\n" +
+	"\fskip_restart\x18\x04 \x01(\bR\vskipRestart\"{\n" +
+	"\"ImportEnvironmentVariablesResponse\x12U\n" +
+	"\x15environment_variables\x18\x01 \x03(\v2 .app_service.EnvironmentVariableR\x14environmentVariables\":\n" +
+	"!ExportEnvironmentVariablesRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\">\n" +
+	"\"ExportEnvironmentVariablesResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\"R\n" +
+	"\x1dCreateEnvironmentGroupRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"l\n" +
+	"\x1eCreateEnvironmentGroupResponse\x12J\n" +
+	"\x11environment_group\x18\x01 \x01(\v2\x1d.app_service.EnvironmentGroupR\x10environmentGroup\"V\n" +
+	"\x1aGetEnvironmentGroupRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\"\xc7\x01\n" +
+	"\x1bGetEnvironmentGroupResponse\x12J\n" +
+	"\x11environment_group\x18\x01 \x01(\v2\x1d.app_service.EnvironmentGroupR\x10environmentGroup\x12C\n" +
+	"\tvariables\x18\x02 \x03(\v2%.app_service.EnvironmentGroupVariableR\tvariables\x12\x17\n" +
+	"\aapp_ids\x18\x03 \x03(\tR\x06appIds\"<\n" +
+	"\x1bGetEnvironmentGroupsRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"l\n" +
+	"\x1cGetEnvironmentGroupsResponse\x12L\n" +
+	"\x12environment_groups\x18\x01 \x03(\v2\x1d.app_service.EnvironmentGroupR\x11environmentGroups\"|\n" +
+	"\x1dDeleteEnvironmentGroupRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12!\n" +
+	"\fskip_restart\x18\x03 \x01(\bR\vskipRestart\" \n" +
+	"\x1eDeleteEnvironmentGroupResponse\"\xd9\x01\n" +
+	"\"SetEnvironmentGroupVariableRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\x12 \n" +
+	"\tis_secret\x18\x05 \x01(\bH\x00R\bisSecret\x88\x01\x01\x12!\n" +
+	"\fskip_restart\x18\x06 \x01(\bR\vskipRestartB\f\n" +
+	"\n" +
+	"_is_secret\"h\n" +
+	"#SetEnvironmentGroupVariableResponse\x12A\n" +
+	"\bvariable\x18\x01 \x01(\v2%.app_service.EnvironmentGroupVariableR\bvariable\"\x96\x01\n" +
+	"%DeleteEnvironmentGroupVariableRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12!\n" +
+	"\fskip_restart\x18\x04 \x01(\bR\vskipRestart\"(\n" +
+	"&DeleteEnvironmentGroupVariableResponse\"\x91\x01\n" +
+	"\x1bLinkEnvironmentGroupRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x15\n" +
+	"\x06app_id\x18\x03 \x01(\tR\x05appId\x12!\n" +
+	"\fskip_restart\x18\x04 \x01(\bR\vskipRestart\"\x1e\n" +
+	"\x1cLinkEnvironmentGroupResponse\"\x93\x01\n" +
+	"\x1dUnlinkEnvironmentGroupRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x15\n" +
+	"\x06app_id\x18\x03 \x01(\tR\x05appId\x12!\n" +
+	"\fskip_restart\x18\x04 \x01(\bR\vskipRestart\" \n" +
+	"\x1eUnlinkEnvironmentGroupResponse\";\n" +
 	"\x18BatchGetAppsCountRequest\x12\x1f\n" +
 	"\vproject_ids\x18\x01 \x03(\tR\n" +
 	"projectIds\"\xcc\x01\n" +
@@ -1443,11 +4920,35 @@ const file_src_protos_app_service_proto_rawDesc = "" +
 	"\x12project_apps_count\x18\x01 \x03(\v2<.app_service.BatchGetAppsCountResponse.ProjectAppsCountEntryR\x10projectAppsCount\x1aC\n" +
 	"\x15ProjectAppsCountEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\x0f\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"3\n" +
+	"\x18GetExistingAppIdsRequest\x12\x17\n" +
+	"\aapp_ids\x18\x01 \x03(\tR\x06appIds\"4\n" +
+	"\x19GetExistingAppIdsResponse\x12\x17\n" +
+	"\aapp_ids\x18\x01 \x03(\tR\x06appIds\"M\n" +
+	"\x15GetAppPreviewsRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x15\n" +
+	"\x06app_id\x18\x02 \x01(\tR\x05appId\">\n" +
+	"\x16GetAppPreviewsResponse\x12$\n" +
+	"\x04apps\x18\x01 \x03(\v2\x10.app_service.AppR\x04apps\":\n" +
+	"\x1bGetPreviewSourceAppsRequest\x12\x1b\n" +
+	"\tclone_url\x18\x01 \x01(\tR\bcloneUrl\"D\n" +
+	"\x1cGetPreviewSourceAppsResponse\x12$\n" +
+	"\x04apps\x18\x01 \x03(\v2\x10.app_service.AppR\x04apps\"\x8e\x01\n" +
+	"\x1fDeployPullRequestPreviewRequest\x12\"\n" +
+	"\rparent_app_id\x18\x01 \x01(\tR\vparentAppId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12.\n" +
+	"\x13pull_request_number\x18\x03 \x01(\x05R\x11pullRequestNumber\"F\n" +
+	" DeployPullRequestPreviewResponse\x12\"\n" +
+	"\x03app\x18\x01 \x01(\v2\x10.app_service.AppR\x03app\"u\n" +
+	"\x1fDeletePullRequestPreviewRequest\x12\"\n" +
+	"\rparent_app_id\x18\x01 \x01(\tR\vparentAppId\x12.\n" +
+	"\x13pull_request_number\x18\x02 \x01(\x05R\x11pullRequestNumber\"\"\n" +
+	" DeletePullRequestPreviewResponse\"\x0f\n" +
 	"\rHealthRequest\"B\n" +
 	"\x0eHealthResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\x93\b\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\xd1\x1c\n" +
 	"\n" +
 	"AppService\x12A\n" +
 	"\x06Health\x12\x1a.app_service.HealthRequest\x1a\x1b.app_service.HealthResponse\x12J\n" +
@@ -1455,12 +4956,39 @@ const file_src_protos_app_service_proto_rawDesc = "" +
 	"\x06GetApp\x12\x1a.app_service.GetAppRequest\x1a\x1b.app_service.GetAppResponse\x12D\n" +
 	"\aGetApps\x12\x1b.app_service.GetAppsRequest\x1a\x1c.app_service.GetAppsResponse\x12J\n" +
 	"\tUpdateApp\x12\x1d.app_service.UpdateAppRequest\x1a\x1e.app_service.UpdateAppResponse\x12J\n" +
-	"\tDeleteApp\x12\x1d.app_service.DeleteAppRequest\x1a\x1e.app_service.DeleteAppResponse\x12t\n" +
+	"\tDeleteApp\x12\x1d.app_service.DeleteAppRequest\x1a\x1e.app_service.DeleteAppResponse\x12M\n" +
+	"\n" +
+	"SuspendApp\x12\x1e.app_service.SuspendAppRequest\x1a\x1f.app_service.SuspendAppResponse\x12J\n" +
+	"\tResumeApp\x12\x1d.app_service.ResumeAppRequest\x1a\x1e.app_service.ResumeAppResponse\x12q\n" +
+	"\x16GetAppDeploymentConfig\x12*.app_service.GetAppDeploymentConfigRequest\x1a+.app_service.GetAppDeploymentConfigResponse\x12M\n" +
+	"\n" +
+	"GetAppDisk\x12\x1e.app_service.GetAppDiskRequest\x1a\x1f.app_service.GetAppDiskResponse\x12M\n" +
+	"\n" +
+	"SetAppDisk\x12\x1e.app_service.SetAppDiskRequest\x1a\x1f.app_service.SetAppDiskResponse\x12V\n" +
+	"\rDeleteAppDisk\x12!.app_service.DeleteAppDiskRequest\x1a\".app_service.DeleteAppDiskResponse\x12t\n" +
 	"\x17GetEnvironmentVariables\x12+.app_service.GetEnvironmentVariablesRequest\x1a,.app_service.GetEnvironmentVariablesResponse\x12}\n" +
 	"\x1aCreateEnvironmentVariables\x12..app_service.CreateEnvironmentVariablesRequest\x1a/.app_service.CreateEnvironmentVariablesResponse\x12}\n" +
 	"\x1aUpdateEnvironmentVariables\x12..app_service.UpdateEnvironmentVariablesRequest\x1a/.app_service.UpdateEnvironmentVariablesResponse\x12}\n" +
-	"\x1aDeleteEnvironmentVariables\x12..app_service.DeleteEnvironmentVariablesRequest\x1a/.app_service.DeleteEnvironmentVariablesResponse\x12b\n" +
-	"\x11BatchGetAppsCount\x12%.app_service.BatchGetAppsCountRequest\x1a&.app_service.BatchGetAppsCountResponseB%Z#proto/app_service_pb;app_service_pbb\x06proto3"
+	"\x1aDeleteEnvironmentVariables\x12..app_service.DeleteEnvironmentVariablesRequest\x1a/.app_service.DeleteEnvironmentVariablesResponse\x12q\n" +
+	"\x16SetEnvironmentVariable\x12*.app_service.SetEnvironmentVariableRequest\x1a+.app_service.SetEnvironmentVariableResponse\x12z\n" +
+	"\x19DeleteEnvironmentVariable\x12-.app_service.DeleteEnvironmentVariableRequest\x1a..app_service.DeleteEnvironmentVariableResponse\x12\x80\x01\n" +
+	"\x1bResolveEnvironmentVariables\x12/.app_service.ResolveEnvironmentVariablesRequest\x1a0.app_service.ResolveEnvironmentVariablesResponse\x12}\n" +
+	"\x1aImportEnvironmentVariables\x12..app_service.ImportEnvironmentVariablesRequest\x1a/.app_service.ImportEnvironmentVariablesResponse\x12}\n" +
+	"\x1aExportEnvironmentVariables\x12..app_service.ExportEnvironmentVariablesRequest\x1a/.app_service.ExportEnvironmentVariablesResponse\x12q\n" +
+	"\x16CreateEnvironmentGroup\x12*.app_service.CreateEnvironmentGroupRequest\x1a+.app_service.CreateEnvironmentGroupResponse\x12h\n" +
+	"\x13GetEnvironmentGroup\x12'.app_service.GetEnvironmentGroupRequest\x1a(.app_service.GetEnvironmentGroupResponse\x12k\n" +
+	"\x14GetEnvironmentGroups\x12(.app_service.GetEnvironmentGroupsRequest\x1a).app_service.GetEnvironmentGroupsResponse\x12q\n" +
+	"\x16DeleteEnvironmentGroup\x12*.app_service.DeleteEnvironmentGroupRequest\x1a+.app_service.DeleteEnvironmentGroupResponse\x12\x80\x01\n" +
+	"\x1bSetEnvironmentGroupVariable\x12/.app_service.SetEnvironmentGroupVariableRequest\x1a0.app_service.SetEnvironmentGroupVariableResponse\x12\x89\x01\n" +
+	"\x1eDeleteEnvironmentGroupVariable\x122.app_service.DeleteEnvironmentGroupVariableRequest\x1a3.app_service.DeleteEnvironmentGroupVariableResponse\x12k\n" +
+	"\x14LinkEnvironmentGroup\x12(.app_service.LinkEnvironmentGroupRequest\x1a).app_service.LinkEnvironmentGroupResponse\x12q\n" +
+	"\x16UnlinkEnvironmentGroup\x12*.app_service.UnlinkEnvironmentGroupRequest\x1a+.app_service.UnlinkEnvironmentGroupResponse\x12b\n" +
+	"\x11BatchGetAppsCount\x12%.app_service.BatchGetAppsCountRequest\x1a&.app_service.BatchGetAppsCountResponse\x12b\n" +
+	"\x11GetExistingAppIds\x12%.app_service.GetExistingAppIdsRequest\x1a&.app_service.GetExistingAppIdsResponse\x12Y\n" +
+	"\x0eGetAppPreviews\x12\".app_service.GetAppPreviewsRequest\x1a#.app_service.GetAppPreviewsResponse\x12k\n" +
+	"\x14GetPreviewSourceApps\x12(.app_service.GetPreviewSourceAppsRequest\x1a).app_service.GetPreviewSourceAppsResponse\x12w\n" +
+	"\x18DeployPullRequestPreview\x12,.app_service.DeployPullRequestPreviewRequest\x1a-.app_service.DeployPullRequestPreviewResponse\x12w\n" +
+	"\x18DeletePullRequestPreview\x12,.app_service.DeletePullRequestPreviewRequest\x1a-.app_service.DeletePullRequestPreviewResponseB%Z#proto/app_service_pb;app_service_pbb\x06proto3"
 
 var (
 	file_src_protos_app_service_proto_rawDescOnce sync.Once
@@ -1474,72 +5002,192 @@ func file_src_protos_app_service_proto_rawDescGZIP() []byte {
 	return file_src_protos_app_service_proto_rawDescData
 }
 
-var file_src_protos_app_service_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_src_protos_app_service_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
 var file_src_protos_app_service_proto_goTypes = []any{
-	(*App)(nil),                                // 0: app_service.App
-	(*EnvironmentVariables)(nil),               // 1: app_service.EnvironmentVariables
-	(*GitRepository)(nil),                      // 2: app_service.GitRepository
-	(*CreateAppRequest)(nil),                   // 3: app_service.CreateAppRequest
-	(*CreateAppResponse)(nil),                  // 4: app_service.CreateAppResponse
-	(*GetAppRequest)(nil),                      // 5: app_service.GetAppRequest
-	(*GetAppResponse)(nil),                     // 6: app_service.GetAppResponse
-	(*GetAppsRequest)(nil),                     // 7: app_service.GetAppsRequest
-	(*GetAppsResponse)(nil),                    // 8: app_service.GetAppsResponse
-	(*UpdateAppRequest)(nil),                   // 9: app_service.UpdateAppRequest
-	(*UpdateAppResponse)(nil),                  // 10: app_service.UpdateAppResponse
-	(*DeleteAppRequest)(nil),                   // 11: app_service.DeleteAppRequest
-	(*DeleteAppResponse)(nil),                  // 12: app_service.DeleteAppResponse
-	(*GetEnvironmentVariablesRequest)(nil),     // 13: app_service.GetEnvironmentVariablesRequest
-	(*GetEnvironmentVariablesResponse)(nil),    // 14: app_service.GetEnvironmentVariablesResponse
-	(*CreateEnvironmentVariablesRequest)(nil),  // 15: app_service.CreateEnvironmentVariablesRequest
-	(*CreateEnvironmentVariablesResponse)(nil), // 16: app_service.CreateEnvironmentVariablesResponse
-	(*UpdateEnvironmentVariablesRequest)(nil),  // 17: app_service.UpdateEnvironmentVariablesRequest
-	(*UpdateEnvironmentVariablesResponse)(nil), // 18: app_service.UpdateEnvironmentVariablesResponse
-	(*DeleteEnvironmentVariablesRequest)(nil),  // 19: app_service.DeleteEnvironmentVariablesRequest
-	(*DeleteEnvironmentVariablesResponse)(nil), // 20: app_service.DeleteEnvironmentVariablesResponse
-	(*BatchGetAppsCountRequest)(nil),           // 21: app_service.BatchGetAppsCountRequest
-	(*BatchGetAppsCountResponse)(nil),          // 22: app_service.BatchGetAppsCountResponse
-	(*HealthRequest)(nil),                      // 23: app_service.HealthRequest
-	(*HealthResponse)(nil),                     // 24: app_service.HealthResponse
-	nil,                                        // 25: app_service.BatchGetAppsCountResponse.ProjectAppsCountEntry
+	(*App)(nil),                                    // 0: app_service.App
+	(*AppDeploymentConfig)(nil),                    // 1: app_service.AppDeploymentConfig
+	(*Disk)(nil),                                   // 2: app_service.Disk
+	(*EnvironmentVariables)(nil),                   // 3: app_service.EnvironmentVariables
+	(*EnvironmentVariable)(nil),                    // 4: app_service.EnvironmentVariable
+	(*EnvironmentGroup)(nil),                       // 5: app_service.EnvironmentGroup
+	(*EnvironmentGroupVariable)(nil),               // 6: app_service.EnvironmentGroupVariable
+	(*GitRepository)(nil),                          // 7: app_service.GitRepository
+	(*CreateAppRequest)(nil),                       // 8: app_service.CreateAppRequest
+	(*CreateAppResponse)(nil),                      // 9: app_service.CreateAppResponse
+	(*GetAppRequest)(nil),                          // 10: app_service.GetAppRequest
+	(*GetAppResponse)(nil),                         // 11: app_service.GetAppResponse
+	(*GetAppsRequest)(nil),                         // 12: app_service.GetAppsRequest
+	(*GetAppsResponse)(nil),                        // 13: app_service.GetAppsResponse
+	(*UpdateAppRequest)(nil),                       // 14: app_service.UpdateAppRequest
+	(*UpdateAppResponse)(nil),                      // 15: app_service.UpdateAppResponse
+	(*DeleteAppRequest)(nil),                       // 16: app_service.DeleteAppRequest
+	(*DeleteAppResponse)(nil),                      // 17: app_service.DeleteAppResponse
+	(*SuspendAppRequest)(nil),                      // 18: app_service.SuspendAppRequest
+	(*SuspendAppResponse)(nil),                     // 19: app_service.SuspendAppResponse
+	(*ResumeAppRequest)(nil),                       // 20: app_service.ResumeAppRequest
+	(*ResumeAppResponse)(nil),                      // 21: app_service.ResumeAppResponse
+	(*GetAppDeploymentConfigRequest)(nil),          // 22: app_service.GetAppDeploymentConfigRequest
+	(*GetAppDeploymentConfigResponse)(nil),         // 23: app_service.GetAppDeploymentConfigResponse
+	(*GetAppDiskRequest)(nil),                      // 24: app_service.GetAppDiskRequest
+	(*GetAppDiskResponse)(nil),                     // 25: app_service.GetAppDiskResponse
+	(*SetAppDiskRequest)(nil),                      // 26: app_service.SetAppDiskRequest
+	(*SetAppDiskResponse)(nil),                     // 27: app_service.SetAppDiskResponse
+	(*DeleteAppDiskRequest)(nil),                   // 28: app_service.DeleteAppDiskRequest
+	(*DeleteAppDiskResponse)(nil),                  // 29: app_service.DeleteAppDiskResponse
+	(*GetEnvironmentVariablesRequest)(nil),         // 30: app_service.GetEnvironmentVariablesRequest
+	(*GetEnvironmentVariablesResponse)(nil),        // 31: app_service.GetEnvironmentVariablesResponse
+	(*CreateEnvironmentVariablesRequest)(nil),      // 32: app_service.CreateEnvironmentVariablesRequest
+	(*CreateEnvironmentVariablesResponse)(nil),     // 33: app_service.CreateEnvironmentVariablesResponse
+	(*UpdateEnvironmentVariablesRequest)(nil),      // 34: app_service.UpdateEnvironmentVariablesRequest
+	(*UpdateEnvironmentVariablesResponse)(nil),     // 35: app_service.UpdateEnvironmentVariablesResponse
+	(*DeleteEnvironmentVariablesRequest)(nil),      // 36: app_service.DeleteEnvironmentVariablesRequest
+	(*DeleteEnvironmentVariablesResponse)(nil),     // 37: app_service.DeleteEnvironmentVariablesResponse
+	(*SetEnvironmentVariableRequest)(nil),          // 38: app_service.SetEnvironmentVariableRequest
+	(*SetEnvironmentVariableResponse)(nil),         // 39: app_service.SetEnvironmentVariableResponse
+	(*DeleteEnvironmentVariableRequest)(nil),       // 40: app_service.DeleteEnvironmentVariableRequest
+	(*DeleteEnvironmentVariableResponse)(nil),      // 41: app_service.DeleteEnvironmentVariableResponse
+	(*ResolveEnvironmentVariablesRequest)(nil),     // 42: app_service.ResolveEnvironmentVariablesRequest
+	(*ResolveEnvironmentVariablesResponse)(nil),    // 43: app_service.ResolveEnvironmentVariablesResponse
+	(*ImportEnvironmentVariablesRequest)(nil),      // 44: app_service.ImportEnvironmentVariablesRequest
+	(*ImportEnvironmentVariablesResponse)(nil),     // 45: app_service.ImportEnvironmentVariablesResponse
+	(*ExportEnvironmentVariablesRequest)(nil),      // 46: app_service.ExportEnvironmentVariablesRequest
+	(*ExportEnvironmentVariablesResponse)(nil),     // 47: app_service.ExportEnvironmentVariablesResponse
+	(*CreateEnvironmentGroupRequest)(nil),          // 48: app_service.CreateEnvironmentGroupRequest
+	(*CreateEnvironmentGroupResponse)(nil),         // 49: app_service.CreateEnvironmentGroupResponse
+	(*GetEnvironmentGroupRequest)(nil),             // 50: app_service.GetEnvironmentGroupRequest
+	(*GetEnvironmentGroupResponse)(nil),            // 51: app_service.GetEnvironmentGroupResponse
+	(*GetEnvironmentGroupsRequest)(nil),            // 52: app_service.GetEnvironmentGroupsRequest
+	(*GetEnvironmentGroupsResponse)(nil),           // 53: app_service.GetEnvironmentGroupsResponse
+	(*DeleteEnvironmentGroupRequest)(nil),          // 54: app_service.DeleteEnvironmentGroupRequest
+	(*DeleteEnvironmentGroupResponse)(nil),         // 55: app_service.DeleteEnvironmentGroupResponse
+	(*SetEnvironmentGroupVariableRequest)(nil),     // 56: app_service.SetEnvironmentGroupVariableRequest
+	(*SetEnvironmentGroupVariableResponse)(nil),    // 57: app_service.SetEnvironmentGroupVariableResponse
+	(*DeleteEnvironmentGroupVariableRequest)(nil),  // 58: app_service.DeleteEnvironmentGroupVariableRequest
+	(*DeleteEnvironmentGroupVariableResponse)(nil), // 59: app_service.DeleteEnvironmentGroupVariableResponse
+	(*LinkEnvironmentGroupRequest)(nil),            // 60: app_service.LinkEnvironmentGroupRequest
+	(*LinkEnvironmentGroupResponse)(nil),           // 61: app_service.LinkEnvironmentGroupResponse
+	(*UnlinkEnvironmentGroupRequest)(nil),          // 62: app_service.UnlinkEnvironmentGroupRequest
+	(*UnlinkEnvironmentGroupResponse)(nil),         // 63: app_service.UnlinkEnvironmentGroupResponse
+	(*BatchGetAppsCountRequest)(nil),               // 64: app_service.BatchGetAppsCountRequest
+	(*BatchGetAppsCountResponse)(nil),              // 65: app_service.BatchGetAppsCountResponse
+	(*GetExistingAppIdsRequest)(nil),               // 66: app_service.GetExistingAppIdsRequest
+	(*GetExistingAppIdsResponse)(nil),              // 67: app_service.GetExistingAppIdsResponse
+	(*GetAppPreviewsRequest)(nil),                  // 68: app_service.GetAppPreviewsRequest
+	(*GetAppPreviewsResponse)(nil),                 // 69: app_service.GetAppPreviewsResponse
+	(*GetPreviewSourceAppsRequest)(nil),            // 70: app_service.GetPreviewSourceAppsRequest
+	(*GetPreviewSourceAppsResponse)(nil),           // 71: app_service.GetPreviewSourceAppsResponse
+	(*DeployPullRequestPreviewRequest)(nil),        // 72: app_service.DeployPullRequestPreviewRequest
+	(*DeployPullRequestPreviewResponse)(nil),       // 73: app_service.DeployPullRequestPreviewResponse
+	(*DeletePullRequestPreviewRequest)(nil),        // 74: app_service.DeletePullRequestPreviewRequest
+	(*DeletePullRequestPreviewResponse)(nil),       // 75: app_service.DeletePullRequestPreviewResponse
+	(*HealthRequest)(nil),                          // 76: app_service.HealthRequest
+	(*HealthResponse)(nil),                         // 77: app_service.HealthResponse
+	nil,                                            // 78: app_service.ResolveEnvironmentVariablesResponse.EnvironmentVariablesEntry
+	nil,                                            // 79: app_service.BatchGetAppsCountResponse.ProjectAppsCountEntry
 }
 var file_src_protos_app_service_proto_depIdxs = []int32{
-	2,  // 0: app_service.CreateAppRequest.git_repository:type_name -> app_service.GitRepository
-	0,  // 1: app_service.CreateAppResponse.app:type_name -> app_service.App
-	0,  // 2: app_service.GetAppResponse.app:type_name -> app_service.App
-	0,  // 3: app_service.GetAppsResponse.apps:type_name -> app_service.App
-	0,  // 4: app_service.UpdateAppResponse.app:type_name -> app_service.App
-	1,  // 5: app_service.GetEnvironmentVariablesResponse.environment_variable:type_name -> app_service.EnvironmentVariables
-	1,  // 6: app_service.CreateEnvironmentVariablesResponse.environment_variable:type_name -> app_service.EnvironmentVariables
-	1,  // 7: app_service.UpdateEnvironmentVariablesResponse.environment_variable:type_name -> app_service.EnvironmentVariables
-	25, // 8: app_service.BatchGetAppsCountResponse.project_apps_count:type_name -> app_service.BatchGetAppsCountResponse.ProjectAppsCountEntry
-	23, // 9: app_service.AppService.Health:input_type -> app_service.HealthRequest
-	3,  // 10: app_service.AppService.CreateApp:input_type -> app_service.CreateAppRequest
-	5,  // 11: app_service.AppService.GetApp:input_type -> app_service.GetAppRequest
-	7,  // 12: app_service.AppService.GetApps:input_type -> app_service.GetAppsRequest
-	9,  // 13: app_service.AppService.UpdateApp:input_type -> app_service.UpdateAppRequest
-	11, // 14: app_service.AppService.DeleteApp:input_type -> app_service.DeleteAppRequest
-	13, // 15: app_service.AppService.GetEnvironmentVariables:input_type -> app_service.GetEnvironmentVariablesRequest
-	15, // 16: app_service.AppService.CreateEnvironmentVariables:input_type -> app_service.CreateEnvironmentVariablesRequest
-	17, // 17: app_service.AppService.UpdateEnvironmentVariables:input_type -> app_service.UpdateEnvironmentVariablesRequest
-	19, // 18: app_service.AppService.DeleteEnvironmentVariables:input_type -> app_service.DeleteEnvironmentVariablesRequest
-	21, // 19: app_service.AppService.BatchGetAppsCount:input_type -> app_service.BatchGetAppsCountRequest
-	24, // 20: app_service.AppService.Health:output_type -> app_service.HealthResponse
-	4,  // 21: app_service.AppService.CreateApp:output_type -> app_service.CreateAppResponse
-	6,  // 22: app_service.AppService.GetApp:output_type -> app_service.GetAppResponse
-	8,  // 23: app_service.AppService.GetApps:output_type -> app_service.GetAppsResponse
-	10, // 24: app_service.AppService.UpdateApp:output_type -> app_service.UpdateAppResponse
-	12, // 25: app_service.AppService.DeleteApp:output_type -> app_service.DeleteAppResponse
-	14, // 26: app_service.AppService.GetEnvironmentVariables:output_type -> app_service.GetEnvironmentVariablesResponse
-	16, // 27: app_service.AppService.CreateEnvironmentVariables:output_type -> app_service.CreateEnvironmentVariablesResponse
-	18, // 28: app_service.AppService.UpdateEnvironmentVariables:output_type -> app_service.UpdateEnvironmentVariablesResponse
-	20, // 29: app_service.AppService.DeleteEnvironmentVariables:output_type -> app_service.DeleteEnvironmentVariablesResponse
-	22, // 30: app_service.AppService.BatchGetAppsCount:output_type -> app_service.BatchGetAppsCountResponse
-	20, // [20:31] is the sub-list for method output_type
-	9,  // [9:20] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	2,  // 0: app_service.AppDeploymentConfig.disk:type_name -> app_service.Disk
+	7,  // 1: app_service.CreateAppRequest.git_repository:type_name -> app_service.GitRepository
+	0,  // 2: app_service.CreateAppResponse.app:type_name -> app_service.App
+	0,  // 3: app_service.GetAppResponse.app:type_name -> app_service.App
+	0,  // 4: app_service.GetAppsResponse.apps:type_name -> app_service.App
+	0,  // 5: app_service.UpdateAppResponse.app:type_name -> app_service.App
+	0,  // 6: app_service.SuspendAppResponse.app:type_name -> app_service.App
+	0,  // 7: app_service.ResumeAppResponse.app:type_name -> app_service.App
+	1,  // 8: app_service.GetAppDeploymentConfigResponse.config:type_name -> app_service.AppDeploymentConfig
+	2,  // 9: app_service.GetAppDiskResponse.disk:type_name -> app_service.Disk
+	2,  // 10: app_service.SetAppDiskResponse.disk:type_name -> app_service.Disk
+	3,  // 11: app_service.GetEnvironmentVariablesResponse.environment_variable:type_name -> app_service.EnvironmentVariables
+	4,  // 12: app_service.GetEnvironmentVariablesResponse.environment_variables:type_name -> app_service.EnvironmentVariable
+	3,  // 13: app_service.CreateEnvironmentVariablesResponse.environment_variable:type_name -> app_service.EnvironmentVariables
+	3,  // 14: app_service.UpdateEnvironmentVariablesResponse.environment_variable:type_name -> app_service.EnvironmentVariables
+	4,  // 15: app_service.SetEnvironmentVariableResponse.environment_variable:type_name -> app_service.EnvironmentVariable
+	78, // 16: app_service.ResolveEnvironmentVariablesResponse.environment_variables:type_name -> app_service.ResolveEnvironmentVariablesResponse.EnvironmentVariablesEntry
+	4,  // 17: app_service.ImportEnvironmentVariablesResponse.environment_variables:type_name -> app_service.EnvironmentVariable
+	5,  // 18: app_service.CreateEnvironmentGroupResponse.environment_group:type_name -> app_service.EnvironmentGroup
+	5,  // 19: app_service.GetEnvironmentGroupResponse.environment_group:type_name -> app_service.EnvironmentGroup
+	6,  // 20: app_service.GetEnvironmentGroupResponse.variables:type_name -> app_service.EnvironmentGroupVariable
+	5,  // 21: app_service.GetEnvironmentGroupsResponse.environment_groups:type_name -> app_service.EnvironmentGroup
+	6,  // 22: app_service.SetEnvironmentGroupVariableResponse.variable:type_name -> app_service.EnvironmentGroupVariable
+	79, // 23: app_service.BatchGetAppsCountResponse.project_apps_count:type_name -> app_service.BatchGetAppsCountResponse.ProjectAppsCountEntry
+	0,  // 24: app_service.GetAppPreviewsResponse.apps:type_name -> app_service.App
+	0,  // 25: app_service.GetPreviewSourceAppsResponse.apps:type_name -> app_service.App
+	0,  // 26: app_service.DeployPullRequestPreviewResponse.app:type_name -> app_service.App
+	76, // 27: app_service.AppService.Health:input_type -> app_service.HealthRequest
+	8,  // 28: app_service.AppService.CreateApp:input_type -> app_service.CreateAppRequest
+	10, // 29: app_service.AppService.GetApp:input_type -> app_service.GetAppRequest
+	12, // 30: app_service.AppService.GetApps:input_type -> app_service.GetAppsRequest
+	14, // 31: app_service.AppService.UpdateApp:input_type -> app_service.UpdateAppRequest
+	16, // 32: app_service.AppService.DeleteApp:input_type -> app_service.DeleteAppRequest
+	18, // 33: app_service.AppService.SuspendApp:input_type -> app_service.SuspendAppRequest
+	20, // 34: app_service.AppService.ResumeApp:input_type -> app_service.ResumeAppRequest
+	22, // 35: app_service.AppService.GetAppDeploymentConfig:input_type -> app_service.GetAppDeploymentConfigRequest
+	24, // 36: app_service.AppService.GetAppDisk:input_type -> app_service.GetAppDiskRequest
+	26, // 37: app_service.AppService.SetAppDisk:input_type -> app_service.SetAppDiskRequest
+	28, // 38: app_service.AppService.DeleteAppDisk:input_type -> app_service.DeleteAppDiskRequest
+	30, // 39: app_service.AppService.GetEnvironmentVariables:input_type -> app_service.GetEnvironmentVariablesRequest
+	32, // 40: app_service.AppService.CreateEnvironmentVariables:input_type -> app_service.CreateEnvironmentVariablesRequest
+	34, // 41: app_service.AppService.UpdateEnvironmentVariables:input_type -> app_service.UpdateEnvironmentVariablesRequest
+	36, // 42: app_service.AppService.DeleteEnvironmentVariables:input_type -> app_service.DeleteEnvironmentVariablesRequest
+	38, // 43: app_service.AppService.SetEnvironmentVariable:input_type -> app_service.SetEnvironmentVariableRequest
+	40, // 44: app_service.AppService.DeleteEnvironmentVariable:input_type -> app_service.DeleteEnvironmentVariableRequest
+	42, // 45: app_service.AppService.ResolveEnvironmentVariables:input_type -> app_service.ResolveEnvironmentVariablesRequest
+	44, // 46: app_service.AppService.ImportEnvironmentVariables:input_type -> app_service.ImportEnvironmentVariablesRequest
+	46, // 47: app_service.AppService.ExportEnvironmentVariables:input_type -> app_service.ExportEnvironmentVariablesRequest
+	48, // 48: app_service.AppService.CreateEnvironmentGroup:input_type -> app_service.CreateEnvironmentGroupRequest
+	50, // 49: app_service.AppService.GetEnvironmentGroup:input_type -> app_service.GetEnvironmentGroupRequest
+	52, // 50: app_service.AppService.GetEnvironmentGroups:input_type -> app_service.GetEnvironmentGroupsRequest
+	54, // 51: app_service.AppService.DeleteEnvironmentGroup:input_type -> app_service.DeleteEnvironmentGroupRequest
+	56, // 52: app_service.AppService.SetEnvironmentGroupVariable:input_type -> app_service.SetEnvironmentGroupVariableRequest
+	58, // 53: app_service.AppService.DeleteEnvironmentGroupVariable:input_type -> app_service.DeleteEnvironmentGroupVariableRequest
+	60, // 54: app_service.AppService.LinkEnvironmentGroup:input_type -> app_service.LinkEnvironmentGroupRequest
+	62, // 55: app_service.AppService.UnlinkEnvironmentGroup:input_type -> app_service.UnlinkEnvironmentGroupRequest
+	64, // 56: app_service.AppService.BatchGetAppsCount:input_type -> app_service.BatchGetAppsCountRequest
+	66, // 57: app_service.AppService.GetExistingAppIds:input_type -> app_service.GetExistingAppIdsRequest
+	68, // 58: app_service.AppService.GetAppPreviews:input_type -> app_service.GetAppPreviewsRequest
+	70, // 59: app_service.AppService.GetPreviewSourceApps:input_type -> app_service.GetPreviewSourceAppsRequest
+	72, // 60: app_service.AppService.DeployPullRequestPreview:input_type -> app_service.DeployPullRequestPreviewRequest
+	74, // 61: app_service.AppService.DeletePullRequestPreview:input_type -> app_service.DeletePullRequestPreviewRequest
+	77, // 62: app_service.AppService.Health:output_type -> app_service.HealthResponse
+	9,  // 63: app_service.AppService.CreateApp:output_type -> app_service.CreateAppResponse
+	11, // 64: app_service.AppService.GetApp:output_type -> app_service.GetAppResponse
+	13, // 65: app_service.AppService.GetApps:output_type -> app_service.GetAppsResponse
+	15, // 66: app_service.AppService.UpdateApp:output_type -> app_service.UpdateAppResponse
+	17, // 67: app_service.AppService.DeleteApp:output_type -> app_service.DeleteAppResponse
+	19, // 68: app_service.AppService.SuspendApp:output_type -> app_service.SuspendAppResponse
+	21, // 69: app_service.AppService.ResumeApp:output_type -> app_service.ResumeAppResponse
+	23, // 70: app_service.AppService.GetAppDeploymentConfig:output_type -> app_service.GetAppDeploymentConfigResponse
+	25, // 71: app_service.AppService.GetAppDisk:output_type -> app_service.GetAppDiskResponse
+	27, // 72: app_service.AppService.SetAppDisk:output_type -> app_service.SetAppDiskResponse
+	29, // 73: app_service.AppService.DeleteAppDisk:output_type -> app_service.DeleteAppDiskResponse
+	31, // 74: app_service.AppService.GetEnvironmentVariables:output_type -> app_service.GetEnvironmentVariablesResponse
+	33, // 75: app_service.AppService.CreateEnvironmentVariables:output_type -> app_service.CreateEnvironmentVariablesResponse
+	35, // 76: app_service.AppService.UpdateEnvironmentVariables:output_type -> app_service.UpdateEnvironmentVariablesResponse
+	37, // 77: app_service.AppService.DeleteEnvironmentVariables:output_type -> app_service.DeleteEnvironmentVariablesResponse
+	39, // 78: app_service.AppService.SetEnvironmentVariable:output_type -> app_service.SetEnvironmentVariableResponse
+	41, // 79: app_service.AppService.DeleteEnvironmentVariable:output_type -> app_service.DeleteEnvironmentVariableResponse
+	43, // 80: app_service.AppService.ResolveEnvironmentVariables:output_type -> app_service.ResolveEnvironmentVariablesResponse
+	45, // 81: app_service.AppService.ImportEnvironmentVariables:output_type -> app_service.ImportEnvironmentVariablesResponse
+	47, // 82: app_service.AppService.ExportEnvironmentVariables:output_type -> app_service.ExportEnvironmentVariablesResponse
+	49, // 83: app_service.AppService.CreateEnvironmentGroup:output_type -> app_service.CreateEnvironmentGroupResponse
+	51, // 84: app_service.AppService.GetEnvironmentGroup:output_type -> app_service.GetEnvironmentGroupResponse
+	53, // 85: app_service.AppService.GetEnvironmentGroups:output_type -> app_service.GetEnvironmentGroupsResponse
+	55, // 86: app_service.AppService.DeleteEnvironmentGroup:output_type -> app_service.DeleteEnvironmentGroupResponse
+	57, // 87: app_service.AppService.SetEnvironmentGroupVariable:output_type -> app_service.SetEnvironmentGroupVariableResponse
+	59, // 88: app_service.AppService.DeleteEnvironmentGroupVariable:output_type -> app_service.DeleteEnvironmentGroupVariableResponse
+	61, // 89: app_service.AppService.LinkEnvironmentGroup:output_type -> app_service.LinkEnvironmentGroupResponse
+	63, // 90: app_service.AppService.UnlinkEnvironmentGroup:output_type -> app_service.UnlinkEnvironmentGroupResponse
+	65, // 91: app_service.AppService.BatchGetAppsCount:output_type -> app_service.BatchGetAppsCountResponse
+	67, // 92: app_service.AppService.GetExistingAppIds:output_type -> app_service.GetExistingAppIdsResponse
+	69, // 93: app_service.AppService.GetAppPreviews:output_type -> app_service.GetAppPreviewsResponse
+	71, // 94: app_service.AppService.GetPreviewSourceApps:output_type -> app_service.GetPreviewSourceAppsResponse
+	73, // 95: app_service.AppService.DeployPullRequestPreview:output_type -> app_service.DeployPullRequestPreviewResponse
+	75, // 96: app_service.AppService.DeletePullRequestPreview:output_type -> app_service.DeletePullRequestPreviewResponse
+	62, // [62:97] is the sub-list for method output_type
+	27, // [27:62] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_src_protos_app_service_proto_init() }
@@ -1547,15 +5195,18 @@ func file_src_protos_app_service_proto_init() {
 	if File_src_protos_app_service_proto != nil {
 		return
 	}
-	file_src_protos_app_service_proto_msgTypes[3].OneofWrappers = []any{}
-	file_src_protos_app_service_proto_msgTypes[9].OneofWrappers = []any{}
+	file_src_protos_app_service_proto_msgTypes[1].OneofWrappers = []any{}
+	file_src_protos_app_service_proto_msgTypes[8].OneofWrappers = []any{}
+	file_src_protos_app_service_proto_msgTypes[14].OneofWrappers = []any{}
+	file_src_protos_app_service_proto_msgTypes[38].OneofWrappers = []any{}
+	file_src_protos_app_service_proto_msgTypes[56].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_src_protos_app_service_proto_rawDesc), len(file_src_protos_app_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   26,
+			NumMessages:   80,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
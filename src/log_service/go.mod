@@ -5,7 +5,7 @@ go 1.24.0
 toolchain go1.24.4
 
 require (
-	apps-hosting.com/logging v0.0.1-20251127192047-9a4b3aa8018d
+	apps-hosting.com/logging v0.0.1-20261019033112-43aa3e925870
 	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.63.0
 	go.opentelemetry.io/otel v1.38.0
 	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp v1.38.0
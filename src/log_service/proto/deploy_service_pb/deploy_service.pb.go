@@ -28,6 +28,10 @@ type Deployment struct {
 	AppId         string                 `protobuf:"bytes,3,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
 	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Kind          string                 `protobuf:"bytes,7,opt,name=kind,proto3" json:"kind,omitempty"`
+	TriggeredBy   string                 `protobuf:"bytes,8,opt,name=triggered_by,json=triggeredBy,proto3" json:"triggered_by,omitempty"`
+	InstanceName  string                 `protobuf:"bytes,9,opt,name=instance_name,json=instanceName,proto3" json:"instance_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -97,6 +101,34 @@ func (x *Deployment) GetCreatedAt() string {
 	return ""
 }
 
+func (x *Deployment) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Deployment) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Deployment) GetTriggeredBy() string {
+	if x != nil {
+		return x.TriggeredBy
+	}
+	return ""
+}
+
+func (x *Deployment) GetInstanceName() string {
+	if x != nil {
+		return x.InstanceName
+	}
+	return ""
+}
+
 type GetDeploymentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
@@ -104,21 +136,838 @@ type GetDeploymentsRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDeploymentsRequest) Reset() {
-	*x = GetDeploymentsRequest{}
-	mi := &file_src_protos_deploy_service_proto_msgTypes[1]
+func (x *GetDeploymentsRequest) Reset() {
+	*x = GetDeploymentsRequest{}
+	mi := &file_src_protos_deploy_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeploymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeploymentsRequest) ProtoMessage() {}
+
+func (x *GetDeploymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_deploy_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeploymentsRequest.ProtoReflect.Descriptor instead.
+func (*GetDeploymentsRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetDeploymentsRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type GetDeploymentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deployments   []*Deployment          `protobuf:"bytes,1,rep,name=deployments,proto3" json:"deployments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeploymentsResponse) Reset() {
+	*x = GetDeploymentsResponse{}
+	mi := &file_src_protos_deploy_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeploymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeploymentsResponse) ProtoMessage() {}
+
+func (x *GetDeploymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_deploy_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeploymentsResponse.ProtoReflect.Descriptor instead.
+func (*GetDeploymentsResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDeploymentsResponse) GetDeployments() []*Deployment {
+	if x != nil {
+		return x.Deployments
+	}
+	return nil
+}
+
+type PromoteDeploymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoteDeploymentRequest) Reset() {
+	*x = PromoteDeploymentRequest{}
+	mi := &file_src_protos_deploy_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteDeploymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteDeploymentRequest) ProtoMessage() {}
+
+func (x *PromoteDeploymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_deploy_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteDeploymentRequest.ProtoReflect.Descriptor instead.
+func (*PromoteDeploymentRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PromoteDeploymentRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type PromoteDeploymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deployment    *Deployment            `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoteDeploymentResponse) Reset() {
+	*x = PromoteDeploymentResponse{}
+	mi := &file_src_protos_deploy_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteDeploymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteDeploymentResponse) ProtoMessage() {}
+
+func (x *PromoteDeploymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_deploy_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteDeploymentResponse.ProtoReflect.Descriptor instead.
+func (*PromoteDeploymentResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PromoteDeploymentResponse) GetDeployment() *Deployment {
+	if x != nil {
+		return x.Deployment
+	}
+	return nil
+}
+
+type AbortDeploymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortDeploymentRequest) Reset() {
+	*x = AbortDeploymentRequest{}
+	mi := &file_src_protos_deploy_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortDeploymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortDeploymentRequest) ProtoMessage() {}
+
+func (x *AbortDeploymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_deploy_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortDeploymentRequest.ProtoReflect.Descriptor instead.
+func (*AbortDeploymentRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AbortDeploymentRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type AbortDeploymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deployment    *Deployment            `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortDeploymentResponse) Reset() {
+	*x = AbortDeploymentResponse{}
+	mi := &file_src_protos_deploy_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortDeploymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortDeploymentResponse) ProtoMessage() {}
+
+func (x *AbortDeploymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_deploy_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortDeploymentResponse.ProtoReflect.Descriptor instead.
+func (*AbortDeploymentResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AbortDeploymentResponse) GetDeployment() *Deployment {
+	if x != nil {
+		return x.Deployment
+	}
+	return nil
+}
+
+type PodStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Phase         string                 `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
+	Ready         bool                   `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+	Restarts      int32                  `protobuf:"varint,4,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	StartedAt     string                 `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PodStatus) Reset() {
+	*x = PodStatus{}
+	mi := &file_src_protos_deploy_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PodStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodStatus) ProtoMessage() {}
+
+func (x *PodStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_deploy_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodStatus.ProtoReflect.Descriptor instead.
+func (*PodStatus) Descriptor() ([]byte, []int) {
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PodStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PodStatus) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *PodStatus) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *PodStatus) GetRestarts() int32 {
+	if x != nil {
+		return x.Restarts
+	}
+	return 0
+}
+
+func (x *PodStatus) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *PodStatus) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+type AppRuntimeStatus struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AppId             string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	DesiredReplicas   int32                  `protobuf:"varint,2,opt,name=desired_replicas,json=desiredReplicas,proto3" json:"desired_replicas,omitempty"`
+	ReadyReplicas     int32                  `protobuf:"varint,3,opt,name=ready_replicas,json=readyReplicas,proto3" json:"ready_replicas,omitempty"`
+	AvailableReplicas int32                  `protobuf:"varint,4,opt,name=available_replicas,json=availableReplicas,proto3" json:"available_replicas,omitempty"`
+	UpdatedReplicas   int32                  `protobuf:"varint,5,opt,name=updated_replicas,json=updatedReplicas,proto3" json:"updated_replicas,omitempty"`
+	Pods              []*PodStatus           `protobuf:"bytes,6,rep,name=pods,proto3" json:"pods,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AppRuntimeStatus) Reset() {
+	*x = AppRuntimeStatus{}
+	mi := &file_src_protos_deploy_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppRuntimeStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppRuntimeStatus) ProtoMessage() {}
+
+func (x *AppRuntimeStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_deploy_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppRuntimeStatus.ProtoReflect.Descriptor instead.
+func (*AppRuntimeStatus) Descriptor() ([]byte, []int) {
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AppRuntimeStatus) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *AppRuntimeStatus) GetDesiredReplicas() int32 {
+	if x != nil {
+		return x.DesiredReplicas
+	}
+	return 0
+}
+
+func (x *AppRuntimeStatus) GetReadyReplicas() int32 {
+	if x != nil {
+		return x.ReadyReplicas
+	}
+	return 0
+}
+
+func (x *AppRuntimeStatus) GetAvailableReplicas() int32 {
+	if x != nil {
+		return x.AvailableReplicas
+	}
+	return 0
+}
+
+func (x *AppRuntimeStatus) GetUpdatedReplicas() int32 {
+	if x != nil {
+		return x.UpdatedReplicas
+	}
+	return 0
+}
+
+func (x *AppRuntimeStatus) GetPods() []*PodStatus {
+	if x != nil {
+		return x.Pods
+	}
+	return nil
+}
+
+type GetAppRuntimeStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppRuntimeStatusRequest) Reset() {
+	*x = GetAppRuntimeStatusRequest{}
+	mi := &file_src_protos_deploy_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppRuntimeStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppRuntimeStatusRequest) ProtoMessage() {}
+
+func (x *GetAppRuntimeStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_deploy_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppRuntimeStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetAppRuntimeStatusRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetAppRuntimeStatusRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type GetAppRuntimeStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *AppRuntimeStatus      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppRuntimeStatusResponse) Reset() {
+	*x = GetAppRuntimeStatusResponse{}
+	mi := &file_src_protos_deploy_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppRuntimeStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppRuntimeStatusResponse) ProtoMessage() {}
+
+func (x *GetAppRuntimeStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_deploy_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppRuntimeStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetAppRuntimeStatusResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetAppRuntimeStatusResponse) GetStatus() *AppRuntimeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type Instance struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Name                    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Phase                   string                 `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
+	Ready                   bool                   `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+	Restarts                int32                  `protobuf:"varint,4,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	LastTerminationReason   string                 `protobuf:"bytes,5,opt,name=last_termination_reason,json=lastTerminationReason,proto3" json:"last_termination_reason,omitempty"`
+	LastTerminationExitCode int32                  `protobuf:"varint,6,opt,name=last_termination_exit_code,json=lastTerminationExitCode,proto3" json:"last_termination_exit_code,omitempty"`
+	NodeName                string                 `protobuf:"bytes,7,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	CreatedAt               string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AgeSeconds              int64                  `protobuf:"varint,9,opt,name=age_seconds,json=ageSeconds,proto3" json:"age_seconds,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Instance) Reset() {
+	*x = Instance{}
+	mi := &file_src_protos_deploy_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Instance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Instance) ProtoMessage() {}
+
+func (x *Instance) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_deploy_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Instance.ProtoReflect.Descriptor instead.
+func (*Instance) Descriptor() ([]byte, []int) {
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Instance) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Instance) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *Instance) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *Instance) GetRestarts() int32 {
+	if x != nil {
+		return x.Restarts
+	}
+	return 0
+}
+
+func (x *Instance) GetLastTerminationReason() string {
+	if x != nil {
+		return x.LastTerminationReason
+	}
+	return ""
+}
+
+func (x *Instance) GetLastTerminationExitCode() int32 {
+	if x != nil {
+		return x.LastTerminationExitCode
+	}
+	return 0
+}
+
+func (x *Instance) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *Instance) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Instance) GetAgeSeconds() int64 {
+	if x != nil {
+		return x.AgeSeconds
+	}
+	return 0
+}
+
+type AppEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	ObjectKind    string                 `protobuf:"bytes,4,opt,name=object_kind,json=objectKind,proto3" json:"object_kind,omitempty"`
+	ObjectName    string                 `protobuf:"bytes,5,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	Count         int32                  `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	LastSeenAt    string                 `protobuf:"bytes,7,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppEvent) Reset() {
+	*x = AppEvent{}
+	mi := &file_src_protos_deploy_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppEvent) ProtoMessage() {}
+
+func (x *AppEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_deploy_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppEvent.ProtoReflect.Descriptor instead.
+func (*AppEvent) Descriptor() ([]byte, []int) {
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AppEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AppEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AppEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AppEvent) GetObjectKind() string {
+	if x != nil {
+		return x.ObjectKind
+	}
+	return ""
+}
+
+func (x *AppEvent) GetObjectName() string {
+	if x != nil {
+		return x.ObjectName
+	}
+	return ""
+}
+
+func (x *AppEvent) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AppEvent) GetLastSeenAt() string {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return ""
+}
+
+type GetAppInstancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppInstancesRequest) Reset() {
+	*x = GetAppInstancesRequest{}
+	mi := &file_src_protos_deploy_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppInstancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppInstancesRequest) ProtoMessage() {}
+
+func (x *GetAppInstancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_deploy_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppInstancesRequest.ProtoReflect.Descriptor instead.
+func (*GetAppInstancesRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetAppInstancesRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+type GetAppInstancesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instances     []*Instance            `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+	Events        []*AppEvent            `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppInstancesResponse) Reset() {
+	*x = GetAppInstancesResponse{}
+	mi := &file_src_protos_deploy_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppInstancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppInstancesResponse) ProtoMessage() {}
+
+func (x *GetAppInstancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_deploy_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppInstancesResponse.ProtoReflect.Descriptor instead.
+func (*GetAppInstancesResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetAppInstancesResponse) GetInstances() []*Instance {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+func (x *GetAppInstancesResponse) GetEvents() []*AppEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type RestartAppRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestartAppRequest) Reset() {
+	*x = RestartAppRequest{}
+	mi := &file_src_protos_deploy_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDeploymentsRequest) String() string {
+func (x *RestartAppRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeploymentsRequest) ProtoMessage() {}
+func (*RestartAppRequest) ProtoMessage() {}
 
-func (x *GetDeploymentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_deploy_service_proto_msgTypes[1]
+func (x *RestartAppRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_deploy_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -129,40 +978,47 @@ func (x *GetDeploymentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeploymentsRequest.ProtoReflect.Descriptor instead.
-func (*GetDeploymentsRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use RestartAppRequest.ProtoReflect.Descriptor instead.
+func (*RestartAppRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetDeploymentsRequest) GetAppId() string {
+func (x *RestartAppRequest) GetAppId() string {
 	if x != nil {
 		return x.AppId
 	}
 	return ""
 }
 
-type GetDeploymentsResponse struct {
+func (x *RestartAppRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RestartAppResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Deployments   []*Deployment          `protobuf:"bytes,1,rep,name=deployments,proto3" json:"deployments,omitempty"`
+	Deployment    *Deployment            `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDeploymentsResponse) Reset() {
-	*x = GetDeploymentsResponse{}
-	mi := &file_src_protos_deploy_service_proto_msgTypes[2]
+func (x *RestartAppResponse) Reset() {
+	*x = RestartAppResponse{}
+	mi := &file_src_protos_deploy_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDeploymentsResponse) String() string {
+func (x *RestartAppResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeploymentsResponse) ProtoMessage() {}
+func (*RestartAppResponse) ProtoMessage() {}
 
-func (x *GetDeploymentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_deploy_service_proto_msgTypes[2]
+func (x *RestartAppResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_deploy_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -173,18 +1029,243 @@ func (x *GetDeploymentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeploymentsResponse.ProtoReflect.Descriptor instead.
-func (*GetDeploymentsResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use RestartAppResponse.ProtoReflect.Descriptor instead.
+func (*RestartAppResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetDeploymentsResponse) GetDeployments() []*Deployment {
+func (x *RestartAppResponse) GetDeployment() *Deployment {
 	if x != nil {
-		return x.Deployments
+		return x.Deployment
+	}
+	return nil
+}
+
+type RestartInstanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	InstanceName  string                 `protobuf:"bytes,2,opt,name=instance_name,json=instanceName,proto3" json:"instance_name,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestartInstanceRequest) Reset() {
+	*x = RestartInstanceRequest{}
+	mi := &file_src_protos_deploy_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestartInstanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestartInstanceRequest) ProtoMessage() {}
+
+func (x *RestartInstanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_deploy_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestartInstanceRequest.ProtoReflect.Descriptor instead.
+func (*RestartInstanceRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RestartInstanceRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *RestartInstanceRequest) GetInstanceName() string {
+	if x != nil {
+		return x.InstanceName
+	}
+	return ""
+}
+
+func (x *RestartInstanceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RestartInstanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deployment    *Deployment            `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestartInstanceResponse) Reset() {
+	*x = RestartInstanceResponse{}
+	mi := &file_src_protos_deploy_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestartInstanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestartInstanceResponse) ProtoMessage() {}
+
+func (x *RestartInstanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_deploy_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestartInstanceResponse.ProtoReflect.Descriptor instead.
+func (*RestartInstanceResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RestartInstanceResponse) GetDeployment() *Deployment {
+	if x != nil {
+		return x.Deployment
 	}
 	return nil
 }
 
+type WatchAppEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppId         string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchAppEventsRequest) Reset() {
+	*x = WatchAppEventsRequest{}
+	mi := &file_src_protos_deploy_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAppEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAppEventsRequest) ProtoMessage() {}
+
+func (x *WatchAppEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_deploy_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAppEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchAppEventsRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WatchAppEventsRequest) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *WatchAppEventsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// WatchAppEventsResponse is a single event, data is its payload encoded as JSON.
+type WatchAppEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventName     string                 `protobuf:"bytes,2,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Data          string                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchAppEventsResponse) Reset() {
+	*x = WatchAppEventsResponse{}
+	mi := &file_src_protos_deploy_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAppEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAppEventsResponse) ProtoMessage() {}
+
+func (x *WatchAppEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_deploy_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAppEventsResponse.ProtoReflect.Descriptor instead.
+func (*WatchAppEventsResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WatchAppEventsResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WatchAppEventsResponse) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *WatchAppEventsResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *WatchAppEventsResponse) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
 type HealthRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -193,7 +1274,7 @@ type HealthRequest struct {
 
 func (x *HealthRequest) Reset() {
 	*x = HealthRequest{}
-	mi := &file_src_protos_deploy_service_proto_msgTypes[3]
+	mi := &file_src_protos_deploy_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -205,7 +1286,7 @@ func (x *HealthRequest) String() string {
 func (*HealthRequest) ProtoMessage() {}
 
 func (x *HealthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_deploy_service_proto_msgTypes[3]
+	mi := &file_src_protos_deploy_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -218,7 +1299,7 @@ func (x *HealthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthRequest.ProtoReflect.Descriptor instead.
 func (*HealthRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{3}
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{21}
 }
 
 type HealthResponse struct {
@@ -231,7 +1312,7 @@ type HealthResponse struct {
 
 func (x *HealthResponse) Reset() {
 	*x = HealthResponse{}
-	mi := &file_src_protos_deploy_service_proto_msgTypes[4]
+	mi := &file_src_protos_deploy_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -243,7 +1324,7 @@ func (x *HealthResponse) String() string {
 func (*HealthResponse) ProtoMessage() {}
 
 func (x *HealthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_deploy_service_proto_msgTypes[4]
+	mi := &file_src_protos_deploy_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -256,7 +1337,7 @@ func (x *HealthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
 func (*HealthResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{4}
+	return file_src_protos_deploy_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *HealthResponse) GetStatus() string {
@@ -277,7 +1358,7 @@ var File_src_protos_deploy_service_proto protoreflect.FileDescriptor
 
 const file_src_protos_deploy_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1fsrc/protos/deploy_service.proto\x12\x0edeploy_service\"\x85\x01\n" +
+	"\x1fsrc/protos/deploy_service.proto\x12\x0edeploy_service\"\xfe\x01\n" +
 	"\n" +
 	"Deployment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
@@ -285,17 +1366,112 @@ const file_src_protos_deploy_service_proto_rawDesc = "" +
 	"\x06app_id\x18\x03 \x01(\tR\x05appId\x12\x16\n" +
 	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\tR\tcreatedAt\".\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1b\n" +
+	"\timage_url\x18\x06 \x01(\tR\bimageUrl\x12\x12\n" +
+	"\x04kind\x18\a \x01(\tR\x04kind\x12!\n" +
+	"\ftriggered_by\x18\b \x01(\tR\vtriggeredBy\x12#\n" +
+	"\rinstance_name\x18\t \x01(\tR\finstanceName\".\n" +
 	"\x15GetDeploymentsRequest\x12\x15\n" +
 	"\x06app_id\x18\x01 \x01(\tR\x05appId\"V\n" +
 	"\x16GetDeploymentsResponse\x12<\n" +
-	"\vdeployments\x18\x01 \x03(\v2\x1a.deploy_service.DeploymentR\vdeployments\"\x0f\n" +
+	"\vdeployments\x18\x01 \x03(\v2\x1a.deploy_service.DeploymentR\vdeployments\"1\n" +
+	"\x18PromoteDeploymentRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\"W\n" +
+	"\x19PromoteDeploymentResponse\x12:\n" +
+	"\n" +
+	"deployment\x18\x01 \x01(\v2\x1a.deploy_service.DeploymentR\n" +
+	"deployment\"/\n" +
+	"\x16AbortDeploymentRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\"U\n" +
+	"\x17AbortDeploymentResponse\x12:\n" +
+	"\n" +
+	"deployment\x18\x01 \x01(\v2\x1a.deploy_service.DeploymentR\n" +
+	"deployment\"\xa3\x01\n" +
+	"\tPodStatus\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phase\x18\x02 \x01(\tR\x05phase\x12\x14\n" +
+	"\x05ready\x18\x03 \x01(\bR\x05ready\x12\x1a\n" +
+	"\brestarts\x18\x04 \x01(\x05R\brestarts\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\tR\tstartedAt\"\x84\x02\n" +
+	"\x10AppRuntimeStatus\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12)\n" +
+	"\x10desired_replicas\x18\x02 \x01(\x05R\x0fdesiredReplicas\x12%\n" +
+	"\x0eready_replicas\x18\x03 \x01(\x05R\rreadyReplicas\x12-\n" +
+	"\x12available_replicas\x18\x04 \x01(\x05R\x11availableReplicas\x12)\n" +
+	"\x10updated_replicas\x18\x05 \x01(\x05R\x0fupdatedReplicas\x12-\n" +
+	"\x04pods\x18\x06 \x03(\v2\x19.deploy_service.PodStatusR\x04pods\"3\n" +
+	"\x1aGetAppRuntimeStatusRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\"W\n" +
+	"\x1bGetAppRuntimeStatusResponse\x128\n" +
+	"\x06status\x18\x01 \x01(\v2 .deploy_service.AppRuntimeStatusR\x06status\"\xb8\x02\n" +
+	"\bInstance\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phase\x18\x02 \x01(\tR\x05phase\x12\x14\n" +
+	"\x05ready\x18\x03 \x01(\bR\x05ready\x12\x1a\n" +
+	"\brestarts\x18\x04 \x01(\x05R\brestarts\x126\n" +
+	"\x17last_termination_reason\x18\x05 \x01(\tR\x15lastTerminationReason\x12;\n" +
+	"\x1alast_termination_exit_code\x18\x06 \x01(\x05R\x17lastTerminationExitCode\x12\x1b\n" +
+	"\tnode_name\x18\a \x01(\tR\bnodeName\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1f\n" +
+	"\vage_seconds\x18\t \x01(\x03R\n" +
+	"ageSeconds\"\xca\x01\n" +
+	"\bAppEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1f\n" +
+	"\vobject_kind\x18\x04 \x01(\tR\n" +
+	"objectKind\x12\x1f\n" +
+	"\vobject_name\x18\x05 \x01(\tR\n" +
+	"objectName\x12\x14\n" +
+	"\x05count\x18\x06 \x01(\x05R\x05count\x12 \n" +
+	"\flast_seen_at\x18\a \x01(\tR\n" +
+	"lastSeenAt\"/\n" +
+	"\x16GetAppInstancesRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\"\x83\x01\n" +
+	"\x17GetAppInstancesResponse\x126\n" +
+	"\tinstances\x18\x01 \x03(\v2\x18.deploy_service.InstanceR\tinstances\x120\n" +
+	"\x06events\x18\x02 \x03(\v2\x18.deploy_service.AppEventR\x06events\"C\n" +
+	"\x11RestartAppRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"P\n" +
+	"\x12RestartAppResponse\x12:\n" +
+	"\n" +
+	"deployment\x18\x01 \x01(\v2\x1a.deploy_service.DeploymentR\n" +
+	"deployment\"m\n" +
+	"\x16RestartInstanceRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12#\n" +
+	"\rinstance_name\x18\x02 \x01(\tR\finstanceName\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"U\n" +
+	"\x17RestartInstanceResponse\x12:\n" +
+	"\n" +
+	"deployment\x18\x01 \x01(\v2\x1a.deploy_service.DeploymentR\n" +
+	"deployment\"G\n" +
+	"\x15WatchAppEventsRequest\x12\x15\n" +
+	"\x06app_id\x18\x01 \x01(\tR\x05appId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"y\n" +
+	"\x16WatchAppEventsResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"event_name\x18\x02 \x01(\tR\teventName\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\tR\x04data\"\x0f\n" +
 	"\rHealthRequest\"B\n" +
 	"\x0eHealthResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\xb9\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\xf7\x06\n" +
 	"\rDeployService\x12_\n" +
-	"\x0eGetDeployments\x12%.deploy_service.GetDeploymentsRequest\x1a&.deploy_service.GetDeploymentsResponse\x12G\n" +
+	"\x0eGetDeployments\x12%.deploy_service.GetDeploymentsRequest\x1a&.deploy_service.GetDeploymentsResponse\x12h\n" +
+	"\x11PromoteDeployment\x12(.deploy_service.PromoteDeploymentRequest\x1a).deploy_service.PromoteDeploymentResponse\x12b\n" +
+	"\x0fAbortDeployment\x12&.deploy_service.AbortDeploymentRequest\x1a'.deploy_service.AbortDeploymentResponse\x12n\n" +
+	"\x13GetAppRuntimeStatus\x12*.deploy_service.GetAppRuntimeStatusRequest\x1a+.deploy_service.GetAppRuntimeStatusResponse\x12b\n" +
+	"\x0fGetAppInstances\x12&.deploy_service.GetAppInstancesRequest\x1a'.deploy_service.GetAppInstancesResponse\x12S\n" +
+	"\n" +
+	"RestartApp\x12!.deploy_service.RestartAppRequest\x1a\".deploy_service.RestartAppResponse\x12b\n" +
+	"\x0fRestartInstance\x12&.deploy_service.RestartInstanceRequest\x1a'.deploy_service.RestartInstanceResponse\x12a\n" +
+	"\x0eWatchAppEvents\x12%.deploy_service.WatchAppEventsRequest\x1a&.deploy_service.WatchAppEventsResponse0\x01\x12G\n" +
 	"\x06Health\x12\x1d.deploy_service.HealthRequest\x1a\x1e.deploy_service.HealthResponseB+Z)proto/deploy_service_pb;deploy_service_pbb\x06proto3"
 
 var (
@@ -310,25 +1486,65 @@ func file_src_protos_deploy_service_proto_rawDescGZIP() []byte {
 	return file_src_protos_deploy_service_proto_rawDescData
 }
 
-var file_src_protos_deploy_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_src_protos_deploy_service_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_src_protos_deploy_service_proto_goTypes = []any{
-	(*Deployment)(nil),             // 0: deploy_service.Deployment
-	(*GetDeploymentsRequest)(nil),  // 1: deploy_service.GetDeploymentsRequest
-	(*GetDeploymentsResponse)(nil), // 2: deploy_service.GetDeploymentsResponse
-	(*HealthRequest)(nil),          // 3: deploy_service.HealthRequest
-	(*HealthResponse)(nil),         // 4: deploy_service.HealthResponse
+	(*Deployment)(nil),                  // 0: deploy_service.Deployment
+	(*GetDeploymentsRequest)(nil),       // 1: deploy_service.GetDeploymentsRequest
+	(*GetDeploymentsResponse)(nil),      // 2: deploy_service.GetDeploymentsResponse
+	(*PromoteDeploymentRequest)(nil),    // 3: deploy_service.PromoteDeploymentRequest
+	(*PromoteDeploymentResponse)(nil),   // 4: deploy_service.PromoteDeploymentResponse
+	(*AbortDeploymentRequest)(nil),      // 5: deploy_service.AbortDeploymentRequest
+	(*AbortDeploymentResponse)(nil),     // 6: deploy_service.AbortDeploymentResponse
+	(*PodStatus)(nil),                   // 7: deploy_service.PodStatus
+	(*AppRuntimeStatus)(nil),            // 8: deploy_service.AppRuntimeStatus
+	(*GetAppRuntimeStatusRequest)(nil),  // 9: deploy_service.GetAppRuntimeStatusRequest
+	(*GetAppRuntimeStatusResponse)(nil), // 10: deploy_service.GetAppRuntimeStatusResponse
+	(*Instance)(nil),                    // 11: deploy_service.Instance
+	(*AppEvent)(nil),                    // 12: deploy_service.AppEvent
+	(*GetAppInstancesRequest)(nil),      // 13: deploy_service.GetAppInstancesRequest
+	(*GetAppInstancesResponse)(nil),     // 14: deploy_service.GetAppInstancesResponse
+	(*RestartAppRequest)(nil),           // 15: deploy_service.RestartAppRequest
+	(*RestartAppResponse)(nil),          // 16: deploy_service.RestartAppResponse
+	(*RestartInstanceRequest)(nil),      // 17: deploy_service.RestartInstanceRequest
+	(*RestartInstanceResponse)(nil),     // 18: deploy_service.RestartInstanceResponse
+	(*WatchAppEventsRequest)(nil),       // 19: deploy_service.WatchAppEventsRequest
+	(*WatchAppEventsResponse)(nil),      // 20: deploy_service.WatchAppEventsResponse
+	(*HealthRequest)(nil),               // 21: deploy_service.HealthRequest
+	(*HealthResponse)(nil),              // 22: deploy_service.HealthResponse
 }
 var file_src_protos_deploy_service_proto_depIdxs = []int32{
-	0, // 0: deploy_service.GetDeploymentsResponse.deployments:type_name -> deploy_service.Deployment
-	1, // 1: deploy_service.DeployService.GetDeployments:input_type -> deploy_service.GetDeploymentsRequest
-	3, // 2: deploy_service.DeployService.Health:input_type -> deploy_service.HealthRequest
-	2, // 3: deploy_service.DeployService.GetDeployments:output_type -> deploy_service.GetDeploymentsResponse
-	4, // 4: deploy_service.DeployService.Health:output_type -> deploy_service.HealthResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: deploy_service.GetDeploymentsResponse.deployments:type_name -> deploy_service.Deployment
+	0,  // 1: deploy_service.PromoteDeploymentResponse.deployment:type_name -> deploy_service.Deployment
+	0,  // 2: deploy_service.AbortDeploymentResponse.deployment:type_name -> deploy_service.Deployment
+	7,  // 3: deploy_service.AppRuntimeStatus.pods:type_name -> deploy_service.PodStatus
+	8,  // 4: deploy_service.GetAppRuntimeStatusResponse.status:type_name -> deploy_service.AppRuntimeStatus
+	11, // 5: deploy_service.GetAppInstancesResponse.instances:type_name -> deploy_service.Instance
+	12, // 6: deploy_service.GetAppInstancesResponse.events:type_name -> deploy_service.AppEvent
+	0,  // 7: deploy_service.RestartAppResponse.deployment:type_name -> deploy_service.Deployment
+	0,  // 8: deploy_service.RestartInstanceResponse.deployment:type_name -> deploy_service.Deployment
+	1,  // 9: deploy_service.DeployService.GetDeployments:input_type -> deploy_service.GetDeploymentsRequest
+	3,  // 10: deploy_service.DeployService.PromoteDeployment:input_type -> deploy_service.PromoteDeploymentRequest
+	5,  // 11: deploy_service.DeployService.AbortDeployment:input_type -> deploy_service.AbortDeploymentRequest
+	9,  // 12: deploy_service.DeployService.GetAppRuntimeStatus:input_type -> deploy_service.GetAppRuntimeStatusRequest
+	13, // 13: deploy_service.DeployService.GetAppInstances:input_type -> deploy_service.GetAppInstancesRequest
+	15, // 14: deploy_service.DeployService.RestartApp:input_type -> deploy_service.RestartAppRequest
+	17, // 15: deploy_service.DeployService.RestartInstance:input_type -> deploy_service.RestartInstanceRequest
+	19, // 16: deploy_service.DeployService.WatchAppEvents:input_type -> deploy_service.WatchAppEventsRequest
+	21, // 17: deploy_service.DeployService.Health:input_type -> deploy_service.HealthRequest
+	2,  // 18: deploy_service.DeployService.GetDeployments:output_type -> deploy_service.GetDeploymentsResponse
+	4,  // 19: deploy_service.DeployService.PromoteDeployment:output_type -> deploy_service.PromoteDeploymentResponse
+	6,  // 20: deploy_service.DeployService.AbortDeployment:output_type -> deploy_service.AbortDeploymentResponse
+	10, // 21: deploy_service.DeployService.GetAppRuntimeStatus:output_type -> deploy_service.GetAppRuntimeStatusResponse
+	14, // 22: deploy_service.DeployService.GetAppInstances:output_type -> deploy_service.GetAppInstancesResponse
+	16, // 23: deploy_service.DeployService.RestartApp:output_type -> deploy_service.RestartAppResponse
+	18, // 24: deploy_service.DeployService.RestartInstance:output_type -> deploy_service.RestartInstanceResponse
+	20, // 25: deploy_service.DeployService.WatchAppEvents:output_type -> deploy_service.WatchAppEventsResponse
+	22, // 26: deploy_service.DeployService.Health:output_type -> deploy_service.HealthResponse
+	18, // [18:27] is the sub-list for method output_type
+	9,  // [9:18] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_src_protos_deploy_service_proto_init() }
@@ -342,7 +1558,7 @@ func file_src_protos_deploy_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_src_protos_deploy_service_proto_rawDesc), len(file_src_protos_deploy_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
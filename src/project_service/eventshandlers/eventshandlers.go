@@ -0,0 +1,110 @@
+package eventshandlers
+
+import (
+	"context"
+	"project/repositories"
+
+	"apps-hosting.com/messaging"
+	"apps-hosting.com/messaging/proto/events_pb"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"apps-hosting.com/logging"
+)
+
+type EventsHandlers struct {
+	addOnRepository repositories.AddOnRepositoryInterface
+	logger          logging.ServiceLogger
+}
+
+func NewEventsHandlers(addOnRepository repositories.AddOnRepositoryInterface, logger logging.ServiceLogger) EventsHandlers {
+	return EventsHandlers{
+		addOnRepository: addOnRepository,
+		logger:          logger,
+	}
+}
+
+func (h *EventsHandlers) HandleAddOnProvisionedEvent(ctx context.Context, message *events_pb.Message) error {
+	h.logger.LogInfo("Handle 'addon.provisioned' event")
+	span := trace.SpanFromContext(ctx)
+
+	data := message.Data.GetAddOnProvisionedData()
+	if data == nil {
+		h.logger.LogError("Invalid add-on provisioned message")
+		span.SetAttributes(attribute.String("error", "Invalid add-on provisioned message"))
+		return messaging.ErrInvalidMessage
+	}
+
+	span.SetAttributes(attribute.String("add_on.id", data.AddOnId))
+
+	err := h.addOnRepository.SetAddOnProvisioned(ctx, data.AddOnId, repositories.ProvisionedAddOnParams{
+		Host:     data.Host,
+		Port:     data.Port,
+		Database: data.Database,
+		Username: data.Username,
+	})
+	if err == repositories.ErrAddOnNotFound {
+		// the add-on was deleted since
+		return nil
+	}
+	if err != nil {
+		h.logger.LogError(err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+func (h *EventsHandlers) HandleAddOnProvisionFailedEvent(ctx context.Context, message *events_pb.Message) error {
+	h.logger.LogInfo("Handle 'addon.provision_failed' event")
+	span := trace.SpanFromContext(ctx)
+
+	data := message.Data.GetAddOnProvisionFailedData()
+	if data == nil {
+		h.logger.LogError("Invalid add-on provision failed message")
+		span.SetAttributes(attribute.String("error", "Invalid add-on provision failed message"))
+		return messaging.ErrInvalidMessage
+	}
+
+	span.SetAttributes(
+		attribute.String("add_on.id", data.AddOnId),
+		attribute.String("add_on.failure_reason", data.Reason),
+	)
+
+	err := h.addOnRepository.SetAddOnStatus(ctx, data.AddOnId, repositories.AddOnStatusFailed)
+	if err == repositories.ErrAddOnNotFound {
+		// the add-on was deleted since
+		return nil
+	}
+	if err != nil {
+		h.logger.LogError(err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+func (h *EventsHandlers) HandleAppDeletedEvent(ctx context.Context, message *events_pb.Message) error {
+	h.logger.LogInfo("Handle 'app.deleted' event")
+	span := trace.SpanFromContext(ctx)
+
+	data := message.Data.GetAppDeletedData()
+	if data == nil {
+		h.logger.LogError("Invalid app deleted message")
+		span.SetAttributes(attribute.String("error", "Invalid app deleted message"))
+		return messaging.ErrInvalidMessage
+	}
+
+	span.SetAttributes(attribute.String("app.id", data.AppId))
+
+	err := h.addOnRepository.DeleteAppLinks(ctx, data.AppId)
+	if err != nil {
+		h.logger.LogError(err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
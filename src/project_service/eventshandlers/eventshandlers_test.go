@@ -0,0 +1,184 @@
+package eventshandlers
+
+import (
+	"context"
+	"errors"
+	"project/repositories"
+	"testing"
+
+	"apps-hosting.com/logging"
+	"apps-hosting.com/messaging"
+	"apps-hosting.com/messaging/proto/events_pb"
+)
+
+// fakeAddOnRepository records the add-ons set provisioned, it fails the first calls with failure.
+type fakeAddOnRepository struct {
+	repositories.AddOnRepositoryInterface
+
+	failures    int
+	failure     error
+	calls       int
+	provisioned map[string]repositories.ProvisionedAddOnParams
+}
+
+func (r *fakeAddOnRepository) SetAddOnProvisioned(ctx context.Context, addOnId string, provisionedAddOnParams repositories.ProvisionedAddOnParams) error {
+	r.calls++
+	if r.failures > 0 {
+		r.failures--
+		return r.failure
+	}
+
+	r.provisioned[addOnId] = provisionedAddOnParams
+	return nil
+}
+
+func newProvisionedTest(t *testing.T, addOnRepository *fakeAddOnRepository) *messaging.MemoryBus {
+	t.Helper()
+
+	eventBus := messaging.NewMemoryBus("project-service")
+	eventsHandlers := NewEventsHandlers(addOnRepository, logging.NewServiceLogger(logging.ServiceProject))
+
+	err := eventBus.Subscribe(events_pb.EventName_ADDON_PROVISIONED, eventsHandlers.HandleAddOnProvisionedEvent)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	return eventBus
+}
+
+func TestHandleAddOnProvisionedEvent(t *testing.T) {
+	addOnProvisionedData := &events_pb.EventData{
+		Value: &events_pb.EventData_AddOnProvisionedData{
+			AddOnProvisionedData: &events_pb.AddOnProvisionedEventData{
+				AddOnId:  "addon-1",
+				Host:     "addon-1.addons.svc",
+				Port:     5432,
+				Database: "app",
+				Username: "app",
+			},
+		},
+	}
+	transientFailure := errors.New("connection reset by peer")
+
+	tests := []struct {
+		name                string
+		data                *events_pb.EventData
+		failures            int
+		failure             error
+		expectedCalls       int
+		expectedProvisioned bool
+		expectedDeliveries  uint64
+	}{
+		{
+			name:                "provisioned on the first delivery",
+			data:                addOnProvisionedData,
+			expectedCalls:       1,
+			expectedProvisioned: true,
+		},
+		{
+			name:                "delivered again while the database fails",
+			data:                addOnProvisionedData,
+			failures:            2,
+			failure:             transientFailure,
+			expectedCalls:       3,
+			expectedProvisioned: true,
+		},
+		{
+			name:               "dead-lettered once every delivery failed",
+			data:               addOnProvisionedData,
+			failures:           messaging.DefaultMaxDeliver,
+			failure:            transientFailure,
+			expectedCalls:      messaging.DefaultMaxDeliver,
+			expectedDeliveries: messaging.DefaultMaxDeliver,
+		},
+		{
+			name:          "add-on deleted since",
+			data:          addOnProvisionedData,
+			failures:      1,
+			failure:       repositories.ErrAddOnNotFound,
+			expectedCalls: 1,
+		},
+		{
+			name: "invalid message dead-lettered right away",
+			data: &events_pb.EventData{
+				Value: &events_pb.EventData_AddOnProvisionFailedData{
+					AddOnProvisionFailedData: &events_pb.AddOnProvisionFailedEventData{AddOnId: "addon-1"},
+				},
+			},
+			expectedDeliveries: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			addOnRepository := &fakeAddOnRepository{
+				failures:    test.failures,
+				failure:     test.failure,
+				provisioned: map[string]repositories.ProvisionedAddOnParams{},
+			}
+			eventBus := newProvisionedTest(t, addOnRepository)
+
+			err := eventBus.Publish(context.Background(), events_pb.EventName_ADDON_PROVISIONED, test.data)
+			if err != nil {
+				t.Fatalf("Publish: %v", err)
+			}
+
+			if addOnRepository.calls != test.expectedCalls {
+				t.Errorf("add-on set provisioned %d times, expected %d", addOnRepository.calls, test.expectedCalls)
+			}
+
+			_, provisioned := addOnRepository.provisioned["addon-1"]
+			if provisioned != test.expectedProvisioned {
+				t.Errorf("add-on provisioned is %t, expected %t", provisioned, test.expectedProvisioned)
+			}
+
+			deadLetters := eventBus.DeadLetters()
+			if test.expectedDeliveries == 0 {
+				if len(deadLetters) != 0 {
+					t.Errorf("%d dead letters, expected none", len(deadLetters))
+				}
+				return
+			}
+
+			if len(deadLetters) != 1 {
+				t.Fatalf("%d dead letters, expected 1", len(deadLetters))
+			}
+			if deadLetters[0].Deliveries != test.expectedDeliveries {
+				t.Errorf("dead-lettered after %d deliveries, expected %d", deadLetters[0].Deliveries, test.expectedDeliveries)
+			}
+			if deadLetters[0].Consumer != "project-service-addon-provisioned" {
+				t.Errorf("dead-lettered by %q, expected project-service-addon-provisioned", deadLetters[0].Consumer)
+			}
+		})
+	}
+}
+
+func TestHandleAddOnProvisionedEventRedelivered(t *testing.T) {
+	addOnRepository := &fakeAddOnRepository{provisioned: map[string]repositories.ProvisionedAddOnParams{}}
+	eventBus := newProvisionedTest(t, addOnRepository)
+
+	err := eventBus.Publish(context.Background(), events_pb.EventName_ADDON_PROVISIONED, &events_pb.EventData{
+		Value: &events_pb.EventData_AddOnProvisionedData{
+			AddOnProvisionedData: &events_pb.AddOnProvisionedEventData{AddOnId: "addon-1", Host: "addon-1.addons.svc"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+
+	// the acknowledgement was lost, the same connection details are written again
+	err = eventBus.Redeliver(eventBus.Published()[0].Id)
+	if err != nil {
+		t.Fatalf("Redeliver: %v", err)
+	}
+
+	if addOnRepository.calls != 2 {
+		t.Errorf("add-on set provisioned %d times, expected 2", addOnRepository.calls)
+	}
+	if host := addOnRepository.provisioned["addon-1"].Host; host != "addon-1.addons.svc" {
+		t.Errorf("add-on host is %q, expected addon-1.addons.svc", host)
+	}
+	if deadLetters := eventBus.DeadLetters(); len(deadLetters) != 0 {
+		t.Errorf("%d dead letters, expected none", len(deadLetters))
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"project/proto/project_service_pb"
 	"project/repositories"
+	"strings"
 
 	"apps-hosting.com/logging"
 	"apps-hosting.com/messaging"
@@ -19,13 +20,20 @@ type GRPCProjectServiceServer struct {
 	project_service_pb.UnimplementedProjectServiceServer
 
 	ProjectRepository repositories.ProjectRepositoryInterface
-	EventBus          *messaging.EventBus
+	AddOnRepository   repositories.AddOnRepositoryInterface
+	EventBus          messaging.Bus
 	Logger            logging.ServiceLogger
 }
 
-func NewGRPCProjectServiceServer(projectRepository repositories.ProjectRepositoryInterface, eventBus *messaging.EventBus, logger logging.ServiceLogger) *GRPCProjectServiceServer {
+func NewGRPCProjectServiceServer(
+	projectRepository repositories.ProjectRepositoryInterface,
+	addOnRepository repositories.AddOnRepositoryInterface,
+	eventBus messaging.Bus,
+	logger logging.ServiceLogger,
+) *GRPCProjectServiceServer {
 	return &GRPCProjectServiceServer{
 		ProjectRepository: projectRepository,
+		AddOnRepository:   addOnRepository,
 		EventBus:          eventBus,
 		Logger:            logger,
 	}
@@ -99,6 +107,35 @@ func (server *GRPCProjectServiceServer) GetUserProjectById(ctx context.Context,
 	}, nil
 }
 
+// GetProject returns the project whoever owns it. It is meant for internal callers only
+// (the webhooks of the gateway) and must not be exposed to users.
+func (server *GRPCProjectServiceServer) GetProject(ctx context.Context, getProjectRequest *project_service_pb.GetProjectRequest) (*project_service_pb.GetProjectResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.String("project.id", getProjectRequest.ProjectId))
+
+	project, err := server.ProjectRepository.GetProjectByIdInternal(ctx, getProjectRequest.ProjectId)
+
+	if err == repositories.ErrProjectNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &project_service_pb.GetProjectResponse{
+		Project: &project_service_pb.Project{
+			Id:        project.Id,
+			Name:      project.Name,
+			UserId:    project.UserId,
+			CreatedAt: project.CreatedAt.String(),
+		},
+	}, nil
+}
+
 func (server *GRPCProjectServiceServer) GetUserProjects(ctx context.Context, getUserProjectsRequest *project_service_pb.GetUserProjectsRequest) (*project_service_pb.GetUserProjectsResponse, error) {
 	span := trace.SpanFromContext(ctx)
 
@@ -166,6 +203,8 @@ func (server *GRPCProjectServiceServer) DeleteUserProject(ctx context.Context, d
 		span.SetAttributes(attribute.String("error", err.Error()))
 	}
 
+	server.deleteProjectAddOns(ctx, deleteUserProjectRequest.ProjectId)
+
 	return &project_service_pb.DeleteUserProjectResponse{}, nil
 }
 
@@ -196,3 +235,317 @@ func (server *GRPCProjectServiceServer) UpdateProject(ctx context.Context, updat
 		},
 	}, nil
 }
+
+func (server *GRPCProjectServiceServer) CreateAddOn(ctx context.Context, createAddOnRequest *project_service_pb.CreateAddOnRequest) (*project_service_pb.CreateAddOnResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("project.id", createAddOnRequest.ProjectId),
+		attribute.String("add_on.type", createAddOnRequest.Type),
+	)
+
+	name := strings.TrimSpace(createAddOnRequest.Name)
+	if len(name) == 0 {
+		span.SetAttributes(attribute.String("error", ErrAddOnNameRequired.Error()))
+		return nil, status.Error(codes.InvalidArgument, ErrAddOnNameRequired.Error())
+	}
+
+	addOnType, err := ValidateAddOnType(createAddOnRequest.Type)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	addOn, err := server.AddOnRepository.CreateAddOn(ctx, createAddOnRequest.ProjectId, repositories.CreateAddOnParams{
+		Name: name,
+		Type: addOnType,
+	})
+
+	if err == repositories.ErrAddOnNameInUse {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	span.SetAttributes(attribute.String("add_on.id", addOn.Id))
+
+	server.Logger.LogInfo("Send AddOnCreated Event")
+	err = server.EventBus.Publish(ctx, events_pb.EventName_ADDON_CREATED, &events_pb.EventData{
+		Value: &events_pb.EventData_AddOnCreatedData{
+			AddOnCreatedData: &events_pb.AddOnCreatedEventData{
+				AddOnId:   addOn.Id,
+				ProjectId: addOn.ProjectId,
+				Type:      string(addOn.Type),
+			},
+		},
+	})
+	if err != nil {
+		server.Logger.LogError(err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+	}
+
+	return &project_service_pb.CreateAddOnResponse{
+		AddOn: AddOnToProto(addOn),
+	}, nil
+}
+
+func (server *GRPCProjectServiceServer) GetAddOn(ctx context.Context, getAddOnRequest *project_service_pb.GetAddOnRequest) (*project_service_pb.GetAddOnResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("project.id", getAddOnRequest.ProjectId),
+		attribute.String("add_on.id", getAddOnRequest.AddOnId),
+	)
+
+	addOn, err := server.AddOnRepository.GetAddOnById(ctx, getAddOnRequest.ProjectId, getAddOnRequest.AddOnId)
+	if err == repositories.ErrAddOnNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	addOnLinks, err := server.AddOnRepository.GetAddOnLinks(ctx, addOn.Id)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &project_service_pb.GetAddOnResponse{
+		AddOn: AddOnToProto(addOn),
+		Links: AddOnLinkListToProto(addOnLinks),
+	}, nil
+}
+
+func (server *GRPCProjectServiceServer) GetAddOns(ctx context.Context, getAddOnsRequest *project_service_pb.GetAddOnsRequest) (*project_service_pb.GetAddOnsResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.String("project.id", getAddOnsRequest.ProjectId))
+
+	addOns, err := server.AddOnRepository.GetAddOns(ctx, getAddOnsRequest.ProjectId)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	_addOns := make([]*project_service_pb.AddOn, 0, len(addOns))
+	for _, addOn := range addOns {
+		_addOns = append(_addOns, AddOnToProto(&addOn))
+	}
+
+	span.SetAttributes(attribute.Int("add_ons.count", len(_addOns)))
+
+	return &project_service_pb.GetAddOnsResponse{
+		AddOns: _addOns,
+	}, nil
+}
+
+func (server *GRPCProjectServiceServer) DeleteAddOn(ctx context.Context, deleteAddOnRequest *project_service_pb.DeleteAddOnRequest) (*project_service_pb.DeleteAddOnResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("project.id", deleteAddOnRequest.ProjectId),
+		attribute.String("add_on.id", deleteAddOnRequest.AddOnId),
+	)
+
+	// The links are gone once the add-on is deleted, collect the apps to restart first.
+	addOnLinks, err := server.AddOnRepository.GetAddOnLinks(ctx, deleteAddOnRequest.AddOnId)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = server.AddOnRepository.DeleteAddOn(ctx, deleteAddOnRequest.ProjectId, deleteAddOnRequest.AddOnId)
+	if err == repositories.ErrAddOnNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	server.publishAddOnDeleted(ctx, deleteAddOnRequest.ProjectId, deleteAddOnRequest.AddOnId)
+
+	for _, addOnLink := range addOnLinks {
+		server.publishEnvUpdated(ctx, addOnLink.AppId, deleteAddOnRequest.SkipRestart)
+	}
+
+	return &project_service_pb.DeleteAddOnResponse{}, nil
+}
+
+func (server *GRPCProjectServiceServer) LinkAddOn(ctx context.Context, linkAddOnRequest *project_service_pb.LinkAddOnRequest) (*project_service_pb.LinkAddOnResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("project.id", linkAddOnRequest.ProjectId),
+		attribute.String("add_on.id", linkAddOnRequest.AddOnId),
+		attribute.String("app.id", linkAddOnRequest.AppId),
+	)
+
+	addOn, err := server.AddOnRepository.GetAddOnById(ctx, linkAddOnRequest.ProjectId, linkAddOnRequest.AddOnId)
+	if err == repositories.ErrAddOnNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	envVarName := linkAddOnRequest.EnvVarName
+	if len(envVarName) == 0 {
+		envVarName = DefaultAddOnEnvVarName(addOn.Type)
+	}
+
+	if !IsValidEnvVarName(envVarName) {
+		span.SetAttributes(attribute.String("error", ErrInvalidEnvVarName.Error()))
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidEnvVarName.Error())
+	}
+
+	addOnLink, err := server.AddOnRepository.LinkApp(ctx, addOn.Id, linkAddOnRequest.AppId, envVarName)
+	if err == repositories.ErrAddOnAlreadyLinked || err == repositories.ErrAddOnEnvVarNameInUse {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	server.publishEnvUpdated(ctx, linkAddOnRequest.AppId, linkAddOnRequest.SkipRestart)
+
+	return &project_service_pb.LinkAddOnResponse{
+		Link: AddOnLinkToProto(addOnLink),
+	}, nil
+}
+
+func (server *GRPCProjectServiceServer) UnlinkAddOn(ctx context.Context, unlinkAddOnRequest *project_service_pb.UnlinkAddOnRequest) (*project_service_pb.UnlinkAddOnResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		attribute.String("project.id", unlinkAddOnRequest.ProjectId),
+		attribute.String("add_on.id", unlinkAddOnRequest.AddOnId),
+		attribute.String("app.id", unlinkAddOnRequest.AppId),
+	)
+
+	_, err := server.AddOnRepository.GetAddOnById(ctx, unlinkAddOnRequest.ProjectId, unlinkAddOnRequest.AddOnId)
+	if err == repositories.ErrAddOnNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = server.AddOnRepository.UnlinkApp(ctx, unlinkAddOnRequest.AddOnId, unlinkAddOnRequest.AppId)
+	if err == repositories.ErrAddOnLinkNotFound {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	server.publishEnvUpdated(ctx, unlinkAddOnRequest.AppId, unlinkAddOnRequest.SkipRestart)
+
+	return &project_service_pb.UnlinkAddOnResponse{}, nil
+}
+
+// GetAppAddOns is used by deploy-service to inject the connection urls of the linked add-ons.
+func (server *GRPCProjectServiceServer) GetAppAddOns(ctx context.Context, getAppAddOnsRequest *project_service_pb.GetAppAddOnsRequest) (*project_service_pb.GetAppAddOnsResponse, error) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.String("app.id", getAppAddOnsRequest.AppId))
+
+	addOnLinks, err := server.AddOnRepository.GetAppAddOnLinks(ctx, getAppAddOnsRequest.AppId)
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	span.SetAttributes(attribute.Int("add_ons.count", len(addOnLinks)))
+
+	return &project_service_pb.GetAppAddOnsResponse{
+		Links: AddOnLinkListToProto(addOnLinks),
+	}, nil
+}
+
+func (server *GRPCProjectServiceServer) deleteProjectAddOns(ctx context.Context, projectId string) {
+	span := trace.SpanFromContext(ctx)
+
+	addOns, err := server.AddOnRepository.GetAddOns(ctx, projectId)
+	if err != nil {
+		server.Logger.LogError(err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	err = server.AddOnRepository.DeleteAddOnsByProjectId(ctx, projectId)
+	if err != nil {
+		server.Logger.LogError(err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return
+	}
+
+	for _, addOn := range addOns {
+		server.publishAddOnDeleted(ctx, projectId, addOn.Id)
+	}
+}
+
+// publishAddOnDeleted asks deploy-service to remove the add-on workload and its data.
+func (server *GRPCProjectServiceServer) publishAddOnDeleted(ctx context.Context, projectId, addOnId string) {
+	span := trace.SpanFromContext(ctx)
+
+	server.Logger.LogInfo("Send AddOnDeleted Event")
+	err := server.EventBus.Publish(ctx, events_pb.EventName_ADDON_DELETED, &events_pb.EventData{
+		Value: &events_pb.EventData_AddOnDeletedData{
+			AddOnDeletedData: &events_pb.AddOnDeletedEventData{
+				AddOnId:   addOnId,
+				ProjectId: projectId,
+			},
+		},
+	})
+	if err != nil {
+		server.Logger.LogError(err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+	}
+}
+
+// publishEnvUpdated rolls the app so that it picks up the new add-on urls,
+// unless the caller chose to wait for the next deployment.
+func (server *GRPCProjectServiceServer) publishEnvUpdated(ctx context.Context, appId string, skipRestart bool) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(attribute.Bool("environment_variables.skip_restart", skipRestart))
+	if skipRestart {
+		return
+	}
+
+	server.Logger.LogInfo("Send AppEnvUpdated Event")
+	err := server.EventBus.Publish(ctx, events_pb.EventName_APP_ENV_UPDATED, &events_pb.EventData{
+		Value: &events_pb.EventData_AppEnvUpdatedData{
+			AppEnvUpdatedData: &events_pb.AppEnvUpdatedEventData{
+				AppId: appId,
+			},
+		},
+	})
+	if err != nil {
+		server.Logger.LogError(err.Error())
+		span.SetAttributes(attribute.String("error", err.Error()))
+	}
+}
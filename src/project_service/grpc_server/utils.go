@@ -0,0 +1,71 @@
+package grpc_server
+
+import (
+	"errors"
+	"fmt"
+	"project/proto/project_service_pb"
+	"project/repositories"
+	"regexp"
+)
+
+var (
+	ErrAddOnNameRequired = errors.New("add-on name is required")
+	ErrInvalidEnvVarName = errors.New("invalid environment variable name, use letters, digits and underscores and do not start with a digit")
+)
+
+// envVarNamePattern follows the POSIX rules for portable environment variable names.
+var envVarNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func IsValidEnvVarName(name string) bool {
+	return envVarNamePattern.MatchString(name)
+}
+
+func ValidateAddOnType(addOnType string) (repositories.AddOnType, error) {
+	for _, _addOnType := range repositories.AddOnTypes {
+		if string(_addOnType) == addOnType {
+			return _addOnType, nil
+		}
+	}
+
+	return "", fmt.Errorf("invalid add-on type '%s', expected one of %v", addOnType, repositories.AddOnTypes)
+}
+
+func DefaultAddOnEnvVarName(addOnType repositories.AddOnType) string {
+	if addOnType == repositories.AddOnTypeRedis {
+		return "REDIS_URL"
+	}
+
+	return "DATABASE_URL"
+}
+
+func AddOnToProto(addOn *repositories.AddOn) *project_service_pb.AddOn {
+	return &project_service_pb.AddOn{
+		Id:        addOn.Id,
+		ProjectId: addOn.ProjectId,
+		Name:      addOn.Name,
+		Type:      string(addOn.Type),
+		Status:    string(addOn.Status),
+		Host:      addOn.Host,
+		Port:      addOn.Port,
+		Database:  addOn.Database,
+		Username:  addOn.Username,
+		CreatedAt: addOn.CreatedAt.String(),
+	}
+}
+
+func AddOnLinkToProto(addOnLink *repositories.AddOnLink) *project_service_pb.AddOnLink {
+	return &project_service_pb.AddOnLink{
+		AddOnId:    addOnLink.AddOnId,
+		AppId:      addOnLink.AppId,
+		EnvVarName: addOnLink.EnvVarName,
+		CreatedAt:  addOnLink.CreatedAt.String(),
+	}
+}
+
+func AddOnLinkListToProto(addOnLinks []repositories.AddOnLink) []*project_service_pb.AddOnLink {
+	_addOnLinks := make([]*project_service_pb.AddOnLink, 0, len(addOnLinks))
+	for _, addOnLink := range addOnLinks {
+		_addOnLinks = append(_addOnLinks, AddOnLinkToProto(&addOnLink))
+	}
+	return _addOnLinks
+}
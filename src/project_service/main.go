@@ -5,6 +5,7 @@ import (
 	"net"
 	"os"
 	"project/database"
+	"project/eventshandlers"
 	"project/grpc_server"
 	"project/proto/project_service_pb"
 	"project/repositories"
@@ -59,27 +60,41 @@ func main() {
 
 	database := database.NewDatabase()
 	projectRepository := repositories.NewProjectRepository(database, logger)
+	addOnRepository := repositories.NewAddOnRepository(database, logger)
 
 	err := projectRepository.CreateProjectsTable()
 	if err != nil {
 		panic(err)
 	}
 
+	err = addOnRepository.CreateAddOnsTables()
+	if err != nil {
+		panic(err)
+	}
+
 	natsURL := os.Getenv("NATS_URL")
-	eventBus, err := messaging.NewEventBus(
-		serviceName,
-		natsURL,
-		events_pb.StreamName_PROJECT_STREAM,
-		[]events_pb.EventName{
-			events_pb.EventName_PROJECT_DELETED,
-		},
-	)
+	eventBus, err := messaging.NewEventBus(serviceName, natsURL, messaging.ProjectStream)
 	if err != nil {
 		panic(err)
 	}
 
+	eventsHandlers := eventshandlers.NewEventsHandlers(&addOnRepository, logger)
+
+	err = eventBus.Subscribe(events_pb.EventName_ADDON_PROVISIONED, eventsHandlers.HandleAddOnProvisionedEvent)
+	if err != nil {
+		logger.LogErrorF("failed to subscribe to '%s': %v", events_pb.EventName_name[int32(events_pb.EventName_ADDON_PROVISIONED)], err)
+	}
+	err = eventBus.Subscribe(events_pb.EventName_ADDON_PROVISION_FAILED, eventsHandlers.HandleAddOnProvisionFailedEvent)
+	if err != nil {
+		logger.LogErrorF("failed to subscribe to '%s': %v", events_pb.EventName_name[int32(events_pb.EventName_ADDON_PROVISION_FAILED)], err)
+	}
+	err = eventBus.Subscribe(events_pb.EventName_APP_DELETED, eventsHandlers.HandleAppDeletedEvent)
+	if err != nil {
+		logger.LogErrorF("failed to subscribe to '%s': %v", events_pb.EventName_name[int32(events_pb.EventName_APP_DELETED)], err)
+	}
+
 	grpcServer := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
-	grpcProjectServiceServer := grpc_server.NewGRPCProjectServiceServer(&projectRepository, eventBus, logger)
+	grpcProjectServiceServer := grpc_server.NewGRPCProjectServiceServer(&projectRepository, &addOnRepository, eventBus, logger)
 	project_service_pb.RegisterProjectServiceServer(grpcServer, grpcProjectServiceServer)
 
 	PORT := os.Getenv("PORT")
@@ -19,17 +19,41 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AppService_Health_FullMethodName                     = "/app_service.AppService/Health"
-	AppService_CreateApp_FullMethodName                  = "/app_service.AppService/CreateApp"
-	AppService_GetApp_FullMethodName                     = "/app_service.AppService/GetApp"
-	AppService_GetApps_FullMethodName                    = "/app_service.AppService/GetApps"
-	AppService_UpdateApp_FullMethodName                  = "/app_service.AppService/UpdateApp"
-	AppService_DeleteApp_FullMethodName                  = "/app_service.AppService/DeleteApp"
-	AppService_GetEnvironmentVariables_FullMethodName    = "/app_service.AppService/GetEnvironmentVariables"
-	AppService_CreateEnvironmentVariables_FullMethodName = "/app_service.AppService/CreateEnvironmentVariables"
-	AppService_UpdateEnvironmentVariables_FullMethodName = "/app_service.AppService/UpdateEnvironmentVariables"
-	AppService_DeleteEnvironmentVariables_FullMethodName = "/app_service.AppService/DeleteEnvironmentVariables"
-	AppService_BatchGetAppsCount_FullMethodName          = "/app_service.AppService/BatchGetAppsCount"
+	AppService_Health_FullMethodName                         = "/app_service.AppService/Health"
+	AppService_CreateApp_FullMethodName                      = "/app_service.AppService/CreateApp"
+	AppService_GetApp_FullMethodName                         = "/app_service.AppService/GetApp"
+	AppService_GetApps_FullMethodName                        = "/app_service.AppService/GetApps"
+	AppService_UpdateApp_FullMethodName                      = "/app_service.AppService/UpdateApp"
+	AppService_DeleteApp_FullMethodName                      = "/app_service.AppService/DeleteApp"
+	AppService_SuspendApp_FullMethodName                     = "/app_service.AppService/SuspendApp"
+	AppService_ResumeApp_FullMethodName                      = "/app_service.AppService/ResumeApp"
+	AppService_GetAppDeploymentConfig_FullMethodName         = "/app_service.AppService/GetAppDeploymentConfig"
+	AppService_GetAppDisk_FullMethodName                     = "/app_service.AppService/GetAppDisk"
+	AppService_SetAppDisk_FullMethodName                     = "/app_service.AppService/SetAppDisk"
+	AppService_DeleteAppDisk_FullMethodName                  = "/app_service.AppService/DeleteAppDisk"
+	AppService_GetEnvironmentVariables_FullMethodName        = "/app_service.AppService/GetEnvironmentVariables"
+	AppService_CreateEnvironmentVariables_FullMethodName     = "/app_service.AppService/CreateEnvironmentVariables"
+	AppService_UpdateEnvironmentVariables_FullMethodName     = "/app_service.AppService/UpdateEnvironmentVariables"
+	AppService_DeleteEnvironmentVariables_FullMethodName     = "/app_service.AppService/DeleteEnvironmentVariables"
+	AppService_SetEnvironmentVariable_FullMethodName         = "/app_service.AppService/SetEnvironmentVariable"
+	AppService_DeleteEnvironmentVariable_FullMethodName      = "/app_service.AppService/DeleteEnvironmentVariable"
+	AppService_ResolveEnvironmentVariables_FullMethodName    = "/app_service.AppService/ResolveEnvironmentVariables"
+	AppService_ImportEnvironmentVariables_FullMethodName     = "/app_service.AppService/ImportEnvironmentVariables"
+	AppService_ExportEnvironmentVariables_FullMethodName     = "/app_service.AppService/ExportEnvironmentVariables"
+	AppService_CreateEnvironmentGroup_FullMethodName         = "/app_service.AppService/CreateEnvironmentGroup"
+	AppService_GetEnvironmentGroup_FullMethodName            = "/app_service.AppService/GetEnvironmentGroup"
+	AppService_GetEnvironmentGroups_FullMethodName           = "/app_service.AppService/GetEnvironmentGroups"
+	AppService_DeleteEnvironmentGroup_FullMethodName         = "/app_service.AppService/DeleteEnvironmentGroup"
+	AppService_SetEnvironmentGroupVariable_FullMethodName    = "/app_service.AppService/SetEnvironmentGroupVariable"
+	AppService_DeleteEnvironmentGroupVariable_FullMethodName = "/app_service.AppService/DeleteEnvironmentGroupVariable"
+	AppService_LinkEnvironmentGroup_FullMethodName           = "/app_service.AppService/LinkEnvironmentGroup"
+	AppService_UnlinkEnvironmentGroup_FullMethodName         = "/app_service.AppService/UnlinkEnvironmentGroup"
+	AppService_BatchGetAppsCount_FullMethodName              = "/app_service.AppService/BatchGetAppsCount"
+	AppService_GetExistingAppIds_FullMethodName              = "/app_service.AppService/GetExistingAppIds"
+	AppService_GetAppPreviews_FullMethodName                 = "/app_service.AppService/GetAppPreviews"
+	AppService_GetPreviewSourceApps_FullMethodName           = "/app_service.AppService/GetPreviewSourceApps"
+	AppService_DeployPullRequestPreview_FullMethodName       = "/app_service.AppService/DeployPullRequestPreview"
+	AppService_DeletePullRequestPreview_FullMethodName       = "/app_service.AppService/DeletePullRequestPreview"
 )
 
 // AppServiceClient is the client API for AppService service.
@@ -42,11 +66,35 @@ type AppServiceClient interface {
 	GetApps(ctx context.Context, in *GetAppsRequest, opts ...grpc.CallOption) (*GetAppsResponse, error)
 	UpdateApp(ctx context.Context, in *UpdateAppRequest, opts ...grpc.CallOption) (*UpdateAppResponse, error)
 	DeleteApp(ctx context.Context, in *DeleteAppRequest, opts ...grpc.CallOption) (*DeleteAppResponse, error)
+	SuspendApp(ctx context.Context, in *SuspendAppRequest, opts ...grpc.CallOption) (*SuspendAppResponse, error)
+	ResumeApp(ctx context.Context, in *ResumeAppRequest, opts ...grpc.CallOption) (*ResumeAppResponse, error)
+	GetAppDeploymentConfig(ctx context.Context, in *GetAppDeploymentConfigRequest, opts ...grpc.CallOption) (*GetAppDeploymentConfigResponse, error)
+	GetAppDisk(ctx context.Context, in *GetAppDiskRequest, opts ...grpc.CallOption) (*GetAppDiskResponse, error)
+	SetAppDisk(ctx context.Context, in *SetAppDiskRequest, opts ...grpc.CallOption) (*SetAppDiskResponse, error)
+	DeleteAppDisk(ctx context.Context, in *DeleteAppDiskRequest, opts ...grpc.CallOption) (*DeleteAppDiskResponse, error)
 	GetEnvironmentVariables(ctx context.Context, in *GetEnvironmentVariablesRequest, opts ...grpc.CallOption) (*GetEnvironmentVariablesResponse, error)
 	CreateEnvironmentVariables(ctx context.Context, in *CreateEnvironmentVariablesRequest, opts ...grpc.CallOption) (*CreateEnvironmentVariablesResponse, error)
 	UpdateEnvironmentVariables(ctx context.Context, in *UpdateEnvironmentVariablesRequest, opts ...grpc.CallOption) (*UpdateEnvironmentVariablesResponse, error)
 	DeleteEnvironmentVariables(ctx context.Context, in *DeleteEnvironmentVariablesRequest, opts ...grpc.CallOption) (*DeleteEnvironmentVariablesResponse, error)
+	SetEnvironmentVariable(ctx context.Context, in *SetEnvironmentVariableRequest, opts ...grpc.CallOption) (*SetEnvironmentVariableResponse, error)
+	DeleteEnvironmentVariable(ctx context.Context, in *DeleteEnvironmentVariableRequest, opts ...grpc.CallOption) (*DeleteEnvironmentVariableResponse, error)
+	ResolveEnvironmentVariables(ctx context.Context, in *ResolveEnvironmentVariablesRequest, opts ...grpc.CallOption) (*ResolveEnvironmentVariablesResponse, error)
+	ImportEnvironmentVariables(ctx context.Context, in *ImportEnvironmentVariablesRequest, opts ...grpc.CallOption) (*ImportEnvironmentVariablesResponse, error)
+	ExportEnvironmentVariables(ctx context.Context, in *ExportEnvironmentVariablesRequest, opts ...grpc.CallOption) (*ExportEnvironmentVariablesResponse, error)
+	CreateEnvironmentGroup(ctx context.Context, in *CreateEnvironmentGroupRequest, opts ...grpc.CallOption) (*CreateEnvironmentGroupResponse, error)
+	GetEnvironmentGroup(ctx context.Context, in *GetEnvironmentGroupRequest, opts ...grpc.CallOption) (*GetEnvironmentGroupResponse, error)
+	GetEnvironmentGroups(ctx context.Context, in *GetEnvironmentGroupsRequest, opts ...grpc.CallOption) (*GetEnvironmentGroupsResponse, error)
+	DeleteEnvironmentGroup(ctx context.Context, in *DeleteEnvironmentGroupRequest, opts ...grpc.CallOption) (*DeleteEnvironmentGroupResponse, error)
+	SetEnvironmentGroupVariable(ctx context.Context, in *SetEnvironmentGroupVariableRequest, opts ...grpc.CallOption) (*SetEnvironmentGroupVariableResponse, error)
+	DeleteEnvironmentGroupVariable(ctx context.Context, in *DeleteEnvironmentGroupVariableRequest, opts ...grpc.CallOption) (*DeleteEnvironmentGroupVariableResponse, error)
+	LinkEnvironmentGroup(ctx context.Context, in *LinkEnvironmentGroupRequest, opts ...grpc.CallOption) (*LinkEnvironmentGroupResponse, error)
+	UnlinkEnvironmentGroup(ctx context.Context, in *UnlinkEnvironmentGroupRequest, opts ...grpc.CallOption) (*UnlinkEnvironmentGroupResponse, error)
 	BatchGetAppsCount(ctx context.Context, in *BatchGetAppsCountRequest, opts ...grpc.CallOption) (*BatchGetAppsCountResponse, error)
+	GetExistingAppIds(ctx context.Context, in *GetExistingAppIdsRequest, opts ...grpc.CallOption) (*GetExistingAppIdsResponse, error)
+	GetAppPreviews(ctx context.Context, in *GetAppPreviewsRequest, opts ...grpc.CallOption) (*GetAppPreviewsResponse, error)
+	GetPreviewSourceApps(ctx context.Context, in *GetPreviewSourceAppsRequest, opts ...grpc.CallOption) (*GetPreviewSourceAppsResponse, error)
+	DeployPullRequestPreview(ctx context.Context, in *DeployPullRequestPreviewRequest, opts ...grpc.CallOption) (*DeployPullRequestPreviewResponse, error)
+	DeletePullRequestPreview(ctx context.Context, in *DeletePullRequestPreviewRequest, opts ...grpc.CallOption) (*DeletePullRequestPreviewResponse, error)
 }
 
 type appServiceClient struct {
@@ -117,6 +165,66 @@ func (c *appServiceClient) DeleteApp(ctx context.Context, in *DeleteAppRequest,
 	return out, nil
 }
 
+func (c *appServiceClient) SuspendApp(ctx context.Context, in *SuspendAppRequest, opts ...grpc.CallOption) (*SuspendAppResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendAppResponse)
+	err := c.cc.Invoke(ctx, AppService_SuspendApp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) ResumeApp(ctx context.Context, in *ResumeAppRequest, opts ...grpc.CallOption) (*ResumeAppResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeAppResponse)
+	err := c.cc.Invoke(ctx, AppService_ResumeApp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) GetAppDeploymentConfig(ctx context.Context, in *GetAppDeploymentConfigRequest, opts ...grpc.CallOption) (*GetAppDeploymentConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAppDeploymentConfigResponse)
+	err := c.cc.Invoke(ctx, AppService_GetAppDeploymentConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) GetAppDisk(ctx context.Context, in *GetAppDiskRequest, opts ...grpc.CallOption) (*GetAppDiskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAppDiskResponse)
+	err := c.cc.Invoke(ctx, AppService_GetAppDisk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) SetAppDisk(ctx context.Context, in *SetAppDiskRequest, opts ...grpc.CallOption) (*SetAppDiskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAppDiskResponse)
+	err := c.cc.Invoke(ctx, AppService_SetAppDisk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) DeleteAppDisk(ctx context.Context, in *DeleteAppDiskRequest, opts ...grpc.CallOption) (*DeleteAppDiskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAppDiskResponse)
+	err := c.cc.Invoke(ctx, AppService_DeleteAppDisk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appServiceClient) GetEnvironmentVariables(ctx context.Context, in *GetEnvironmentVariablesRequest, opts ...grpc.CallOption) (*GetEnvironmentVariablesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetEnvironmentVariablesResponse)
@@ -157,6 +265,136 @@ func (c *appServiceClient) DeleteEnvironmentVariables(ctx context.Context, in *D
 	return out, nil
 }
 
+func (c *appServiceClient) SetEnvironmentVariable(ctx context.Context, in *SetEnvironmentVariableRequest, opts ...grpc.CallOption) (*SetEnvironmentVariableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEnvironmentVariableResponse)
+	err := c.cc.Invoke(ctx, AppService_SetEnvironmentVariable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) DeleteEnvironmentVariable(ctx context.Context, in *DeleteEnvironmentVariableRequest, opts ...grpc.CallOption) (*DeleteEnvironmentVariableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEnvironmentVariableResponse)
+	err := c.cc.Invoke(ctx, AppService_DeleteEnvironmentVariable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) ResolveEnvironmentVariables(ctx context.Context, in *ResolveEnvironmentVariablesRequest, opts ...grpc.CallOption) (*ResolveEnvironmentVariablesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveEnvironmentVariablesResponse)
+	err := c.cc.Invoke(ctx, AppService_ResolveEnvironmentVariables_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) ImportEnvironmentVariables(ctx context.Context, in *ImportEnvironmentVariablesRequest, opts ...grpc.CallOption) (*ImportEnvironmentVariablesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportEnvironmentVariablesResponse)
+	err := c.cc.Invoke(ctx, AppService_ImportEnvironmentVariables_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) ExportEnvironmentVariables(ctx context.Context, in *ExportEnvironmentVariablesRequest, opts ...grpc.CallOption) (*ExportEnvironmentVariablesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportEnvironmentVariablesResponse)
+	err := c.cc.Invoke(ctx, AppService_ExportEnvironmentVariables_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) CreateEnvironmentGroup(ctx context.Context, in *CreateEnvironmentGroupRequest, opts ...grpc.CallOption) (*CreateEnvironmentGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEnvironmentGroupResponse)
+	err := c.cc.Invoke(ctx, AppService_CreateEnvironmentGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) GetEnvironmentGroup(ctx context.Context, in *GetEnvironmentGroupRequest, opts ...grpc.CallOption) (*GetEnvironmentGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEnvironmentGroupResponse)
+	err := c.cc.Invoke(ctx, AppService_GetEnvironmentGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) GetEnvironmentGroups(ctx context.Context, in *GetEnvironmentGroupsRequest, opts ...grpc.CallOption) (*GetEnvironmentGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEnvironmentGroupsResponse)
+	err := c.cc.Invoke(ctx, AppService_GetEnvironmentGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) DeleteEnvironmentGroup(ctx context.Context, in *DeleteEnvironmentGroupRequest, opts ...grpc.CallOption) (*DeleteEnvironmentGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEnvironmentGroupResponse)
+	err := c.cc.Invoke(ctx, AppService_DeleteEnvironmentGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) SetEnvironmentGroupVariable(ctx context.Context, in *SetEnvironmentGroupVariableRequest, opts ...grpc.CallOption) (*SetEnvironmentGroupVariableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEnvironmentGroupVariableResponse)
+	err := c.cc.Invoke(ctx, AppService_SetEnvironmentGroupVariable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) DeleteEnvironmentGroupVariable(ctx context.Context, in *DeleteEnvironmentGroupVariableRequest, opts ...grpc.CallOption) (*DeleteEnvironmentGroupVariableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEnvironmentGroupVariableResponse)
+	err := c.cc.Invoke(ctx, AppService_DeleteEnvironmentGroupVariable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) LinkEnvironmentGroup(ctx context.Context, in *LinkEnvironmentGroupRequest, opts ...grpc.CallOption) (*LinkEnvironmentGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkEnvironmentGroupResponse)
+	err := c.cc.Invoke(ctx, AppService_LinkEnvironmentGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) UnlinkEnvironmentGroup(ctx context.Context, in *UnlinkEnvironmentGroupRequest, opts ...grpc.CallOption) (*UnlinkEnvironmentGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkEnvironmentGroupResponse)
+	err := c.cc.Invoke(ctx, AppService_UnlinkEnvironmentGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appServiceClient) BatchGetAppsCount(ctx context.Context, in *BatchGetAppsCountRequest, opts ...grpc.CallOption) (*BatchGetAppsCountResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BatchGetAppsCountResponse)
@@ -167,6 +405,56 @@ func (c *appServiceClient) BatchGetAppsCount(ctx context.Context, in *BatchGetAp
 	return out, nil
 }
 
+func (c *appServiceClient) GetExistingAppIds(ctx context.Context, in *GetExistingAppIdsRequest, opts ...grpc.CallOption) (*GetExistingAppIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExistingAppIdsResponse)
+	err := c.cc.Invoke(ctx, AppService_GetExistingAppIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) GetAppPreviews(ctx context.Context, in *GetAppPreviewsRequest, opts ...grpc.CallOption) (*GetAppPreviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAppPreviewsResponse)
+	err := c.cc.Invoke(ctx, AppService_GetAppPreviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) GetPreviewSourceApps(ctx context.Context, in *GetPreviewSourceAppsRequest, opts ...grpc.CallOption) (*GetPreviewSourceAppsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPreviewSourceAppsResponse)
+	err := c.cc.Invoke(ctx, AppService_GetPreviewSourceApps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) DeployPullRequestPreview(ctx context.Context, in *DeployPullRequestPreviewRequest, opts ...grpc.CallOption) (*DeployPullRequestPreviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeployPullRequestPreviewResponse)
+	err := c.cc.Invoke(ctx, AppService_DeployPullRequestPreview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appServiceClient) DeletePullRequestPreview(ctx context.Context, in *DeletePullRequestPreviewRequest, opts ...grpc.CallOption) (*DeletePullRequestPreviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePullRequestPreviewResponse)
+	err := c.cc.Invoke(ctx, AppService_DeletePullRequestPreview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AppServiceServer is the server API for AppService service.
 // All implementations must embed UnimplementedAppServiceServer
 // for forward compatibility.
@@ -177,11 +465,35 @@ type AppServiceServer interface {
 	GetApps(context.Context, *GetAppsRequest) (*GetAppsResponse, error)
 	UpdateApp(context.Context, *UpdateAppRequest) (*UpdateAppResponse, error)
 	DeleteApp(context.Context, *DeleteAppRequest) (*DeleteAppResponse, error)
+	SuspendApp(context.Context, *SuspendAppRequest) (*SuspendAppResponse, error)
+	ResumeApp(context.Context, *ResumeAppRequest) (*ResumeAppResponse, error)
+	GetAppDeploymentConfig(context.Context, *GetAppDeploymentConfigRequest) (*GetAppDeploymentConfigResponse, error)
+	GetAppDisk(context.Context, *GetAppDiskRequest) (*GetAppDiskResponse, error)
+	SetAppDisk(context.Context, *SetAppDiskRequest) (*SetAppDiskResponse, error)
+	DeleteAppDisk(context.Context, *DeleteAppDiskRequest) (*DeleteAppDiskResponse, error)
 	GetEnvironmentVariables(context.Context, *GetEnvironmentVariablesRequest) (*GetEnvironmentVariablesResponse, error)
 	CreateEnvironmentVariables(context.Context, *CreateEnvironmentVariablesRequest) (*CreateEnvironmentVariablesResponse, error)
 	UpdateEnvironmentVariables(context.Context, *UpdateEnvironmentVariablesRequest) (*UpdateEnvironmentVariablesResponse, error)
 	DeleteEnvironmentVariables(context.Context, *DeleteEnvironmentVariablesRequest) (*DeleteEnvironmentVariablesResponse, error)
+	SetEnvironmentVariable(context.Context, *SetEnvironmentVariableRequest) (*SetEnvironmentVariableResponse, error)
+	DeleteEnvironmentVariable(context.Context, *DeleteEnvironmentVariableRequest) (*DeleteEnvironmentVariableResponse, error)
+	ResolveEnvironmentVariables(context.Context, *ResolveEnvironmentVariablesRequest) (*ResolveEnvironmentVariablesResponse, error)
+	ImportEnvironmentVariables(context.Context, *ImportEnvironmentVariablesRequest) (*ImportEnvironmentVariablesResponse, error)
+	ExportEnvironmentVariables(context.Context, *ExportEnvironmentVariablesRequest) (*ExportEnvironmentVariablesResponse, error)
+	CreateEnvironmentGroup(context.Context, *CreateEnvironmentGroupRequest) (*CreateEnvironmentGroupResponse, error)
+	GetEnvironmentGroup(context.Context, *GetEnvironmentGroupRequest) (*GetEnvironmentGroupResponse, error)
+	GetEnvironmentGroups(context.Context, *GetEnvironmentGroupsRequest) (*GetEnvironmentGroupsResponse, error)
+	DeleteEnvironmentGroup(context.Context, *DeleteEnvironmentGroupRequest) (*DeleteEnvironmentGroupResponse, error)
+	SetEnvironmentGroupVariable(context.Context, *SetEnvironmentGroupVariableRequest) (*SetEnvironmentGroupVariableResponse, error)
+	DeleteEnvironmentGroupVariable(context.Context, *DeleteEnvironmentGroupVariableRequest) (*DeleteEnvironmentGroupVariableResponse, error)
+	LinkEnvironmentGroup(context.Context, *LinkEnvironmentGroupRequest) (*LinkEnvironmentGroupResponse, error)
+	UnlinkEnvironmentGroup(context.Context, *UnlinkEnvironmentGroupRequest) (*UnlinkEnvironmentGroupResponse, error)
 	BatchGetAppsCount(context.Context, *BatchGetAppsCountRequest) (*BatchGetAppsCountResponse, error)
+	GetExistingAppIds(context.Context, *GetExistingAppIdsRequest) (*GetExistingAppIdsResponse, error)
+	GetAppPreviews(context.Context, *GetAppPreviewsRequest) (*GetAppPreviewsResponse, error)
+	GetPreviewSourceApps(context.Context, *GetPreviewSourceAppsRequest) (*GetPreviewSourceAppsResponse, error)
+	DeployPullRequestPreview(context.Context, *DeployPullRequestPreviewRequest) (*DeployPullRequestPreviewResponse, error)
+	DeletePullRequestPreview(context.Context, *DeletePullRequestPreviewRequest) (*DeletePullRequestPreviewResponse, error)
 	mustEmbedUnimplementedAppServiceServer()
 }
 
@@ -210,6 +522,24 @@ func (UnimplementedAppServiceServer) UpdateApp(context.Context, *UpdateAppReques
 func (UnimplementedAppServiceServer) DeleteApp(context.Context, *DeleteAppRequest) (*DeleteAppResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteApp not implemented")
 }
+func (UnimplementedAppServiceServer) SuspendApp(context.Context, *SuspendAppRequest) (*SuspendAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendApp not implemented")
+}
+func (UnimplementedAppServiceServer) ResumeApp(context.Context, *ResumeAppRequest) (*ResumeAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeApp not implemented")
+}
+func (UnimplementedAppServiceServer) GetAppDeploymentConfig(context.Context, *GetAppDeploymentConfigRequest) (*GetAppDeploymentConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppDeploymentConfig not implemented")
+}
+func (UnimplementedAppServiceServer) GetAppDisk(context.Context, *GetAppDiskRequest) (*GetAppDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppDisk not implemented")
+}
+func (UnimplementedAppServiceServer) SetAppDisk(context.Context, *SetAppDiskRequest) (*SetAppDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAppDisk not implemented")
+}
+func (UnimplementedAppServiceServer) DeleteAppDisk(context.Context, *DeleteAppDiskRequest) (*DeleteAppDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppDisk not implemented")
+}
 func (UnimplementedAppServiceServer) GetEnvironmentVariables(context.Context, *GetEnvironmentVariablesRequest) (*GetEnvironmentVariablesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEnvironmentVariables not implemented")
 }
@@ -222,9 +552,63 @@ func (UnimplementedAppServiceServer) UpdateEnvironmentVariables(context.Context,
 func (UnimplementedAppServiceServer) DeleteEnvironmentVariables(context.Context, *DeleteEnvironmentVariablesRequest) (*DeleteEnvironmentVariablesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEnvironmentVariables not implemented")
 }
+func (UnimplementedAppServiceServer) SetEnvironmentVariable(context.Context, *SetEnvironmentVariableRequest) (*SetEnvironmentVariableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEnvironmentVariable not implemented")
+}
+func (UnimplementedAppServiceServer) DeleteEnvironmentVariable(context.Context, *DeleteEnvironmentVariableRequest) (*DeleteEnvironmentVariableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEnvironmentVariable not implemented")
+}
+func (UnimplementedAppServiceServer) ResolveEnvironmentVariables(context.Context, *ResolveEnvironmentVariablesRequest) (*ResolveEnvironmentVariablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveEnvironmentVariables not implemented")
+}
+func (UnimplementedAppServiceServer) ImportEnvironmentVariables(context.Context, *ImportEnvironmentVariablesRequest) (*ImportEnvironmentVariablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportEnvironmentVariables not implemented")
+}
+func (UnimplementedAppServiceServer) ExportEnvironmentVariables(context.Context, *ExportEnvironmentVariablesRequest) (*ExportEnvironmentVariablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportEnvironmentVariables not implemented")
+}
+func (UnimplementedAppServiceServer) CreateEnvironmentGroup(context.Context, *CreateEnvironmentGroupRequest) (*CreateEnvironmentGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEnvironmentGroup not implemented")
+}
+func (UnimplementedAppServiceServer) GetEnvironmentGroup(context.Context, *GetEnvironmentGroupRequest) (*GetEnvironmentGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnvironmentGroup not implemented")
+}
+func (UnimplementedAppServiceServer) GetEnvironmentGroups(context.Context, *GetEnvironmentGroupsRequest) (*GetEnvironmentGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnvironmentGroups not implemented")
+}
+func (UnimplementedAppServiceServer) DeleteEnvironmentGroup(context.Context, *DeleteEnvironmentGroupRequest) (*DeleteEnvironmentGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEnvironmentGroup not implemented")
+}
+func (UnimplementedAppServiceServer) SetEnvironmentGroupVariable(context.Context, *SetEnvironmentGroupVariableRequest) (*SetEnvironmentGroupVariableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEnvironmentGroupVariable not implemented")
+}
+func (UnimplementedAppServiceServer) DeleteEnvironmentGroupVariable(context.Context, *DeleteEnvironmentGroupVariableRequest) (*DeleteEnvironmentGroupVariableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEnvironmentGroupVariable not implemented")
+}
+func (UnimplementedAppServiceServer) LinkEnvironmentGroup(context.Context, *LinkEnvironmentGroupRequest) (*LinkEnvironmentGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkEnvironmentGroup not implemented")
+}
+func (UnimplementedAppServiceServer) UnlinkEnvironmentGroup(context.Context, *UnlinkEnvironmentGroupRequest) (*UnlinkEnvironmentGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkEnvironmentGroup not implemented")
+}
 func (UnimplementedAppServiceServer) BatchGetAppsCount(context.Context, *BatchGetAppsCountRequest) (*BatchGetAppsCountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchGetAppsCount not implemented")
 }
+func (UnimplementedAppServiceServer) GetExistingAppIds(context.Context, *GetExistingAppIdsRequest) (*GetExistingAppIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExistingAppIds not implemented")
+}
+func (UnimplementedAppServiceServer) GetAppPreviews(context.Context, *GetAppPreviewsRequest) (*GetAppPreviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppPreviews not implemented")
+}
+func (UnimplementedAppServiceServer) GetPreviewSourceApps(context.Context, *GetPreviewSourceAppsRequest) (*GetPreviewSourceAppsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreviewSourceApps not implemented")
+}
+func (UnimplementedAppServiceServer) DeployPullRequestPreview(context.Context, *DeployPullRequestPreviewRequest) (*DeployPullRequestPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeployPullRequestPreview not implemented")
+}
+func (UnimplementedAppServiceServer) DeletePullRequestPreview(context.Context, *DeletePullRequestPreviewRequest) (*DeletePullRequestPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePullRequestPreview not implemented")
+}
 func (UnimplementedAppServiceServer) mustEmbedUnimplementedAppServiceServer() {}
 func (UnimplementedAppServiceServer) testEmbeddedByValue()                    {}
 
@@ -354,135 +738,591 @@ func _AppService_DeleteApp_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppService_GetEnvironmentVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEnvironmentVariablesRequest)
+func _AppService_SuspendApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendAppRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServiceServer).GetEnvironmentVariables(ctx, in)
+		return srv.(AppServiceServer).SuspendApp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppService_GetEnvironmentVariables_FullMethodName,
+		FullMethod: AppService_SuspendApp_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServiceServer).GetEnvironmentVariables(ctx, req.(*GetEnvironmentVariablesRequest))
+		return srv.(AppServiceServer).SuspendApp(ctx, req.(*SuspendAppRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppService_CreateEnvironmentVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateEnvironmentVariablesRequest)
+func _AppService_ResumeApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeAppRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServiceServer).CreateEnvironmentVariables(ctx, in)
+		return srv.(AppServiceServer).ResumeApp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppService_CreateEnvironmentVariables_FullMethodName,
+		FullMethod: AppService_ResumeApp_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServiceServer).CreateEnvironmentVariables(ctx, req.(*CreateEnvironmentVariablesRequest))
+		return srv.(AppServiceServer).ResumeApp(ctx, req.(*ResumeAppRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppService_UpdateEnvironmentVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateEnvironmentVariablesRequest)
+func _AppService_GetAppDeploymentConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppDeploymentConfigRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServiceServer).UpdateEnvironmentVariables(ctx, in)
+		return srv.(AppServiceServer).GetAppDeploymentConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppService_UpdateEnvironmentVariables_FullMethodName,
+		FullMethod: AppService_GetAppDeploymentConfig_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServiceServer).UpdateEnvironmentVariables(ctx, req.(*UpdateEnvironmentVariablesRequest))
+		return srv.(AppServiceServer).GetAppDeploymentConfig(ctx, req.(*GetAppDeploymentConfigRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppService_DeleteEnvironmentVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteEnvironmentVariablesRequest)
+func _AppService_GetAppDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppDiskRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServiceServer).DeleteEnvironmentVariables(ctx, in)
+		return srv.(AppServiceServer).GetAppDisk(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppService_DeleteEnvironmentVariables_FullMethodName,
+		FullMethod: AppService_GetAppDisk_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServiceServer).DeleteEnvironmentVariables(ctx, req.(*DeleteEnvironmentVariablesRequest))
+		return srv.(AppServiceServer).GetAppDisk(ctx, req.(*GetAppDiskRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppService_BatchGetAppsCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchGetAppsCountRequest)
+func _AppService_SetAppDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAppDiskRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppServiceServer).BatchGetAppsCount(ctx, in)
+		return srv.(AppServiceServer).SetAppDisk(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppService_BatchGetAppsCount_FullMethodName,
+		FullMethod: AppService_SetAppDisk_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppServiceServer).BatchGetAppsCount(ctx, req.(*BatchGetAppsCountRequest))
+		return srv.(AppServiceServer).SetAppDisk(ctx, req.(*SetAppDiskRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// AppService_ServiceDesc is the grpc.ServiceDesc for AppService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var AppService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "app_service.AppService",
-	HandlerType: (*AppServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Health",
-			Handler:    _AppService_Health_Handler,
-		},
-		{
-			MethodName: "CreateApp",
-			Handler:    _AppService_CreateApp_Handler,
-		},
-		{
-			MethodName: "GetApp",
-			Handler:    _AppService_GetApp_Handler,
-		},
-		{
-			MethodName: "GetApps",
-			Handler:    _AppService_GetApps_Handler,
-		},
-		{
-			MethodName: "UpdateApp",
-			Handler:    _AppService_UpdateApp_Handler,
-		},
-		{
-			MethodName: "DeleteApp",
-			Handler:    _AppService_DeleteApp_Handler,
-		},
-		{
-			MethodName: "GetEnvironmentVariables",
-			Handler:    _AppService_GetEnvironmentVariables_Handler,
-		},
-		{
-			MethodName: "CreateEnvironmentVariables",
-			Handler:    _AppService_CreateEnvironmentVariables_Handler,
-		},
+func _AppService_DeleteAppDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAppDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).DeleteAppDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_DeleteAppDisk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).DeleteAppDisk(ctx, req.(*DeleteAppDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_GetEnvironmentVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnvironmentVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).GetEnvironmentVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_GetEnvironmentVariables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).GetEnvironmentVariables(ctx, req.(*GetEnvironmentVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_CreateEnvironmentVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEnvironmentVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).CreateEnvironmentVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_CreateEnvironmentVariables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).CreateEnvironmentVariables(ctx, req.(*CreateEnvironmentVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_UpdateEnvironmentVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEnvironmentVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).UpdateEnvironmentVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_UpdateEnvironmentVariables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).UpdateEnvironmentVariables(ctx, req.(*UpdateEnvironmentVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_DeleteEnvironmentVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEnvironmentVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).DeleteEnvironmentVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_DeleteEnvironmentVariables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).DeleteEnvironmentVariables(ctx, req.(*DeleteEnvironmentVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_SetEnvironmentVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEnvironmentVariableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).SetEnvironmentVariable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_SetEnvironmentVariable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).SetEnvironmentVariable(ctx, req.(*SetEnvironmentVariableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_DeleteEnvironmentVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEnvironmentVariableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).DeleteEnvironmentVariable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_DeleteEnvironmentVariable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).DeleteEnvironmentVariable(ctx, req.(*DeleteEnvironmentVariableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_ResolveEnvironmentVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveEnvironmentVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).ResolveEnvironmentVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_ResolveEnvironmentVariables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).ResolveEnvironmentVariables(ctx, req.(*ResolveEnvironmentVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_ImportEnvironmentVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportEnvironmentVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).ImportEnvironmentVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_ImportEnvironmentVariables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).ImportEnvironmentVariables(ctx, req.(*ImportEnvironmentVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_ExportEnvironmentVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportEnvironmentVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).ExportEnvironmentVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_ExportEnvironmentVariables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).ExportEnvironmentVariables(ctx, req.(*ExportEnvironmentVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_CreateEnvironmentGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEnvironmentGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).CreateEnvironmentGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_CreateEnvironmentGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).CreateEnvironmentGroup(ctx, req.(*CreateEnvironmentGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_GetEnvironmentGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnvironmentGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).GetEnvironmentGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_GetEnvironmentGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).GetEnvironmentGroup(ctx, req.(*GetEnvironmentGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_GetEnvironmentGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnvironmentGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).GetEnvironmentGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_GetEnvironmentGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).GetEnvironmentGroups(ctx, req.(*GetEnvironmentGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_DeleteEnvironmentGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEnvironmentGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).DeleteEnvironmentGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_DeleteEnvironmentGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).DeleteEnvironmentGroup(ctx, req.(*DeleteEnvironmentGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_SetEnvironmentGroupVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEnvironmentGroupVariableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).SetEnvironmentGroupVariable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_SetEnvironmentGroupVariable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).SetEnvironmentGroupVariable(ctx, req.(*SetEnvironmentGroupVariableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_DeleteEnvironmentGroupVariable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEnvironmentGroupVariableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).DeleteEnvironmentGroupVariable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_DeleteEnvironmentGroupVariable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).DeleteEnvironmentGroupVariable(ctx, req.(*DeleteEnvironmentGroupVariableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_LinkEnvironmentGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkEnvironmentGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).LinkEnvironmentGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_LinkEnvironmentGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).LinkEnvironmentGroup(ctx, req.(*LinkEnvironmentGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_UnlinkEnvironmentGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkEnvironmentGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).UnlinkEnvironmentGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_UnlinkEnvironmentGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).UnlinkEnvironmentGroup(ctx, req.(*UnlinkEnvironmentGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_BatchGetAppsCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetAppsCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).BatchGetAppsCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_BatchGetAppsCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).BatchGetAppsCount(ctx, req.(*BatchGetAppsCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_GetExistingAppIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExistingAppIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).GetExistingAppIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_GetExistingAppIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).GetExistingAppIds(ctx, req.(*GetExistingAppIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_GetAppPreviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppPreviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).GetAppPreviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_GetAppPreviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).GetAppPreviews(ctx, req.(*GetAppPreviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_GetPreviewSourceApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreviewSourceAppsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).GetPreviewSourceApps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_GetPreviewSourceApps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).GetPreviewSourceApps(ctx, req.(*GetPreviewSourceAppsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_DeployPullRequestPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeployPullRequestPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).DeployPullRequestPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_DeployPullRequestPreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).DeployPullRequestPreview(ctx, req.(*DeployPullRequestPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppService_DeletePullRequestPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePullRequestPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServiceServer).DeletePullRequestPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppService_DeletePullRequestPreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServiceServer).DeletePullRequestPreview(ctx, req.(*DeletePullRequestPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AppService_ServiceDesc is the grpc.ServiceDesc for AppService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AppService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "app_service.AppService",
+	HandlerType: (*AppServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Health",
+			Handler:    _AppService_Health_Handler,
+		},
+		{
+			MethodName: "CreateApp",
+			Handler:    _AppService_CreateApp_Handler,
+		},
+		{
+			MethodName: "GetApp",
+			Handler:    _AppService_GetApp_Handler,
+		},
+		{
+			MethodName: "GetApps",
+			Handler:    _AppService_GetApps_Handler,
+		},
+		{
+			MethodName: "UpdateApp",
+			Handler:    _AppService_UpdateApp_Handler,
+		},
+		{
+			MethodName: "DeleteApp",
+			Handler:    _AppService_DeleteApp_Handler,
+		},
+		{
+			MethodName: "SuspendApp",
+			Handler:    _AppService_SuspendApp_Handler,
+		},
+		{
+			MethodName: "ResumeApp",
+			Handler:    _AppService_ResumeApp_Handler,
+		},
+		{
+			MethodName: "GetAppDeploymentConfig",
+			Handler:    _AppService_GetAppDeploymentConfig_Handler,
+		},
+		{
+			MethodName: "GetAppDisk",
+			Handler:    _AppService_GetAppDisk_Handler,
+		},
+		{
+			MethodName: "SetAppDisk",
+			Handler:    _AppService_SetAppDisk_Handler,
+		},
+		{
+			MethodName: "DeleteAppDisk",
+			Handler:    _AppService_DeleteAppDisk_Handler,
+		},
+		{
+			MethodName: "GetEnvironmentVariables",
+			Handler:    _AppService_GetEnvironmentVariables_Handler,
+		},
+		{
+			MethodName: "CreateEnvironmentVariables",
+			Handler:    _AppService_CreateEnvironmentVariables_Handler,
+		},
 		{
 			MethodName: "UpdateEnvironmentVariables",
 			Handler:    _AppService_UpdateEnvironmentVariables_Handler,
@@ -491,10 +1331,82 @@ var AppService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEnvironmentVariables",
 			Handler:    _AppService_DeleteEnvironmentVariables_Handler,
 		},
+		{
+			MethodName: "SetEnvironmentVariable",
+			Handler:    _AppService_SetEnvironmentVariable_Handler,
+		},
+		{
+			MethodName: "DeleteEnvironmentVariable",
+			Handler:    _AppService_DeleteEnvironmentVariable_Handler,
+		},
+		{
+			MethodName: "ResolveEnvironmentVariables",
+			Handler:    _AppService_ResolveEnvironmentVariables_Handler,
+		},
+		{
+			MethodName: "ImportEnvironmentVariables",
+			Handler:    _AppService_ImportEnvironmentVariables_Handler,
+		},
+		{
+			MethodName: "ExportEnvironmentVariables",
+			Handler:    _AppService_ExportEnvironmentVariables_Handler,
+		},
+		{
+			MethodName: "CreateEnvironmentGroup",
+			Handler:    _AppService_CreateEnvironmentGroup_Handler,
+		},
+		{
+			MethodName: "GetEnvironmentGroup",
+			Handler:    _AppService_GetEnvironmentGroup_Handler,
+		},
+		{
+			MethodName: "GetEnvironmentGroups",
+			Handler:    _AppService_GetEnvironmentGroups_Handler,
+		},
+		{
+			MethodName: "DeleteEnvironmentGroup",
+			Handler:    _AppService_DeleteEnvironmentGroup_Handler,
+		},
+		{
+			MethodName: "SetEnvironmentGroupVariable",
+			Handler:    _AppService_SetEnvironmentGroupVariable_Handler,
+		},
+		{
+			MethodName: "DeleteEnvironmentGroupVariable",
+			Handler:    _AppService_DeleteEnvironmentGroupVariable_Handler,
+		},
+		{
+			MethodName: "LinkEnvironmentGroup",
+			Handler:    _AppService_LinkEnvironmentGroup_Handler,
+		},
+		{
+			MethodName: "UnlinkEnvironmentGroup",
+			Handler:    _AppService_UnlinkEnvironmentGroup_Handler,
+		},
 		{
 			MethodName: "BatchGetAppsCount",
 			Handler:    _AppService_BatchGetAppsCount_Handler,
 		},
+		{
+			MethodName: "GetExistingAppIds",
+			Handler:    _AppService_GetExistingAppIds_Handler,
+		},
+		{
+			MethodName: "GetAppPreviews",
+			Handler:    _AppService_GetAppPreviews_Handler,
+		},
+		{
+			MethodName: "GetPreviewSourceApps",
+			Handler:    _AppService_GetPreviewSourceApps_Handler,
+		},
+		{
+			MethodName: "DeployPullRequestPreview",
+			Handler:    _AppService_DeployPullRequestPreview_Handler,
+		},
+		{
+			MethodName: "DeletePullRequestPreview",
+			Handler:    _AppService_DeletePullRequestPreview_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "src/protos/app_service.proto",
@@ -0,0 +1,348 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"apps-hosting.com/logging"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/uptrace/bun"
+)
+
+type AddOnType string
+
+const (
+	AddOnTypePostgres AddOnType = "postgres"
+	AddOnTypeRedis    AddOnType = "redis"
+)
+
+var AddOnTypes = []AddOnType{AddOnTypePostgres, AddOnTypeRedis}
+
+type AddOnStatus string
+
+const (
+	AddOnStatusProvisioning AddOnStatus = "provisioning"
+	AddOnStatusReady        AddOnStatus = "ready"
+	AddOnStatusFailed       AddOnStatus = "failed"
+)
+
+// AddOn is a database running next to the apps of a project. Its password never
+// leaves the cluster, only the public connection info is stored here.
+type AddOn struct {
+	bun.BaseModel `bun:"table:add_ons,alias:add_on"`
+
+	Id        string      `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	ProjectId string      `bun:"project_id,type:uuid,notnull,unique:add_ons_project_id_name_key" json:"project_id"`
+	Name      string      `bun:"name,notnull,unique:add_ons_project_id_name_key" json:"name"`
+	Type      AddOnType   `bun:"type,notnull" json:"type"`
+	Status    AddOnStatus `bun:"status,notnull" json:"status"`
+	Host      string      `bun:"host,notnull,default:''" json:"host"`
+	Port      int32       `bun:"port,notnull,default:0" json:"port"`
+	Database  string      `bun:"database,notnull,default:''" json:"database"`
+	Username  string      `bun:"username,notnull,default:''" json:"username"`
+	CreatedAt time.Time   `bun:"created_at,default:now()" json:"created_at"`
+}
+
+// AddOnLink exposes the connection url of an add-on to an app under EnvVarName.
+type AddOnLink struct {
+	bun.BaseModel `bun:"table:add_on_links"`
+
+	AddOnId    string    `bun:"add_on_id,pk,type:uuid" json:"add_on_id"`
+	AppId      string    `bun:"app_id,pk,type:uuid,unique:add_on_links_app_id_env_var_name_key" json:"app_id"`
+	EnvVarName string    `bun:"env_var_name,notnull,unique:add_on_links_app_id_env_var_name_key" json:"env_var_name"`
+	CreatedAt  time.Time `bun:"created_at,default:now()" json:"created_at"`
+}
+
+type CreateAddOnParams struct {
+	Name string
+	Type AddOnType
+}
+
+type ProvisionedAddOnParams struct {
+	Host     string
+	Port     int32
+	Database string
+	Username string
+}
+
+type AddOnRepositoryInterface interface {
+	CreateAddOnsTables() error
+	CreateAddOn(ctx context.Context, projectId string, createAddOnParams CreateAddOnParams) (*AddOn, error)
+	GetAddOnById(ctx context.Context, projectId, addOnId string) (*AddOn, error)
+	GetAddOns(ctx context.Context, projectId string) ([]AddOn, error)
+	DeleteAddOn(ctx context.Context, projectId, addOnId string) error
+	DeleteAddOnsByProjectId(ctx context.Context, projectId string) error
+	SetAddOnProvisioned(ctx context.Context, addOnId string, provisionedAddOnParams ProvisionedAddOnParams) error
+	SetAddOnStatus(ctx context.Context, addOnId string, status AddOnStatus) error
+	LinkApp(ctx context.Context, addOnId, appId, envVarName string) (*AddOnLink, error)
+	UnlinkApp(ctx context.Context, addOnId, appId string) error
+	GetAddOnLinks(ctx context.Context, addOnId string) ([]AddOnLink, error)
+	GetAppAddOnLinks(ctx context.Context, appId string) ([]AddOnLink, error)
+	DeleteAppLinks(ctx context.Context, appId string) error
+}
+
+type AddOnRepository struct {
+	Database *bun.DB
+	Logger   logging.ServiceLogger
+}
+
+func NewAddOnRepository(database *bun.DB, logger logging.ServiceLogger) AddOnRepository {
+	return AddOnRepository{
+		Database: database,
+		Logger:   logger,
+	}
+}
+
+func (repository *AddOnRepository) CreateAddOnsTables() error {
+	repository.Logger.LogInfo("Creating add_ons tables.")
+
+	models := []interface{}{
+		(*AddOn)(nil),
+		(*AddOnLink)(nil),
+	}
+
+	for _, model := range models {
+		_, err := repository.Database.NewCreateTable().Model(model).IfNotExists().Exec(context.Background())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (repository *AddOnRepository) CreateAddOn(ctx context.Context, projectId string, createAddOnParams CreateAddOnParams) (*AddOn, error) {
+	addOn := AddOn{
+		ProjectId: projectId,
+		Name:      createAddOnParams.Name,
+		Type:      createAddOnParams.Type,
+		Status:    AddOnStatusProvisioning,
+	}
+
+	_, err := repository.Database.NewInsert().Model(&addOn).Returning("*").Exec(ctx)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" && pgErr.ConstraintName == "add_ons_project_id_name_key" {
+				return nil, ErrAddOnNameInUse
+			}
+		}
+
+		return nil, err
+	}
+
+	return &addOn, nil
+}
+
+func (repository *AddOnRepository) GetAddOnById(ctx context.Context, projectId, addOnId string) (*AddOn, error) {
+	addOn := AddOn{}
+	err := repository.Database.
+		NewSelect().
+		Model(&addOn).
+		Where("id = ? AND project_id = ?", addOnId, projectId).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAddOnNotFound
+		}
+		return nil, err
+	}
+
+	return &addOn, nil
+}
+
+func (repository *AddOnRepository) GetAddOns(ctx context.Context, projectId string) ([]AddOn, error) {
+	addOns := []AddOn{}
+	err := repository.Database.
+		NewSelect().
+		Model(&addOns).
+		Where("project_id = ?", projectId).
+		Order("created_at DESC").
+		Scan(ctx)
+
+	if err != nil {
+		return []AddOn{}, err
+	}
+
+	return addOns, nil
+}
+
+// DeleteAddOn removes the add-on with its app links.
+func (repository *AddOnRepository) DeleteAddOn(ctx context.Context, projectId, addOnId string) error {
+	return repository.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		result, err := tx.
+			NewDelete().
+			Model((*AddOn)(nil)).
+			Where("id = ? AND project_id = ?", addOnId, projectId).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, _ := result.RowsAffected()
+		if rowsAffected == 0 {
+			return ErrAddOnNotFound
+		}
+
+		_, err = tx.
+			NewDelete().
+			Model((*AddOnLink)(nil)).
+			Where("add_on_id = ?", addOnId).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (repository *AddOnRepository) DeleteAddOnsByProjectId(ctx context.Context, projectId string) error {
+	return repository.Database.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.
+			NewDelete().
+			Model((*AddOnLink)(nil)).
+			Where("add_on_id IN (?)", tx.NewSelect().Model((*AddOn)(nil)).Column("id").Where("project_id = ?", projectId)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.
+			NewDelete().
+			Model((*AddOn)(nil)).
+			Where("project_id = ?", projectId).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (repository *AddOnRepository) SetAddOnProvisioned(ctx context.Context, addOnId string, provisionedAddOnParams ProvisionedAddOnParams) error {
+	addOn := AddOn{
+		Status:   AddOnStatusReady,
+		Host:     provisionedAddOnParams.Host,
+		Port:     provisionedAddOnParams.Port,
+		Database: provisionedAddOnParams.Database,
+		Username: provisionedAddOnParams.Username,
+	}
+
+	result, err := repository.Database.
+		NewUpdate().
+		Model(&addOn).
+		Column("status", "host", "port", "database", "username").
+		Where("id = ?", addOnId).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return ErrAddOnNotFound
+	}
+
+	return nil
+}
+
+func (repository *AddOnRepository) SetAddOnStatus(ctx context.Context, addOnId string, status AddOnStatus) error {
+	result, err := repository.Database.
+		NewUpdate().
+		Model((*AddOn)(nil)).
+		Set("status = ?", status).
+		Where("id = ?", addOnId).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return ErrAddOnNotFound
+	}
+
+	return nil
+}
+
+func (repository *AddOnRepository) LinkApp(ctx context.Context, addOnId, appId, envVarName string) (*AddOnLink, error) {
+	addOnLink := AddOnLink{
+		AddOnId:    addOnId,
+		AppId:      appId,
+		EnvVarName: envVarName,
+	}
+
+	_, err := repository.Database.NewInsert().Model(&addOnLink).Returning("*").Exec(ctx)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			switch pgErr.ConstraintName {
+			case "add_on_links_pkey":
+				return nil, ErrAddOnAlreadyLinked
+			case "add_on_links_app_id_env_var_name_key":
+				return nil, ErrAddOnEnvVarNameInUse
+			}
+		}
+
+		return nil, err
+	}
+
+	return &addOnLink, nil
+}
+
+func (repository *AddOnRepository) UnlinkApp(ctx context.Context, addOnId, appId string) error {
+	result, err := repository.Database.
+		NewDelete().
+		Model((*AddOnLink)(nil)).
+		Where("add_on_id = ? AND app_id = ?", addOnId, appId).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return ErrAddOnLinkNotFound
+	}
+
+	return nil
+}
+
+func (repository *AddOnRepository) GetAddOnLinks(ctx context.Context, addOnId string) ([]AddOnLink, error) {
+	addOnLinks := []AddOnLink{}
+	err := repository.Database.
+		NewSelect().
+		Model(&addOnLinks).
+		Where("add_on_id = ?", addOnId).
+		Order("created_at ASC").
+		Scan(ctx)
+
+	if err != nil {
+		return []AddOnLink{}, err
+	}
+
+	return addOnLinks, nil
+}
+
+func (repository *AddOnRepository) GetAppAddOnLinks(ctx context.Context, appId string) ([]AddOnLink, error) {
+	addOnLinks := []AddOnLink{}
+	err := repository.Database.
+		NewSelect().
+		Model(&addOnLinks).
+		Where("app_id = ?", appId).
+		Order("created_at ASC").
+		Scan(ctx)
+
+	if err != nil {
+		return []AddOnLink{}, err
+	}
+
+	return addOnLinks, nil
+}
+
+func (repository *AddOnRepository) DeleteAppLinks(ctx context.Context, appId string) error {
+	_, err := repository.Database.
+		NewDelete().
+		Model((*AddOnLink)(nil)).
+		Where("app_id = ?", appId).
+		Exec(ctx)
+	return err
+}
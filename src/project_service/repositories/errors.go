@@ -5,4 +5,10 @@ import "errors"
 var (
 	ErrProjectNameInUse = errors.New("project with that name already exists")
 	ErrProjectNotFound  = errors.New("project not found")
+
+	ErrAddOnNameInUse       = errors.New("add-on with that name already exists")
+	ErrAddOnNotFound        = errors.New("add-on not found")
+	ErrAddOnAlreadyLinked   = errors.New("add-on is already linked to this app")
+	ErrAddOnEnvVarNameInUse = errors.New("app already has an add-on linked under that variable name")
+	ErrAddOnLinkNotFound    = errors.New("add-on is not linked to this app")
 )
@@ -16,6 +16,7 @@ type ProjectRepositoryInterface interface {
 	CreateProjectsTable() error
 	CreateProject(ctx context.Context, userId string, createProjectParams CreateProjectParams) (*Project, error)
 	GetProjectById(ctx context.Context, userId, projectId string) (*Project, error)
+	GetProjectByIdInternal(ctx context.Context, projectId string) (*Project, error)
 	GetProjects(ctx context.Context, userId string) ([]Project, error)
 	DeleteProjectById(ctx context.Context, userId, projectId string) error
 	UpdateProjectById(ctx context.Context, projectId string, updateProjectParams UpdateProjectParams) (*Project, error)
@@ -96,6 +97,26 @@ func (repository *ProjectRepository) GetProjectById(ctx context.Context, userId,
 	return &project, nil
 }
 
+// GetProjectByIdInternal looks the project up without the owner scope, for internal callers only.
+func (repository *ProjectRepository) GetProjectByIdInternal(ctx context.Context, projectId string) (*Project, error) {
+	project := Project{}
+
+	err := repository.Database.
+		NewSelect().
+		Model(&project).
+		Where("id = ?", projectId).
+		Scan(ctx)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, err
+	}
+
+	return &project, nil
+}
+
 func (repository *ProjectRepository) GetProjects(ctx context.Context, userId string) ([]Project, error) {
 	var projects []Project
 	err := repository.Database.NewSelect().